@@ -46,6 +46,14 @@ func run() error {
 		loadedSpec = spec.DevnetChainSpec()
 	}
 
+	// A chain spec file takes precedence over the named chain specs.
+	if specFile := os.Getenv("CHAIN_SPEC_FILE"); specFile != "" {
+		var err error
+		if loadedSpec, err = spec.FileChainSpec(specFile); err != nil {
+			return err
+		}
+	}
+
 	// Build the node using the node-core.
 	nb := nodebuilder.New(
 		// Set the Name to the Default.
@@ -35,4 +35,31 @@ var (
 	ErrNilBlk = errors.New("nil beacon block")
 	// ErrDataNotAvailable.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrNilEth1Data is an error for when the eth1 data of a block is nil.
+	ErrNilEth1Data = errors.New("nil eth1 data")
+	// ErrUnknownEth1Block is an error for when the eth1 data of a block
+	// refers to an execution block at which the deposit contract has not
+	// been read.
+	ErrUnknownEth1Block = errors.New("unknown eth1 block")
+	// ErrDepositCountMismatch is an error for when the deposit count of a
+	// block does not match the deposit contract at its eth1 block.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+	// ErrDepositStoreBehind is an error for when the deposits a block
+	// commits to have not been synced to the local deposit store yet.
+	ErrDepositStoreBehind = errors.New("deposit store is behind")
+	// ErrDepositRootMismatch is an error for when the deposit root of a
+	// block does not match the local deposit tree.
+	ErrDepositRootMismatch = errors.New("deposit root mismatch")
+	// ErrNoValidAncestor is an error for when no valid execution ancestor
+	// of an invalid payload is known.
+	ErrNoValidAncestor = errors.New("no valid execution ancestor")
+	// ErrValidAncestorFinalized is an error for when the last valid
+	// execution ancestor of an invalid payload is below the finalized
+	// payload.
+	ErrValidAncestorFinalized = errors.New(
+		"valid execution ancestor is below the finalized payload",
+	)
+	// ErrInvalidPayloadRecovery is an error for when the node fails to
+	// recover from an invalid execution payload.
+	ErrInvalidPayloadRecovery = errors.New("invalid payload recovery failed")
 )
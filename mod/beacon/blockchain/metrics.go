@@ -116,6 +116,41 @@ func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
 	)
 }
 
+// markInvalidPayloadRecoveryStarted increments the counter for the number of
+// times the node started recovering from an invalid execution payload.
+func (cm *chainMetrics) markInvalidPayloadRecoveryStarted(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.invalid_payload_recovery_started",
+		"slot",
+		string(slot.String()),
+	)
+}
+
+// markInvalidPayloadRecoverySuccess increments the counter for the number of
+// times the node recovered from an invalid execution payload.
+func (cm *chainMetrics) markInvalidPayloadRecoverySuccess(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.invalid_payload_recovery_success",
+		"slot",
+		string(slot.String()),
+	)
+}
+
+// markInvalidPayloadRecoveryFailure increments the counter for the number of
+// times the node failed to recover from an invalid execution payload.
+func (cm *chainMetrics) markInvalidPayloadRecoveryFailure(
+	slot math.Slot,
+	err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.invalid_payload_recovery_failure",
+		"slot",
+		string(slot.String()),
+		"error",
+		err.Error(),
+	)
+}
+
 // measureStateRootVerificationTime measures the time taken to verify the state
 // root of a block.
 // It records the duration from the provided start time to the current time.
@@ -62,6 +62,49 @@ func (s *Service[
 	}
 }
 
+// handleRejectedBlock handles the case where the incoming block was rejected.
+// If the execution client rejected its payload, the execution client is moved
+// back onto the last valid ancestor of the payload before the payload for the
+// current slot is rebuilt.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) handleRejectedBlock(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	err error,
+) {
+	if isInvalidPayload(err) {
+		// The state has not been modified by the rejected block, hence its
+		// latest execution payload is the finalized one.
+		lph, lphErr := st.GetLatestExecutionPayloadHeader()
+		if lphErr != nil {
+			s.logger.Error(
+				"failed to get latest execution payload for recovery",
+				"error", lphErr,
+			)
+			return
+		}
+		s.handleInvalidPayload(
+			ctx,
+			blk,
+			blk.GetBody().GetExecutionPayload().GetBlockHash(),
+			nil,
+			lph.GetBlockHash(),
+		)
+	}
+
+	if s.shouldBuildOptimisticPayloads() {
+		s.handleRebuildPayloadForRejectedBlock(ctx, st)
+	}
+}
+
 // handleRebuildPayloadForRejectedBlock handles the case where the incoming
 // block was rejected and we need to rebuild the payload for the current slot.
 func (s *Service[
@@ -189,7 +232,21 @@ func (s *Service[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) {
-	if err := s.optimisticPayloadBuild(ctx, st, blk); err != nil {
+	err := s.optimisticPayloadBuild(ctx, st, blk)
+	if isInvalidPayload(err) {
+		// The execution client found the payload of the proposed block
+		// INVALID when it was made the head, so we recover from its last
+		// valid ancestor. The block is not finalized yet, so its parent is
+		// still the finalized payload.
+		payload := blk.GetBody().GetExecutionPayload()
+		s.handleInvalidPayload(
+			ctx,
+			blk,
+			payload.GetBlockHash(),
+			nil,
+			payload.GetParentHash(),
+		)
+	} else if err != nil {
 		s.logger.Error(
 			"failed to build optimistic payload",
 			"for_slot", blk.GetSlot()+1,
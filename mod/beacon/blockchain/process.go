@@ -116,6 +116,11 @@ func (s *Service[
 		return nil, ErrDataNotAvailable
 	}
 
+	// Store the block so that it can still be served once finalized.
+	if err := s.bs.Set(blk); err != nil {
+		return nil, err
+	}
+
 	// emit new block event
 	s.blockFeed.Send(
 		// TODO: decouple from feed package.
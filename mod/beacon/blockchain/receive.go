@@ -108,10 +108,12 @@ func (s *Service[
 	// with the incoming block.
 	postState := preState.Copy()
 
-	// Verify the state root of the incoming block.
-	if err := s.verifyStateRoot(
-		ctx, postState, blk,
-	); err != nil {
+	// Verify the eth1 data and the state root of the incoming block.
+	err := s.verifyEth1Data(ctx, preState, blk)
+	if err == nil {
+		err = s.verifyStateRoot(ctx, postState, blk)
+	}
+	if err != nil {
 		s.logger.Error(
 			"rejecting incoming beacon block ❌ ",
 			"state_root",
@@ -120,10 +122,7 @@ func (s *Service[
 			err,
 		)
 
-		if s.shouldBuildOptimisticPayloads() {
-			go s.handleRebuildPayloadForRejectedBlock(ctx, preState)
-		}
-
+		go s.handleRejectedBlock(ctx, preState, blk, err)
 		return err
 	}
 
@@ -181,6 +180,75 @@ func (s *Service[
 	return nil
 }
 
+// verifyEth1Data verifies that the eth1 data of an incoming block commits to
+// the deposit contract as read from the execution client. The deposits of
+// the block are only verified against the deposit root of its eth1 data, so
+// blocks whose eth1 data cannot be verified because the local deposit store
+// is behind are rejected rather than accepted unverified.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) verifyEth1Data(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	eth1Data := blk.GetBody().GetEth1Data()
+	if eth1Data == nil {
+		return ErrNilEth1Data
+	}
+
+	// The eth1 data of the state has already been agreed upon.
+	current, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	} else if *eth1Data == *current {
+		return nil
+	}
+
+	ds := s.sb.DepositStore(ctx)
+	depositCount, ok, err := ds.GetEth1BlockDepositCount(eth1Data.BlockHash)
+	switch {
+	case err != nil:
+		return err
+	case !ok:
+		return errors.Wrapf(
+			ErrUnknownEth1Block, "block hash: %s", eth1Data.BlockHash,
+		)
+	case depositCount != eth1Data.DepositCount:
+		return errors.Wrapf(
+			ErrDepositCountMismatch,
+			"expected: %d, got: %d", depositCount, eth1Data.DepositCount,
+		)
+	}
+
+	stored, err := ds.GetDepositCount()
+	if err != nil {
+		return err
+	} else if stored < depositCount {
+		return errors.Wrapf(
+			ErrDepositStoreBehind,
+			"stored: %d, deposit count: %d", stored, depositCount,
+		)
+	}
+
+	depositRoot, err := ds.GetDepositRoot(depositCount)
+	if err != nil {
+		return err
+	} else if depositRoot != eth1Data.DepositRoot {
+		return errors.Wrapf(
+			ErrDepositRootMismatch,
+			"expected: %s, got: %s", depositRoot, eth1Data.DepositRoot,
+		)
+	}
+	return nil
+}
+
 // VerifyIncomingBlobs receives blobs from the network and processes them.
 func (s *Service[
 	AvailabilityStoreT,
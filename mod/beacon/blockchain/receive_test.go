@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// eth1TestState is a beacon state that only holds eth1 data.
+type eth1TestState struct {
+	ReadOnlyBeaconState[*eth1TestState]
+	eth1Data *types.Eth1Data
+}
+
+func (s *eth1TestState) GetEth1Data() (*types.Eth1Data, error) {
+	return s.eth1Data, nil
+}
+
+// eth1TestDepositStore is a deposit store that has synced count deposits
+// and has read the deposit contract at the given eth1 blocks.
+type eth1TestDepositStore struct {
+	DepositStore[*types.Deposit]
+	count      uint64
+	roots      map[uint64]common.Root
+	eth1Blocks map[common.ExecutionHash]uint64
+}
+
+func (s *eth1TestDepositStore) GetDepositCount() (uint64, error) {
+	return s.count, nil
+}
+
+func (s *eth1TestDepositStore) GetDepositRoot(
+	depositCount uint64,
+) (common.Root, error) {
+	return s.roots[depositCount], nil
+}
+
+func (s *eth1TestDepositStore) GetEth1BlockDepositCount(
+	blockHash common.ExecutionHash,
+) (uint64, bool, error) {
+	count, ok := s.eth1Blocks[blockHash]
+	return count, ok, nil
+}
+
+// eth1TestStorageBackend serves the deposit store.
+type eth1TestStorageBackend struct {
+	StorageBackend[
+		AvailabilityStore[*types.BeaconBlockBody, BlobSidecars],
+		*types.BeaconBlockBody,
+		*eth1TestState,
+		BlobSidecars,
+		*types.Deposit,
+		DepositStore[*types.Deposit],
+	]
+	ds *eth1TestDepositStore
+}
+
+func (b eth1TestStorageBackend) DepositStore(
+	context.Context,
+) DepositStore[*types.Deposit] {
+	return b.ds
+}
+
+// newTestDeposits returns deposits with proofs against the root of the
+// deposit tree made of them.
+func newTestDeposits(
+	t *testing.T,
+	pubkeys ...crypto.BLSPubkey,
+) ([]*types.Deposit, common.Root) {
+	t.Helper()
+	deposits := make([]*types.Deposit, len(pubkeys))
+	leaves := make([]common.Root, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		deposits[i] = &types.Deposit{
+			Pubkey: pubkey,
+			Amount: math.Gwei(32e9),
+			Index:  uint64(i),
+		}
+		leaf, err := deposits[i].GetDepositDataRoot()
+		require.NoError(t, err)
+		leaves[i] = leaf
+	}
+
+	tree, err := merkle.NewTreeFromLeavesWithDepth[common.Root, common.Root](
+		leaves, constants.DepositContractTreeDepth,
+	)
+	require.NoError(t, err)
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	for i, deposit := range deposits {
+		proof, proofErr := tree.MerkleProofWithMixin(uint64(i))
+		require.NoError(t, proofErr)
+		var branch [33]common.Root
+		for j := range proof {
+			branch[j] = proof[j]
+		}
+		deposit.SetProof(branch)
+	}
+	return deposits, root
+}
+
+func TestVerifyEth1Data(t *testing.T) {
+	var (
+		eth1Block = common.ExecutionHash{0x01}
+		state     = &types.Eth1Data{BlockHash: common.ExecutionHash{0x02}}
+		_, root   = newTestDeposits(
+			t, crypto.BLSPubkey{0x01}, crypto.BLSPubkey{0x02},
+		)
+		// The proposer made up deposits with valid proofs against a
+		// deposit root of its own.
+		fakeDeposits, fakeRoot = newTestDeposits(
+			t, crypto.BLSPubkey{0x03}, crypto.BLSPubkey{0x04},
+		)
+	)
+	for _, deposit := range fakeDeposits {
+		require.NoError(t, deposit.VerifyMerkleProof(fakeRoot))
+	}
+
+	tests := []struct {
+		name        string
+		eth1Data    *types.Eth1Data
+		deposits    []*types.Deposit
+		stored      uint64
+		expectedErr error
+	}{
+		{
+			name:     "eth1 data of the state",
+			eth1Data: state,
+		},
+		{
+			name: "eth1 data of the deposit contract",
+			eth1Data: &types.Eth1Data{
+				DepositRoot: root, DepositCount: 2, BlockHash: eth1Block,
+			},
+			stored: 2,
+		},
+		{
+			name: "made up deposit root",
+			eth1Data: &types.Eth1Data{
+				DepositRoot: fakeRoot, DepositCount: 2, BlockHash: eth1Block,
+			},
+			deposits:    fakeDeposits,
+			stored:      2,
+			expectedErr: ErrDepositRootMismatch,
+		},
+		{
+			name: "made up deposit count",
+			eth1Data: &types.Eth1Data{
+				DepositRoot: fakeRoot, DepositCount: 4, BlockHash: eth1Block,
+			},
+			deposits:    fakeDeposits,
+			stored:      4,
+			expectedErr: ErrDepositCountMismatch,
+		},
+		{
+			name: "unknown eth1 block",
+			eth1Data: &types.Eth1Data{
+				DepositRoot:  root,
+				DepositCount: 2,
+				BlockHash:    common.ExecutionHash{0x03},
+			},
+			stored:      2,
+			expectedErr: ErrUnknownEth1Block,
+		},
+		{
+			name: "deposit store is behind",
+			eth1Data: &types.Eth1Data{
+				DepositRoot: root, DepositCount: 2, BlockHash: eth1Block,
+			},
+			stored:      1,
+			expectedErr: ErrDepositStoreBehind,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service[
+				AvailabilityStore[*types.BeaconBlockBody, BlobSidecars],
+				*types.BeaconBlock,
+				*types.BeaconBlockBody,
+				*eth1TestState,
+				BlobSidecars,
+				*types.Deposit,
+				DepositStore[*types.Deposit],
+			]{
+				sb: eth1TestStorageBackend{ds: &eth1TestDepositStore{
+					count:      tt.stored,
+					roots:      map[uint64]common.Root{2: root},
+					eth1Blocks: map[common.ExecutionHash]uint64{eth1Block: 2},
+				}},
+			}
+			blk := &types.BeaconBlock{
+				RawBeaconBlock: &types.BeaconBlockDeneb{
+					Body: &types.BeaconBlockBodyDeneb{
+						BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+							Eth1Data: tt.eth1Data,
+							Deposits: tt.deposits,
+						},
+					},
+				},
+			}
+
+			err := s.verifyEth1Data(
+				context.Background(), &eth1TestState{eth1Data: state}, blk,
+			)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// isInvalidPayload returns true if the execution client reported the
+// payload behind the given error as INVALID.
+func isInvalidPayload(err error) bool {
+	return errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+	)
+}
+
+// handleInvalidPayload handles the case where the execution client rejected
+// the execution payload of the given block as INVALID. It must only be called
+// for blocks that have not been finalized, i.e. from process proposal or
+// when optimistically building on top of a proposed block.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) handleInvalidPayload(
+	ctx context.Context,
+	blk BeaconBlockT,
+	invalidHash common.ExecutionHash,
+	latestValidHash *common.ExecutionHash,
+	finalizedHash common.ExecutionHash,
+) {
+	s.metrics.markInvalidPayloadRecoveryStarted(blk.GetSlot())
+	if err := s.recoverFromInvalidPayload(
+		ctx, blk, invalidHash, latestValidHash, finalizedHash,
+	); err != nil {
+		s.metrics.markInvalidPayloadRecoveryFailure(blk.GetSlot(), err)
+		s.logger.Error(
+			"failed to recover from invalid payload",
+			"slot", blk.GetSlot(),
+			"invalid_hash", invalidHash,
+			"error", err,
+		)
+		return
+	}
+	s.metrics.markInvalidPayloadRecoverySuccess(blk.GetSlot())
+}
+
+// recoverFromInvalidPayload walks back from an invalid execution payload to
+// its last valid ancestor and forkchoices the execution client onto it. If
+// the latest valid hash is not known, it is queried from the execution
+// client by a forkchoice update to the invalid payload.
+//
+// The finalized payload is kept as the safe and finalized block of every
+// forkchoice update, so the recovery never moves the finalized head of the
+// execution client backwards. A valid ancestor below the finalized payload
+// can only be recovered from by rebuilding the finalized chain, hence it is
+// reported as an error instead.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) recoverFromInvalidPayload(
+	ctx context.Context,
+	blk BeaconBlockT,
+	invalidHash common.ExecutionHash,
+	latestValidHash *common.ExecutionHash,
+	finalizedHash common.ExecutionHash,
+) error {
+	var (
+		slot        = blk.GetSlot()
+		forkVersion = s.cs.ActiveForkVersionForSlot(slot)
+		parentHash  = blk.GetBody().GetExecutionPayload().GetParentHash()
+	)
+	if latestValidHash == nil {
+		latestValidHash = s.queryLatestValidHash(
+			ctx, invalidHash, finalizedHash, forkVersion,
+		)
+	}
+
+	validHash, err := lastValidAncestor(
+		invalidHash, latestValidHash, parentHash, finalizedHash,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Warn(
+		"recovering from invalid execution payload 🚑 ",
+		"slot", slot,
+		"invalid_hash", invalidHash,
+		"valid_ancestor_hash", validHash,
+	)
+
+	// Move the head of the execution client back onto the valid ancestor,
+	// so that it stops building on top of the invalid payload.
+	if _, _, err = s.ee.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash:      validHash,
+				SafeBlockHash:      finalizedHash,
+				FinalizedBlockHash: finalizedHash,
+			},
+			nil,
+			forkVersion,
+		),
+	); err != nil {
+		return errors.Join(
+			ErrInvalidPayloadRecovery,
+			errors.Wrapf(err, "forkchoice update to %s", validHash),
+		)
+	}
+
+	s.logger.Info(
+		"recovered from invalid execution payload 🩹 ",
+		"slot", slot,
+		"valid_ancestor_hash", validHash,
+	)
+	return nil
+}
+
+// queryLatestValidHash returns the latest valid hash that the execution
+// client reports for the invalid payload when it is made the head, or nil
+// if the execution client does not report the payload as INVALID.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) queryLatestValidHash(
+	ctx context.Context,
+	invalidHash common.ExecutionHash,
+	finalizedHash common.ExecutionHash,
+	forkVersion uint32,
+) *common.ExecutionHash {
+	_, latestValidHash, err := s.ee.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash:      invalidHash,
+				SafeBlockHash:      finalizedHash,
+				FinalizedBlockHash: finalizedHash,
+			},
+			nil,
+			forkVersion,
+		),
+	)
+	if !isInvalidPayload(err) {
+		return nil
+	}
+	return latestValidHash
+}
+
+// lastValidAncestor returns the hash of the last valid ancestor of an
+// invalid payload. The latest valid hash reported by the execution client
+// is preferred, falling back to the parent of the invalid payload when the
+// execution client did not report one.
+//
+// If the invalid payload extends the finalized payload, any valid ancestor
+// other than its parent lies below the finalized payload and is rejected.
+func lastValidAncestor(
+	invalidHash common.ExecutionHash,
+	latestValidHash *common.ExecutionHash,
+	parentHash common.ExecutionHash,
+	finalizedHash common.ExecutionHash,
+) (common.ExecutionHash, error) {
+	validHash := parentHash
+	if latestValidHash != nil &&
+		*latestValidHash != (common.ExecutionHash{}) &&
+		*latestValidHash != invalidHash {
+		validHash = *latestValidHash
+	}
+
+	switch {
+	case validHash == (common.ExecutionHash{}):
+		return common.ExecutionHash{}, ErrNoValidAncestor
+	case parentHash == finalizedHash && validHash != finalizedHash:
+		return common.ExecutionHash{}, errors.Wrapf(
+			ErrValidAncestorFinalized,
+			"valid ancestor: %s, finalized: %s", validHash, finalizedHash,
+		)
+	}
+	return validHash, nil
+}
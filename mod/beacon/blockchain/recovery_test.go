@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testBeaconState is a beacon state that is not accessed by the recovery.
+type testBeaconState struct {
+	ReadOnlyBeaconState[*testBeaconState]
+}
+
+type testService = Service[
+	AvailabilityStore[*types.BeaconBlockBody, BlobSidecars],
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+	*testBeaconState,
+	BlobSidecars,
+	*types.Deposit,
+	DepositStore[*types.Deposit],
+]
+
+// testChainSpec is a chain spec that is always on Deneb.
+type testChainSpec struct {
+	primitives.ChainSpec
+}
+
+func (testChainSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return version.Deneb
+}
+
+// testSink is a telemetry sink that drops all metrics.
+type testSink struct{}
+
+func (testSink) IncrementCounter(string, ...string)        {}
+func (testSink) SetGauge(string, int64, ...string)         {}
+func (testSink) MeasureSince(string, time.Time, ...string) {}
+
+// testEngine is an execution engine that records the forkchoice updates it
+// receives and answers them with the given function.
+type testEngine struct {
+	ExecutionEngine
+	forkchoiceUpdate func(
+		*engineprimitives.ForkchoiceStateV1,
+	) (*common.ExecutionHash, error)
+	states []engineprimitives.ForkchoiceStateV1
+}
+
+func (e *testEngine) NotifyForkchoiceUpdate(
+	_ context.Context,
+	req *engineprimitives.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	e.states = append(e.states, *req.State)
+	latestValidHash, err := e.forkchoiceUpdate(req.State)
+	return nil, latestValidHash, err
+}
+
+func TestLastValidAncestor(t *testing.T) {
+	var (
+		invalidHash   = common.ExecutionHash{0x01}
+		parentHash    = common.ExecutionHash{0x02}
+		validHash     = common.ExecutionHash{0x03}
+		finalizedHash = common.ExecutionHash{0x04}
+	)
+	tests := []struct {
+		name            string
+		latestValidHash *common.ExecutionHash
+		parentHash      common.ExecutionHash
+		expected        common.ExecutionHash
+		expectedErr     error
+	}{
+		{
+			name:            "reported latest valid hash",
+			latestValidHash: &validHash,
+			parentHash:      parentHash,
+			expected:        validHash,
+		},
+		{
+			name:       "no latest valid hash",
+			parentHash: parentHash,
+			expected:   parentHash,
+		},
+		{
+			name:            "zero latest valid hash",
+			latestValidHash: &common.ExecutionHash{},
+			parentHash:      parentHash,
+			expected:        parentHash,
+		},
+		{
+			name:            "latest valid hash is the invalid payload",
+			latestValidHash: &invalidHash,
+			parentHash:      parentHash,
+			expected:        parentHash,
+		},
+		{
+			name:            "latest valid hash is the finalized payload",
+			latestValidHash: &finalizedHash,
+			parentHash:      finalizedHash,
+			expected:        finalizedHash,
+		},
+		{
+			name:            "latest valid hash below the finalized payload",
+			latestValidHash: &validHash,
+			parentHash:      finalizedHash,
+			expectedErr:     ErrValidAncestorFinalized,
+		},
+		{
+			name:        "no valid ancestor",
+			expectedErr: ErrNoValidAncestor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := lastValidAncestor(
+				invalidHash, tt.latestValidHash, tt.parentHash, finalizedHash,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expected, hash)
+		})
+	}
+}
+
+func TestRecoverFromInvalidPayload(t *testing.T) {
+	var (
+		invalidHash   = common.ExecutionHash{0x01}
+		finalizedHash = common.ExecutionHash{0x02}
+		belowHash     = common.ExecutionHash{0x03}
+	)
+	tests := []struct {
+		name             string
+		latestValidHash  *common.ExecutionHash
+		forkchoiceUpdate func(
+			*engineprimitives.ForkchoiceStateV1,
+		) (*common.ExecutionHash, error)
+		expectedHeads []common.ExecutionHash
+		expectedErr   error
+	}{
+		{
+			name:            "reported latest valid hash",
+			latestValidHash: &finalizedHash,
+			forkchoiceUpdate: func(
+				*engineprimitives.ForkchoiceStateV1,
+			) (*common.ExecutionHash, error) {
+				return nil, nil
+			},
+			expectedHeads: []common.ExecutionHash{finalizedHash},
+		},
+		{
+			name: "latest valid hash queried by forkchoice update",
+			forkchoiceUpdate: func(
+				state *engineprimitives.ForkchoiceStateV1,
+			) (*common.ExecutionHash, error) {
+				if state.HeadBlockHash == invalidHash {
+					return &finalizedHash,
+						engineerrors.ErrInvalidPayloadStatus
+				}
+				return nil, nil
+			},
+			expectedHeads: []common.ExecutionHash{invalidHash, finalizedHash},
+		},
+		{
+			name:            "latest valid hash below the finalized payload",
+			latestValidHash: &belowHash,
+			expectedHeads:   []common.ExecutionHash{},
+			expectedErr:     ErrValidAncestorFinalized,
+		},
+		{
+			name:            "forkchoice update to valid ancestor fails",
+			latestValidHash: &finalizedHash,
+			forkchoiceUpdate: func(
+				*engineprimitives.ForkchoiceStateV1,
+			) (*common.ExecutionHash, error) {
+				return nil, errors.New("connection refused")
+			},
+			expectedHeads: []common.ExecutionHash{finalizedHash},
+			expectedErr:   ErrInvalidPayloadRecovery,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ee := &testEngine{forkchoiceUpdate: tt.forkchoiceUpdate}
+			s := &testService{
+				logger:  noop.NewLogger(),
+				cs:      testChainSpec{},
+				ee:      ee,
+				metrics: newChainMetrics(testSink{}),
+			}
+			blk, err := (&types.BeaconBlock{}).NewWithVersion(
+				1, 0, common.Root{}, version.Deneb,
+			)
+			require.NoError(t, err)
+			require.NoError(t, blk.GetBody().SetExecutionData(
+				&types.ExecutionPayload{
+					InnerExecutionPayload: &types.ExecutableDataDeneb{
+						ParentHash: finalizedHash,
+						BlockHash:  invalidHash,
+					},
+				},
+			))
+
+			err = s.recoverFromInvalidPayload(
+				context.Background(),
+				blk,
+				invalidHash,
+				tt.latestValidHash,
+				finalizedHash,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			heads := make([]common.ExecutionHash, 0, len(ee.states))
+			for _, state := range ee.states {
+				// The finalized head is never moved backwards.
+				require.Equal(t, finalizedHash, state.SafeBlockHash)
+				require.Equal(t, finalizedHash, state.FinalizedBlockHash)
+				heads = append(heads, state.HeadBlockHash)
+			}
+			require.Equal(t, tt.expectedHeads, heads)
+		})
+	}
+}
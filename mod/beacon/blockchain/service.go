@@ -53,6 +53,8 @@ type Service[
 		DepositT,
 		DepositStoreT,
 	]
+	// bs is the store of finalized beacon blocks.
+	bs BlockStore[BeaconBlockT]
 	// logger is used for logging messages in the service.
 	logger log.Logger[any]
 	// cs holds the chain specifications.
@@ -102,6 +104,7 @@ func NewService[
 		DepositT,
 		DepositStoreT,
 	],
+	bs BlockStore[BeaconBlockT],
 	logger log.Logger[any],
 	cs primitives.ChainSpec,
 	ee ExecutionEngine,
@@ -127,6 +130,7 @@ func NewService[
 		BlobSidecarsT, DepositT, DepositStoreT,
 	]{
 		sb:                      sb,
+		bs:                      bs,
 		logger:                  logger,
 		cs:                      cs,
 		ee:                      ee,
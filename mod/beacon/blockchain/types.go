@@ -60,6 +60,8 @@ type ReadOnlyBeaconState[T any] interface {
 		*types.ExecutionPayloadHeader,
 		error,
 	)
+	// GetEth1Data returns the eth1 data of the beacon state.
+	GetEth1Data() (*types.Eth1Data, error)
 	// GetEth1DepositIndex returns the index of the most recent eth1 deposit.
 	GetEth1DepositIndex() (uint64, error)
 	// GetLatestBlockHeader returns the most recent block header.
@@ -103,12 +105,30 @@ type BlobSidecars interface {
 	Len() int
 }
 
+// BlockStore defines the interface for storing finalized beacon blocks.
+type BlockStore[BeaconBlockT any] interface {
+	// Set stores the given block.
+	Set(blk BeaconBlockT) error
+}
+
 // DepositStore defines the interface for managing deposit operations.
 type DepositStore[DepositT any] interface {
 	// Prune prunes the deposit store of [start, end)
 	Prune(start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetDepositCount returns the number of deposits synced from the
+	// deposit contract without gaps.
+	GetDepositCount() (uint64, error)
+	// GetDepositRoot returns the root of the deposit tree made of the first
+	// depositCount deposits.
+	GetDepositRoot(depositCount uint64) (common.Root, error)
+	// GetEth1BlockDepositCount returns the deposit count of the deposit
+	// contract at the recent execution block with the given hash, or false
+	// if the deposit contract has not been read at that block.
+	GetEth1BlockDepositCount(
+		blockHash common.ExecutionHash,
+	) (uint64, bool, error)
 }
 
 // ExecutionEngine is the interface for the execution engine.
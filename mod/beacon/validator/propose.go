@@ -28,7 +28,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -113,10 +113,34 @@ func (s *Service[
 		return blk, sidecars, ErrNilDepositIndexStart
 	}
 
-	// Dequeue deposits from the state.
-	deposits, err := s.bsb.DepositStore(ctx).GetDepositsByIndex(
+	// Assemble the eth1 data, which is applied to the state before the
+	// deposits of the block are processed.
+	eth1Data, err := s.buildEth1Data(ctx, st)
+	if err != nil {
+		return blk, sidecars, err
+	}
+	body.SetEth1Data(eth1Data)
+
+	// Once deposits are processed from the deposit requests of the
+	// execution payload, the deposit contract logs are only used to catch up
+	// with the deposits made before the first deposit request.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return blk, sidecars, err
+	}
+	var numDeposits uint64
+	if depositIndex < startIndex {
+		numDeposits = min(
+			s.chainSpec.MaxDepositsPerBlock(), startIndex-depositIndex,
+		)
+	}
+
+	// Dequeue the deposits committed to by the eth1 data, along with their
+	// proofs against its deposit root.
+	deposits, err := s.bsb.DepositStore(ctx).GetDepositsWithProofs(
 		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
+		numDeposits,
+		eth1Data.DepositCount,
 	)
 	if err != nil {
 		return blk, sidecars, err
@@ -125,16 +149,16 @@ func (s *Service[
 	// Set the deposits on the block body.
 	body.SetDeposits(deposits)
 
+	// Set the BLS to execution changes from the pool on the block body.
+	changes, err := s.buildBLSToExecutionChanges(st)
+	if err != nil {
+		return blk, sidecars, err
+	}
+	body.SetBLSToExecutionChanges(changes)
+
 	// Set the KZG commitments on the block body.
 	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
 
-	// TODO: assemble real eth1data.
-	body.SetEth1Data(&types.Eth1Data{
-		DepositRoot:  primitives.Bytes32{},
-		DepositCount: 0,
-		BlockHash:    common.ZeroHash,
-	})
-
 	// Set the execution data.
 	if err = body.SetExecutionData(
 		envelope.GetExecutionPayload(),
@@ -295,3 +319,124 @@ func (s *Service[
 	}
 	return envelope, nil
 }
+
+// buildEth1Data assembles the eth1 data of the block proposed on top of the
+// given state from the state of the deposit contract at the follow distance.
+// If the deposit contract is not ahead of the state, or if the deposit store
+// has not synced the deposits it commits to yet, the proposer skips its vote
+// and the eth1 data of the state is kept.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, ForkDataT,
+]) buildEth1Data(
+	ctx context.Context,
+	st BeaconStateT,
+) (*types.Eth1Data, error) {
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return nil, err
+	}
+
+	ds := s.bsb.DepositStore(ctx)
+	blockHash, depositCount, err := ds.GetEth1Block()
+	if err != nil {
+		s.logger.Warn(
+			"failed to get eth1 block, reusing eth1 data of the state",
+			"error", err,
+		)
+		return eth1Data, nil
+	} else if depositCount < eth1Data.DepositCount {
+		return eth1Data, nil
+	}
+
+	stored, err := ds.GetDepositCount()
+	if err != nil {
+		s.logger.Warn(
+			"failed to get deposit count, reusing eth1 data of the state",
+			"error", err,
+		)
+		return eth1Data, nil
+	} else if stored < depositCount {
+		s.logger.Info(
+			"deposit store is behind the deposit contract, skipping eth1 vote",
+			"stored", stored,
+			"deposit_count", depositCount,
+		)
+		return eth1Data, nil
+	}
+
+	depositRoot, err := ds.GetDepositRoot(depositCount)
+	if err != nil {
+		s.logger.Warn(
+			"failed to get deposit root, reusing eth1 data of the state",
+			"deposit_count", depositCount,
+			"error", err,
+		)
+		return eth1Data, nil
+	}
+
+	return eth1Data.New(depositRoot, math.U64(depositCount), blockHash), nil
+}
+
+// buildBLSToExecutionChanges selects the BLS to execution changes pending in
+// the pool that apply to the given state, up to the maximum allowed per
+// block. Changes that no longer apply, either because they were included in
+// a previous block or because they are invalid, are dropped from the pool.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, ForkDataT,
+]) buildBLSToExecutionChanges(
+	st BeaconStateT,
+) ([]*types.SignedBLSToExecutionChange, error) {
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	// The changes are signed over the genesis fork version.
+	forkData := types.NewForkData(
+		version.FromUint32[primitives.Version](
+			s.chainSpec.ActiveForkVersionForEpoch(
+				math.Epoch(constants.GenesisEpoch),
+			),
+		), genesisValidatorsRoot,
+	)
+
+	var (
+		maxChanges = s.chainSpec.MaxBLSToExecutionChangesPerBlock()
+		changes    = make([]*types.SignedBLSToExecutionChange, 0)
+		stale      = make([]math.ValidatorIndex, 0)
+	)
+	for _, change := range s.blsToExecutionChangePool.Pending() {
+		if uint64(len(changes)) >= maxChanges {
+			break
+		}
+
+		idx := change.GetValidatorIndex()
+		val, valErr := st.ValidatorByIndex(idx)
+		if valErr != nil ||
+			val.GetWithdrawalCredentials() !=
+				change.GetFromWithdrawalCredentials() {
+			stale = append(stale, idx)
+			continue
+		}
+
+		if err = change.VerifySignature(
+			forkData,
+			s.chainSpec.DomainTypeBLSToExecutionChange(),
+			s.signer.VerifySignature,
+		); err != nil {
+			s.logger.Warn(
+				"dropping invalid bls to execution change",
+				"validator_index", idx,
+				"error", err,
+			)
+			stale = append(stale, idx)
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	s.blsToExecutionChangePool.Remove(stale...)
+	return changes, nil
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -35,7 +36,8 @@ import (
 type Service[
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		*types.Deposit, *types.Eth1Data, *types.ExecutionPayload,
+		*types.SignedBLSToExecutionChange, *types.Deposit, *types.Eth1Data,
+		*types.ExecutionPayload,
 	],
 	BeaconStateT BeaconState[
 		*types.BeaconBlockHeader,
@@ -85,6 +87,9 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, *types.ExecutionPayload]
+	// blsToExecutionChangePool is the pool of BLS to execution changes
+	// pending inclusion in a block.
+	blsToExecutionChangePool *pool.Pool[*types.SignedBLSToExecutionChange]
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 }
@@ -93,7 +98,8 @@ type Service[
 func NewService[
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		*types.Deposit, *types.Eth1Data, *types.ExecutionPayload],
+		*types.SignedBLSToExecutionChange, *types.Deposit, *types.Eth1Data,
+		*types.ExecutionPayload],
 	BeaconStateT BeaconState[
 		*types.BeaconBlockHeader,
 		BeaconStateT,
@@ -124,6 +130,7 @@ func NewService[
 	],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, *types.ExecutionPayload],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, *types.ExecutionPayload],
+	blsToExecutionChangePool *pool.Pool[*types.SignedBLSToExecutionChange],
 	ts TelemetrySink,
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -133,17 +140,18 @@ func NewService[
 		BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 		BlobSidecarsT, DepositStoreT, ForkDataT,
 	]{
-		cfg:                   cfg,
-		logger:                logger,
-		blobProcessor:         blobProcessor,
-		bsb:                   bsb,
-		chainSpec:             chainSpec,
-		signer:                signer,
-		stateProcessor:        stateProcessor,
-		blobFactory:           blobFactory,
-		localPayloadBuilder:   localPayloadBuilder,
-		remotePayloadBuilders: remotePayloadBuilders,
-		metrics:               newValidatorMetrics(ts),
+		cfg:                      cfg,
+		logger:                   logger,
+		blobProcessor:            blobProcessor,
+		bsb:                      bsb,
+		chainSpec:                chainSpec,
+		signer:                   signer,
+		stateProcessor:           stateProcessor,
+		blobFactory:              blobFactory,
+		localPayloadBuilder:      localPayloadBuilder,
+		remotePayloadBuilders:    remotePayloadBuilders,
+		blsToExecutionChangePool: blsToExecutionChangePool,
+		metrics:                  newValidatorMetrics(ts),
 	}
 }
 
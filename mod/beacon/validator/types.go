@@ -37,7 +37,8 @@ import (
 
 // BeaconBlock represents a beacon block interface.
 type BeaconBlock[BeaconBlockT any, BeaconBlockBodyT BeaconBlockBody[
-	*types.Deposit, *types.Eth1Data, *types.ExecutionPayload,
+	*types.SignedBLSToExecutionChange, *types.Deposit, *types.Eth1Data,
+	*types.ExecutionPayload,
 ]] interface {
 	ssz.Marshallable
 	// NewWithVersion creates a new beacon block with the given parameters.
@@ -69,7 +70,7 @@ type BeaconBlock[BeaconBlockT any, BeaconBlockBodyT BeaconBlockBody[
 
 // BeaconBlockBody represents a beacon block body interface.
 type BeaconBlockBody[
-	DepositT, Eth1DataT, ExecutionPayloadT any,
+	BLSToExecutionChangeT, DepositT, Eth1DataT, ExecutionPayloadT any,
 ] interface {
 	ssz.Marshallable
 	// IsNil checks if the beacon block body is nil.
@@ -82,6 +83,9 @@ type BeaconBlockBody[
 	GetDeposits() []DepositT
 	// SetDeposits sets the deposits of the beacon block body.
 	SetDeposits([]DepositT)
+	// SetBLSToExecutionChanges sets the BLS to execution changes of the
+	// beacon block body.
+	SetBLSToExecutionChanges([]BLSToExecutionChangeT)
 	// SetExecutionData sets the execution data of the beacon block body.
 	SetExecutionData(ExecutionPayloadT) error
 	// GetBlobKzgCommitments returns the blob KZG commitments of the beacon
@@ -120,9 +124,16 @@ type BeaconState[
 	HashTreeRoot() ([32]byte, error)
 	// ValidatorIndexByPubkey returns the validator index by public key.
 	ValidatorIndexByPubkey(crypto.BLSPubkey) (math.ValidatorIndex, error)
+	// ValidatorByIndex returns the validator at the given index.
+	ValidatorByIndex(math.ValidatorIndex) (*types.Validator, error)
 	// GetEth1DepositIndex returns the latest deposit index from the beacon
 	// state.
 	GetEth1DepositIndex() (uint64, error)
+	// GetDepositRequestsStartIndex returns the index of the first deposit
+	// processed from an execution payload deposit request.
+	GetDepositRequestsStartIndex() (uint64, error)
+	// GetEth1Data returns the eth1 data of the beacon state.
+	GetEth1Data() (*types.Eth1Data, error)
 	// GetGenesisValidatorsRoot returns the genesis validators root.
 	GetGenesisValidatorsRoot() (primitives.Root, error)
 }
@@ -131,7 +142,8 @@ type BeaconState[
 type BlobFactory[
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		*types.Deposit, *types.Eth1Data, *types.ExecutionPayload,
+		*types.SignedBLSToExecutionChange, *types.Deposit, *types.Eth1Data,
+		*types.ExecutionPayload,
 	],
 	BlobSidecarsT BlobSidecars,
 ] interface {
@@ -170,6 +182,23 @@ type DepositStore[DepositT any] interface {
 		startIndex uint64,
 		numView uint64,
 	) ([]DepositT, error)
+	// GetDepositsWithProofs returns `numView` expected deposits along with
+	// their Merkle proofs against the tree of the first `depositCount`
+	// deposits.
+	GetDepositsWithProofs(
+		startIndex uint64,
+		numView uint64,
+		depositCount uint64,
+	) ([]DepositT, error)
+	// GetDepositCount returns the number of deposits synced from the
+	// deposit contract without gaps.
+	GetDepositCount() (uint64, error)
+	// GetDepositRoot returns the root of the deposit tree made of the first
+	// `depositCount` deposits.
+	GetDepositRoot(depositCount uint64) (common.Root, error)
+	// GetEth1Block returns the hash of the latest execution block at which
+	// the deposit contract has been read, along with its deposit count.
+	GetEth1Block() (common.ExecutionHash, uint64, error)
 }
 
 // PayloadBuilder represents a service that is responsible for
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+)
+
+// depositBackend is the execution client used to broadcast the deposit
+// transaction and wait for its inclusion.
+type depositBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// dialExecutionClient dials the engine RPC of the execution client,
+// authenticating with the JWT secret at the configured path.
+func dialExecutionClient(
+	ctx context.Context,
+	cmd *cobra.Command,
+) (*ethclient.Client, error) {
+	url, err := cmd.Flags().GetString(engineRPCURL)
+	if err != nil {
+		return nil, err
+	}
+
+	secretPath, err := cmd.Flags().GetString(jwtSecretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := components.LoadJWTFromFile(secretPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load jwt secret")
+	}
+
+	rpcClient, err := rpc.DialOptions(
+		ctx, url, rpc.WithHTTPAuth(func(h http.Header) error {
+			token, tErr := jwt.BuildSignedJWT(secret)
+			if tErr != nil {
+				return tErr
+			}
+			h.Set("Authorization", "Bearer "+token)
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial execution client")
+	}
+
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// getTransactor returns the transactor used to sign and pay for the deposit
+// transaction. The private key flag takes precedence over the keystore.
+func getTransactor(
+	cmd *cobra.Command,
+	chainID *big.Int,
+) (*bind.TransactOpts, error) {
+	privKeyHex, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if privKeyHex != "" {
+		var privKey *ecdsa.PrivateKey
+		privKey, err = ethcrypto.HexToECDSA(
+			strings.TrimPrefix(privKeyHex, "0x"),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse private key")
+		}
+		return bind.NewKeyedTransactorWithChainID(privKey, chainID)
+	}
+
+	keystoreFile, err := cmd.Flags().GetString(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+	if keystoreFile == "" {
+		return nil, ErrTransactorKeyRequired
+	}
+
+	passwordFile, err := cmd.Flags().GetString(keystorePasswordPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var password []byte
+	if passwordFile != "" {
+		if password, err = os.ReadFile(passwordFile); err != nil {
+			return nil, errors.Wrap(err, "failed to read keystore password")
+		}
+	}
+
+	keystore, err := os.Open(keystoreFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open keystore")
+	}
+	defer keystore.Close()
+
+	return bind.NewTransactorWithChainID(
+		keystore, strings.TrimSpace(string(password)), chainID,
+	)
+}
+
+// broadcastDepositTx submits the deposit to the deposit contract, waits for
+// the transaction to be included and verifies the emitted Deposit event.
+func broadcastDepositTx(
+	ctx context.Context,
+	client depositBackend,
+	contractAddress common.ExecutionAddress,
+	transactor *bind.TransactOpts,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) (*ethtypes.Transaction, *deposit.BeaconDepositContractDeposit, error) {
+	contract, err := deposit.NewBeaconDepositContract(
+		contractAddress, client,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The contract derives the deposit amount from the value sent.
+	opts := *transactor
+	opts.Context = ctx
+	opts.Value = depositMsg.Amount.ToWei()
+
+	tx, err := contract.Deposit(
+		&opts,
+		depositMsg.Pubkey[:],
+		depositMsg.Credentials[:],
+		depositMsg.Amount.Unwrap(),
+		signature[:],
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to send deposit transaction")
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return tx, nil, errors.Wrap(err, "failed to wait for deposit inclusion")
+	}
+
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return tx, nil, ErrDepositTransactionFailed
+	}
+
+	event, err := findDepositEvent(contract, contractAddress, receipt)
+	if err != nil {
+		return tx, nil, err
+	}
+
+	return tx, event, verifyDepositEvent(event, depositMsg, signature)
+}
+
+// findDepositEvent returns the Deposit event emitted by the deposit contract
+// in the given receipt.
+func findDepositEvent(
+	contract *deposit.BeaconDepositContract,
+	contractAddress common.ExecutionAddress,
+	receipt *ethtypes.Receipt,
+) (*deposit.BeaconDepositContractDeposit, error) {
+	for _, log := range receipt.Logs {
+		if log.Address != contractAddress {
+			continue
+		}
+		if event, err := contract.ParseDeposit(*log); err == nil {
+			return event, nil
+		}
+	}
+	return nil, ErrDepositEventNotFound
+}
+
+// verifyDepositEvent checks that the emitted Deposit event matches the
+// deposit that was broadcast.
+func verifyDepositEvent(
+	event *deposit.BeaconDepositContractDeposit,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) error {
+	if !bytes.Equal(event.Pubkey, depositMsg.Pubkey[:]) ||
+		!bytes.Equal(event.Credentials, depositMsg.Credentials[:]) ||
+		!bytes.Equal(event.Signature, signature[:]) ||
+		event.Amount != depositMsg.Amount.Unwrap() {
+		return ErrDepositEventMismatch
+	}
+	return nil
+}
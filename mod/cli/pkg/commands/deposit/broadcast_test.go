@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// genesisFile is the execution genesis of the devnet, which deploys
+	// the deposit contract.
+	genesisFile = "../../../../../testing/files/eth-genesis.json"
+	// depositAuthSlot is the storage slot of the mapping of the number of
+	// deposits an address is allowed to make in the deposit contract.
+	depositAuthSlot = 1
+)
+
+var contractAddress = common.ExecutionAddress{
+	0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42,
+	0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42,
+}
+
+// newTestDeposit returns a deposit message and signature with distinct
+// non-zero fields.
+func newTestDeposit() (*types.DepositMessage, crypto.BLSSignature) {
+	msg := &types.DepositMessage{
+		Pubkey:      crypto.BLSPubkey{0x01},
+		Credentials: types.WithdrawalCredentials{0x02},
+		Amount:      math.Gwei(32e9),
+	}
+	return msg, crypto.BLSSignature{0x03}
+}
+
+// depositContractCode returns the runtime bytecode of the deposit contract
+// as deployed by the devnet genesis.
+func depositContractCode(t *testing.T) []byte {
+	t.Helper()
+	bz, err := os.ReadFile(genesisFile)
+	require.NoError(t, err)
+	var genesis struct {
+		Alloc map[ethcommon.Address]struct {
+			Code hexutil.Bytes `json:"code"`
+		} `json:"alloc"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &genesis))
+	code := genesis.Alloc[ethcommon.Address(contractAddress)].Code
+	require.NotEmpty(t, code)
+	return code
+}
+
+// newTestBackend returns a simulated backend with a funded account and the
+// deposit contract, which allows the account to make the given number of
+// deposits. Blocks are committed periodically until the test completes.
+func newTestBackend(
+	t *testing.T,
+	allowedDeposits uint64,
+) (*simulated.Backend, *bind.TransactOpts) {
+	t.Helper()
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	depositor := ethcrypto.PubkeyToAddress(key.PublicKey)
+	balance, _ := new(big.Int).SetString("1000000000000000000000", 10)
+
+	// The depositor is authorized by writing the allowance to storage, as
+	// the contract is not owned by any known key.
+	authSlot := ethcrypto.Keccak256Hash(
+		ethcommon.LeftPadBytes(depositor[:], 32),
+		ethcommon.LeftPadBytes(big.NewInt(depositAuthSlot).Bytes(), 32),
+	)
+	backend := simulated.NewBackend(ethtypes.GenesisAlloc{
+		depositor: {Balance: balance},
+		ethcommon.Address(contractAddress): {
+			Code: depositContractCode(t),
+			Storage: map[ethcommon.Hash]ethcommon.Hash{
+				authSlot: ethcommon.BigToHash(
+					new(big.Int).SetUint64(allowedDeposits),
+				),
+			},
+		},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	// The genesis block predates the merge, so the contract cannot run on
+	// top of it.
+	backend.Commit()
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+	transactor, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				backend.Commit()
+			}
+		}
+	}()
+
+	return backend, transactor
+}
+
+func TestBroadcastDepositTx(t *testing.T) {
+	msg, signature := newTestDeposit()
+	backend, transactor := newTestBackend(t, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The contract derives the amount of the event from the value sent.
+	tx, event, err := broadcastDepositTx(
+		ctx, backend.Client(), contractAddress, transactor, msg, signature,
+	)
+	require.NoError(t, err)
+	require.Equal(t, msg.Amount.ToWei(), tx.Value())
+	require.Equal(t, contractAddress, *tx.To())
+	require.Equal(t, msg.Pubkey[:], event.Pubkey)
+	require.Equal(t, msg.Credentials[:], event.Credentials)
+	require.Equal(t, msg.Amount.Unwrap(), event.Amount)
+	require.Equal(t, signature[:], event.Signature)
+	require.Zero(t, event.Index)
+}
+
+func TestBroadcastDepositTx_Reverted(t *testing.T) {
+	msg, signature := newTestDeposit()
+	// The depositor is not authorized, so the contract reverts.
+	backend, transactor := newTestBackend(t, 0)
+	// Skip gas estimation, which would fail on the revert.
+	transactor.GasLimit = 1_000_000
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, _, err := broadcastDepositTx(
+		ctx, backend.Client(), contractAddress, transactor, msg, signature,
+	)
+	require.ErrorIs(t, err, ErrDepositTransactionFailed)
+}
+
+func TestFindDepositEvent_NotFound(t *testing.T) {
+	contract, err := deposit.NewBeaconDepositContract(contractAddress, nil)
+	require.NoError(t, err)
+
+	_, err = findDepositEvent(
+		contract, contractAddress, &ethtypes.Receipt{
+			Logs: []*ethtypes.Log{{Address: ethcommon.Address{0x01}}},
+		},
+	)
+	require.ErrorIs(t, err, ErrDepositEventNotFound)
+}
+
+func TestVerifyDepositEvent(t *testing.T) {
+	msg, signature := newTestDeposit()
+	event := &deposit.BeaconDepositContractDeposit{
+		Pubkey:      msg.Pubkey[:],
+		Credentials: msg.Credentials[:],
+		Amount:      msg.Amount.Unwrap(),
+		Signature:   signature[:],
+	}
+	require.NoError(t, verifyDepositEvent(event, msg, signature))
+
+	event.Amount = math.Gwei(1e9).Unwrap()
+	require.ErrorIs(
+		t, verifyDepositEvent(event, msg, signature), ErrDepositEventMismatch,
+	)
+}
@@ -21,6 +21,7 @@
 package deposit
 
 import (
+	"context"
 	"os"
 
 	"cosmossdk.io/depinject"
@@ -45,7 +46,9 @@ func NewCreateValidator(chainSpec primitives.ChainSpec) *cobra.Command {
 		Long: `Creates a validator deposit with the necessary credentials. The 
 		arguments are expected in the order of withdrawal credentials, deposit
 		amount, current version, and genesis validator root. If the broadcast
-		flag is set to true, a private key must be provided to sign the transaction.`,
+		flag is set to true, a private key or keystore must be provided to sign
+		the transaction, which is sent to the deposit contract over the engine
+		RPC of the execution client.`,
 		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
 		RunE: createValidatorCmd(chainSpec),
 	}
@@ -63,16 +66,20 @@ func NewCreateValidator(chainSpec primitives.ChainSpec) *cobra.Command {
 		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
 	cmd.Flags().String(jwtSecretPath, defaultJWTSecretPath, jwtSecretPathMsg)
 	cmd.Flags().String(engineRPCURL, defaultEngineRPCURL, engineRPCURLMsg)
+	cmd.Flags().String(keystorePath, defaultKeystorePath, keystorePathMsg)
+	cmd.Flags().String(
+		keystorePasswordPath, defaultKeystorePasswordPath,
+		keystorePasswordPathMsg,
+	)
+	cmd.Flags().Duration(
+		inclusionTimeout, defaultInclusionTimeout, inclusionTimeoutMsg,
+	)
 
 	return cmd
 }
 
-// createValidatorCmd returns a command that builds a create validator request.
-//
-// TODO: Implement broadcast functionality. Currently, the implementation works
-// for the geth client but something about the Deposit binding is not handling
-// other execution layers correctly. Peep the commit history for what we had.
-// 🤷‍♂️.
+// createValidatorCmd returns a command that builds a create validator request
+// and optionally broadcasts it to the deposit contract.
 func createValidatorCmd(
 	chainSpec primitives.ChainSpec,
 ) func(*cobra.Command, []string) error {
@@ -129,21 +136,89 @@ func createValidatorCmd(
 			return err
 		}
 
+		broadcast, err := cmd.Flags().GetBool(broadcastDeposit)
+		if err != nil {
+			return err
+		}
+
 		// If the broadcast flag is not set, output the deposit message and
 		// signature and return early.
-		logger.Info(
-			"Deposit Message CallData",
-			"pubkey", depositMsg.Pubkey.String(),
-			"withdrawal credentials", depositMsg.Credentials.String(),
-			"amount", depositMsg.Amount,
-			"signature", signature.String(),
+		if !broadcast {
+			logger.Info(
+				"Deposit Message CallData",
+				"pubkey", depositMsg.Pubkey.String(),
+				"withdrawal credentials", depositMsg.Credentials.String(),
+				"amount", depositMsg.Amount,
+				"signature", signature.String(),
+			)
+			logger.Info("Send the above calldata to the deposit contract 🫡")
+			return nil
+		}
+
+		return broadcastDepositCmd(
+			cmd, logger, chainSpec, depositMsg, signature,
 		)
+	}
+}
+
+// broadcastDepositCmd signs and sends the deposit transaction over the
+// configured execution client and logs the verified Deposit event.
+func broadcastDepositCmd(
+	cmd *cobra.Command,
+	logger log.Logger,
+	chainSpec primitives.ChainSpec,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) error {
+	timeout, err := cmd.Flags().GetDuration(inclusionTimeout)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-		// TODO: once broadcast is fixed, remove this.
-		logger.Info("Send the above calldata to the deposit contract 🫡")
+	client, err := dialExecutionClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
 
-		return nil
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
 	}
+
+	transactor, err := getTransactor(cmd, chainID)
+	if err != nil {
+		return err
+	}
+
+	tx, event, err := broadcastDepositTx(
+		ctx,
+		client,
+		chainSpec.DepositContractAddress(),
+		transactor,
+		depositMsg,
+		signature,
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"Deposit included 🫡",
+		"tx", tx.Hash().Hex(),
+		"block", event.Raw.BlockNumber,
+		"index", event.Index,
+		"pubkey", depositMsg.Pubkey.String(),
+		"amount", depositMsg.Amount,
+	)
+	return nil
 }
 
 // getBLSSigner returns a BLS signer based on the override commands key flag.
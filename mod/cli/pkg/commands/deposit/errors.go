@@ -28,4 +28,24 @@ var (
 	ErrValidatorPrivateKeyRequired = errors.New(
 		"validator private key required",
 	)
+
+	// ErrTransactorKeyRequired is returned when the deposit is to be
+	// broadcast but neither a private key nor a keystore is provided.
+	ErrTransactorKeyRequired = errors.New(
+		"private key or keystore required to broadcast deposit",
+	)
+
+	// ErrDepositTransactionFailed is returned when the deposit transaction
+	// is included but its execution reverted.
+	ErrDepositTransactionFailed = errors.New("deposit transaction failed")
+
+	// ErrDepositEventNotFound is returned when the receipt of the deposit
+	// transaction does not contain a Deposit event.
+	ErrDepositEventNotFound = errors.New("deposit event not found")
+
+	// ErrDepositEventMismatch is returned when the emitted Deposit event does
+	// not match the deposit that was broadcast.
+	ErrDepositEventMismatch = errors.New(
+		"deposit event does not match deposit",
+	)
 )
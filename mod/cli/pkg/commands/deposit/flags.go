@@ -20,6 +20,8 @@
 
 package deposit
 
+import "time"
+
 const (
 	// broadcastDeposit is the flag for broadcasting a deposit transaction.
 	broadcastDeposit = "broadcast"
@@ -38,6 +40,18 @@ const (
 
 	// engineRPCURL is the flag for the URL for the engine RPC.
 	engineRPCURL = "engine-rpc-url"
+
+	// keystorePath is the flag for the path to the keystore file used to
+	// sign the deposit transaction.
+	keystorePath = "keystore"
+
+	// keystorePasswordPath is the flag for the path to the file containing
+	// the keystore password.
+	keystorePasswordPath = "keystore-password-file"
+
+	// inclusionTimeout is the flag for how long to wait for the deposit
+	// transaction to be included.
+	inclusionTimeout = "inclusion-timeout"
 )
 
 const (
@@ -71,6 +85,17 @@ const (
 
 	// defaultEngineRPCURL is the default value for the engineRPCURL flag.
 	defaultEngineRPCURL = "http://localhost:8551"
+
+	// defaultKeystorePath is the default value for the keystorePath flag.
+	defaultKeystorePath = ""
+
+	// defaultKeystorePasswordPath is the default value for the
+	// keystorePasswordPath flag.
+	defaultKeystorePasswordPath = ""
+
+	// defaultInclusionTimeout is the default value for the inclusionTimeout
+	// flag.
+	defaultInclusionTimeout = 2 * time.Minute
 )
 
 const (
@@ -97,4 +122,17 @@ const (
 
 	// engineRPCURLMsg is the usage description for the engineRPCURL flag.
 	engineRPCURLMsg = "URL for the engine RPC"
+
+	// keystorePathMsg is the usage description for the keystorePath flag.
+	keystorePathMsg = `keystore file to sign and pay for the deposit message.
+	Used if the broadcast flag is set and no private key is provided.`
+
+	// keystorePasswordPathMsg is the usage description for the
+	// keystorePasswordPath flag.
+	// #nosec G101 // This is a descriptor
+	keystorePasswordPathMsg = "path to the file containing the keystore password"
+
+	// inclusionTimeoutMsg is the usage description for the inclusionTimeout
+	// flag.
+	inclusionTimeoutMsg = "time to wait for the deposit transaction inclusion"
 )
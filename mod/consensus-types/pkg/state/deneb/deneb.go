@@ -60,4 +60,8 @@ type BeaconState struct {
 	// Slashing
 	Slashings     []uint64  `json:"slashings"     ssz-max:"1099511627776"`
 	TotalSlashing math.Gwei `json:"totalSlashing"`
+
+	// Participation
+	EpochParticipation []uint64 `json:"epochParticipation" ssz-max:"1099511627776"`
+	InactivityScores   []uint64 `json:"inactivityScores"   ssz-max:"1099511627776"`
 }
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 2e3616dd20a792ee1ba01d4cef5ec208385bfcde19df61a2f8331a161221a17e
+// Hash: 28f0ec18fd532d60ae05e4d10f0092b028b9b1d3790449305b0fe0ba0ae05727
 // Version: 0.1.3
 package deneb
 
@@ -18,7 +18,7 @@ func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the BeaconState object to a target array
 func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(300)
+	offset := int(308)
 
 	// Field (0) 'GenesisValidatorsRoot'
 	dst = append(dst, b.GenesisValidatorsRoot[:]...)
@@ -88,10 +88,18 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Offset (14) 'Slashings'
 	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Slashings) * 8
 
 	// Field (15) 'TotalSlashing'
 	dst = ssz.MarshalUint64(dst, uint64(b.TotalSlashing))
 
+	// Offset (16) 'EpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.EpochParticipation) * 8
+
+	// Offset (17) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+
 	// Field (4) 'BlockRoots'
 	if size := len(b.BlockRoots); size > 8192 {
 		err = ssz.ErrListTooBigFn("BeaconState.BlockRoots", size, 8192)
@@ -153,6 +161,24 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
 	}
 
+	// Field (16) 'EpochParticipation'
+	if size := len(b.EpochParticipation); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.EpochParticipation", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.EpochParticipation); ii++ {
+		dst = ssz.MarshalUint64(dst, b.EpochParticipation[ii])
+	}
+
+	// Field (17) 'InactivityScores'
+	if size := len(b.InactivityScores); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+	}
+
 	return
 }
 
@@ -160,12 +186,12 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 300 {
+	if size < 308 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o4, o5, o8, o9, o10, o11, o14 uint64
+	var o4, o5, o8, o9, o10, o11, o14, o16, o17 uint64
 
 	// Field (0) 'GenesisValidatorsRoot'
 	copy(b.GenesisValidatorsRoot[:], buf[0:32])
@@ -194,7 +220,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 300 {
+	if o4 < 308 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -248,6 +274,16 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	// Field (15) 'TotalSlashing'
 	b.TotalSlashing = math.Gwei(ssz.UnmarshallUint64(buf[292:300]))
 
+	// Offset (16) 'EpochParticipation'
+	if o16 = ssz.ReadOffset(buf[300:304]); o16 > size || o14 > o16 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (17) 'InactivityScores'
+	if o17 = ssz.ReadOffset(buf[304:308]); o17 > size || o16 > o17 {
+		return ssz.ErrOffset
+	}
+
 	// Field (4) 'BlockRoots'
 	{
 		buf = tail[o4:o5]
@@ -331,7 +367,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 	// Field (14) 'Slashings'
 	{
-		buf = tail[o14:]
+		buf = tail[o14:o16]
 		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
 		if err != nil {
 			return err
@@ -341,12 +377,38 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 			b.Slashings[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
 		}
 	}
+
+	// Field (16) 'EpochParticipation'
+	{
+		buf = tail[o16:o17]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.EpochParticipation = ssz.ExtendUint64(b.EpochParticipation, num)
+		for ii := 0; ii < num; ii++ {
+			b.EpochParticipation[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (17) 'InactivityScores'
+	{
+		buf = tail[o17:]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+		for ii := 0; ii < num; ii++ {
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
 func (b *BeaconState) SizeSSZ() (size int) {
-	size = 300
+	size = 308
 
 	// Field (4) 'BlockRoots'
 	size += len(b.BlockRoots) * 32
@@ -372,6 +434,12 @@ func (b *BeaconState) SizeSSZ() (size int) {
 	// Field (14) 'Slashings'
 	size += len(b.Slashings) * 8
 
+	// Field (16) 'EpochParticipation'
+	size += len(b.EpochParticipation) * 8
+
+	// Field (17) 'InactivityScores'
+	size += len(b.InactivityScores) * 8
+
 	return
 }
 
@@ -519,6 +587,36 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(b.TotalSlashing))
 
+	// Field (16) 'EpochParticipation'
+	{
+		if size := len(b.EpochParticipation); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.EpochParticipation", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.EpochParticipation {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.EpochParticipation))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (17) 'InactivityScores'
+	{
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
 	hh.Merkleize(indx)
 	return
 }
@@ -40,12 +40,14 @@ import (
 func generateValidBeaconState() *deneb.BeaconState {
 	var byteArray [256]byte
 	return &deneb.BeaconState{
-		BlockRoots:  []primitives.Root{},
-		StateRoots:  []primitives.Root{},
-		Validators:  []*types.Validator{},
-		Balances:    []uint64{},
-		RandaoMixes: []primitives.Bytes32{},
-		Slashings:   []uint64{},
+		BlockRoots:         []primitives.Root{},
+		StateRoots:         []primitives.Root{},
+		Validators:         []*types.Validator{},
+		Balances:           []uint64{},
+		RandaoMixes:        []primitives.Bytes32{},
+		Slashings:          []uint64{},
+		EpochParticipation: []uint64{},
+		InactivityScores:   []uint64{},
 		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
 			LogsBloom: byteArray[:],
 			ExtraData: []byte{},
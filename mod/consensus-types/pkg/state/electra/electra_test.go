@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/electra"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// generateValidBeaconState generates a valid beacon state for the Electra.
+func generateValidBeaconState() *electra.BeaconState {
+	var byteArray [256]byte
+	return &electra.BeaconState{
+		BlockRoots:         []primitives.Root{},
+		StateRoots:         []primitives.Root{},
+		Validators:         []*types.Validator{},
+		Balances:           []uint64{},
+		RandaoMixes:        []primitives.Bytes32{},
+		Slashings:          []uint64{},
+		EpochParticipation: []uint64{},
+		InactivityScores:   []uint64{},
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeaderElectra{
+			LogsBloom: byteArray[:],
+			ExtraData: []byte{},
+		},
+	}
+}
+
+func TestBeaconStateMarshalUnmarshalSSZ(t *testing.T) {
+	state := generateValidBeaconState()
+
+	data, fastSSZMarshalErr := state.MarshalSSZ()
+	require.NoError(t, fastSSZMarshalErr)
+	require.NotNil(t, data)
+
+	newState := &electra.BeaconState{}
+	err := newState.UnmarshalSSZ(data)
+	require.NoError(t, err)
+
+	require.Equal(t, state, newState)
+
+	// Check if the state size is greater than 0
+	require.Positive(t, state.SizeSSZ())
+}
+
+func TestHashTreeRoot(t *testing.T) {
+	state := generateValidBeaconState()
+	_, err := state.HashTreeRoot()
+	require.NoError(t, err)
+}
+
+func TestGetTree(t *testing.T) {
+	state := generateValidBeaconState()
+	tree, err := state.GetTree()
+	require.NoError(t, err)
+	require.NotNil(t, tree)
+}
+
+func TestBeaconState_UnmarshalSSZ_Error(t *testing.T) {
+	state := &electra.BeaconState{}
+	err := state.UnmarshalSSZ([]byte{0x01, 0x02, 0x03}) // Invalid data
+	require.ErrorIs(t, err, ssz.ErrSize)
+}
+
+func TestBeaconState_MarshalSSZTo(t *testing.T) {
+	state := generateValidBeaconState()
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	require.NotNil(t, data)
+
+	var buf []byte
+	buf, err = state.MarshalSSZTo(buf)
+	require.NoError(t, err)
+
+	// The two byte slices should be equal
+	require.Equal(t, data, buf)
+}
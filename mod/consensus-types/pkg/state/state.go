@@ -25,9 +25,12 @@ import (
 	"reflect"
 
 	deneb "github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	electra "github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/electra"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -39,8 +42,8 @@ type BeaconState[
 	ForkT,
 	ValidatorT any,
 ] struct {
-	// TODO: decouple from deneb.BeaconState
-	*deneb.BeaconState
+	// TODO: decouple from the fork specific beacon states.
+	ssz.Marshallable
 }
 
 // New creates a new BeaconState.
@@ -60,6 +63,7 @@ func (st *BeaconState[
 	stateRoots []primitives.Root,
 	eth1Data Eth1DataT,
 	eth1DepositIndex uint64,
+	depositRequestsStartIndex uint64,
 	latestExecutionPayloadHeader ExecutionPayloadHeaderT,
 	validators []ValidatorT,
 	balances []uint64,
@@ -68,6 +72,8 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []uint64,
 	totalSlashing math.Gwei,
+	epochParticipation []uint64,
+	inactivityScores []uint64,
 ) (*BeaconState[
 	BeaconBlockHeaderT,
 	ExecutionPayloadHeaderT,
@@ -85,7 +91,7 @@ func (st *BeaconState[
 			ValidatorT,
 		]{
 			// TODO: Unhack reflection.
-			BeaconState: &deneb.BeaconState{
+			Marshallable: &deneb.BeaconState{
 				Slot:                  slot,
 				GenesisValidatorsRoot: genesisValidatorsRoot,
 				Fork: reflect.ValueOf(fork).
@@ -109,9 +115,165 @@ func (st *BeaconState[
 				NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
 				Slashings:                    slashings,
 				TotalSlashing:                totalSlashing,
+				EpochParticipation:           epochParticipation,
+				InactivityScores:             inactivityScores,
 			},
 		}, nil
+	case version.Electra:
+		header, err := toElectraExecutionPayloadHeader(
+			reflect.ValueOf(latestExecutionPayloadHeader).
+				Interface().(*types.ExecutionPayloadHeader),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &BeaconState[
+			BeaconBlockHeaderT,
+			ExecutionPayloadHeaderT,
+			Eth1DataT,
+			ForkT,
+			ValidatorT,
+		]{
+			// TODO: Unhack reflection.
+			Marshallable: &electra.BeaconState{
+				Slot:                  slot,
+				GenesisValidatorsRoot: genesisValidatorsRoot,
+				Fork: reflect.ValueOf(fork).
+					Interface().(*types.Fork),
+				LatestBlockHeader: reflect.ValueOf(latestBlockHeader).
+					Interface().(*types.BeaconBlockHeader),
+				BlockRoots:                   blockRoots,
+				StateRoots:                   stateRoots,
+				LatestExecutionPayloadHeader: header,
+				Eth1Data: reflect.ValueOf(eth1Data).
+					Interface().(*types.Eth1Data),
+				Eth1DepositIndex:          eth1DepositIndex,
+				DepositRequestsStartIndex: depositRequestsStartIndex,
+				Validators: reflect.ValueOf(validators).
+					Interface().([]*types.Validator),
+				Balances:                     balances,
+				RandaoMixes:                  randaoMixes,
+				NextWithdrawalIndex:          nextWithdrawalIndex,
+				NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
+				Slashings:                    slashings,
+				TotalSlashing:                totalSlashing,
+				EpochParticipation:           epochParticipation,
+				InactivityScores:             inactivityScores,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported version %d", forkVersion)
+	}
+}
+
+// NewFromSSZ returns a new BeaconState from the given SSZ bytes, decoded in
+// the container of the given fork version.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	ExecutionPayloadHeaderT,
+	Eth1DataT,
+	ForkT,
+	ValidatorT,
+]) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*BeaconState[
+	BeaconBlockHeaderT,
+	ExecutionPayloadHeaderT,
+	Eth1DataT,
+	ForkT,
+	ValidatorT,
+], error) {
+	st = &BeaconState[
+		BeaconBlockHeaderT,
+		ExecutionPayloadHeaderT,
+		Eth1DataT,
+		ForkT,
+		ValidatorT,
+	]{}
+	switch forkVersion {
+	case version.Deneb:
+		st.Marshallable = &deneb.BeaconState{}
+	case version.Electra:
+		st.Marshallable = &electra.BeaconState{}
 	default:
 		return nil, fmt.Errorf("unsupported version %d", forkVersion)
 	}
+	if err := st.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// UpgradeToElectra returns the Electra container of a Deneb state, with the
+// execution payload header upgraded with empty request roots and no deposit
+// request processed yet.
+func UpgradeToElectra(st *deneb.BeaconState) (*electra.BeaconState, error) {
+	header, err := toElectraExecutionPayloadHeader(
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: st.LatestExecutionPayloadHeader,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &electra.BeaconState{
+		GenesisValidatorsRoot:        st.GenesisValidatorsRoot,
+		Slot:                         st.Slot,
+		Fork:                         st.Fork,
+		LatestBlockHeader:            st.LatestBlockHeader,
+		BlockRoots:                   st.BlockRoots,
+		StateRoots:                   st.StateRoots,
+		Eth1Data:                     st.Eth1Data,
+		Eth1DepositIndex:             st.Eth1DepositIndex,
+		LatestExecutionPayloadHeader: header,
+		DepositRequestsStartIndex:    constants.UnsetDepositRequestsStartIndex,
+		Validators:                   st.Validators,
+		Balances:                     st.Balances,
+		RandaoMixes:                  st.RandaoMixes,
+		NextWithdrawalIndex:          st.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: st.NextWithdrawalValidatorIndex,
+		Slashings:                    st.Slashings,
+		TotalSlashing:                st.TotalSlashing,
+		EpochParticipation:           st.EpochParticipation,
+		InactivityScores:             st.InactivityScores,
+	}, nil
+}
+
+// toElectraExecutionPayloadHeader returns the latest execution payload header
+// of an Electra state. Until the first Electra payload is processed the state
+// still holds the last Deneb header, which is upgraded with empty request
+// roots.
+func toElectraExecutionPayloadHeader(
+	header *types.ExecutionPayloadHeader,
+) (*types.ExecutionPayloadHeaderElectra, error) {
+	switch h := header.InnerExecutionPayloadHeader.(type) {
+	case *types.ExecutionPayloadHeaderElectra:
+		return h, nil
+	case *types.ExecutionPayloadHeaderDeneb:
+		return &types.ExecutionPayloadHeaderElectra{
+			ParentHash:       h.ParentHash,
+			FeeRecipient:     h.FeeRecipient,
+			StateRoot:        h.StateRoot,
+			ReceiptsRoot:     h.ReceiptsRoot,
+			LogsBloom:        h.LogsBloom,
+			Random:           h.Random,
+			Number:           h.Number,
+			GasLimit:         h.GasLimit,
+			GasUsed:          h.GasUsed,
+			Timestamp:        h.Timestamp,
+			ExtraData:        h.ExtraData,
+			BaseFeePerGas:    h.BaseFeePerGas,
+			BlockHash:        h.BlockHash,
+			TransactionsRoot: h.TransactionsRoot,
+			WithdrawalsRoot:  h.WithdrawalsRoot,
+			BlobGasUsed:      h.BlobGasUsed,
+			ExcessBlobGas:    h.ExcessBlobGas,
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported execution payload header version %d",
+			header.Version(),
+		)
+	}
 }
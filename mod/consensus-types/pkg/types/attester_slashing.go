@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// Checkpoint as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#checkpoint
+//
+//nolint:lll
+type Checkpoint struct {
+	// Epoch is the epoch of the checkpoint.
+	Epoch math.Epoch `json:"epoch"`
+	// Root is the block root of the checkpoint.
+	Root common.Root `json:"root"  ssz-size:"32"`
+}
+
+// AttestationData as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#attestationdata
+//
+//nolint:lll
+type AttestationData struct {
+	// Slot is the slot of the attestation.
+	Slot math.Slot `json:"slot"`
+	// Index is the committee index of the attestation.
+	Index uint64 `json:"index"`
+	// BeaconBlockRoot is the root of the block voted for.
+	BeaconBlockRoot common.Root `json:"beaconBlockRoot" ssz-size:"32"`
+	// Source is the source checkpoint of the FFG vote.
+	Source *Checkpoint `json:"source"`
+	// Target is the target checkpoint of the FFG vote.
+	Target *Checkpoint `json:"target"`
+}
+
+// IndexedAttestation as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#indexedattestation
+//
+//nolint:lll
+type IndexedAttestation struct {
+	// AttestingIndices are the sorted indices of the attesting validators.
+	AttestingIndices []uint64 `json:"attestingIndices" ssz-max:"2048"`
+	// Data is the data being attested to.
+	Data *AttestationData `json:"data"`
+	// Signature is the aggregate signature of the attesting validators.
+	Signature crypto.BLSSignature `json:"signature"        ssz-size:"96"`
+}
+
+// HasValidIndices returns true if the attesting indices are non-empty,
+// sorted and unique, as required by `is_valid_indexed_attestation`.
+func (a *IndexedAttestation) HasValidIndices() bool {
+	indices := a.AttestingIndices
+	if len(indices) == 0 ||
+		uint64(len(indices)) > constants.MaxValidatorsPerCommittee {
+		return false
+	}
+	for i := 1; i < len(indices); i++ {
+		if indices[i-1] >= indices[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifySignature verifies the aggregate signature of the indexed attestation
+// against the public keys of the attesting validators.
+func (a *IndexedAttestation) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkeys []crypto.BLSPubkey,
+	aggregateVerificationFn func(
+		pubkeys []crypto.BLSPubkey,
+		message []byte,
+		signature crypto.BLSSignature,
+	) error,
+) error {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := ssz.ComputeSigningRoot(a.Data, domain)
+	if err != nil {
+		return err
+	}
+
+	return aggregateVerificationFn(pubkeys, signingRoot[:], a.Signature)
+}
+
+// AttesterSlashing as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#attesterslashing
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./attester_slashing.go -objs Checkpoint,AttestationData,IndexedAttestation,AttesterSlashing -include ../../../primitives/pkg/common,../../../primitives/pkg/math,../../../primitives/pkg/bytes,../../../primitives/pkg/crypto,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output attester_slashing.ssz.go
+//nolint:lll
+type AttesterSlashing struct {
+	// Attestation1 is the first of the two conflicting attestations.
+	Attestation1 *IndexedAttestation `json:"attestation1"`
+	// Attestation2 is the second of the two conflicting attestations.
+	Attestation2 *IndexedAttestation `json:"attestation2"`
+}
+
+// IsSlashable returns true if the two attestations form either a double
+// vote or a surround vote.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#is_slashable_attestation_data
+//
+//nolint:lll
+func (s *AttesterSlashing) IsSlashable() bool {
+	data1, data2 := s.Attestation1.Data, s.Attestation2.Data
+	isDoubleVote := *data1.Source != *data2.Source ||
+		*data1.Target != *data2.Target ||
+		data1.Slot != data2.Slot ||
+		data1.Index != data2.Index ||
+		data1.BeaconBlockRoot != data2.BeaconBlockRoot
+	isDoubleVote = isDoubleVote && data1.Target.Epoch == data2.Target.Epoch
+	isSurroundVote := data1.Source.Epoch < data2.Source.Epoch &&
+		data2.Target.Epoch < data1.Target.Epoch
+	return isDoubleVote || isSurroundVote
+}
+
+// GetSlashableIndices returns the sorted indices of the validators that
+// signed both of the conflicting attestations.
+func (s *AttesterSlashing) GetSlashableIndices() []math.ValidatorIndex {
+	var (
+		indices1  = s.Attestation1.AttestingIndices
+		indices2  = s.Attestation2.AttestingIndices
+		slashable = make([]math.ValidatorIndex, 0)
+	)
+
+	// Both lists are sorted, so the intersection can be found in one pass.
+	for i, j := 0, 0; i < len(indices1) && j < len(indices2); {
+		switch {
+		case indices1[i] < indices2[j]:
+			i++
+		case indices1[i] > indices2[j]:
+			j++
+		default:
+			slashable = append(slashable, math.ValidatorIndex(indices1[i]))
+			i++
+			j++
+		}
+	}
+	return slashable
+}
+
+// VerifyAttestations ensures both of the conflicting attestations are valid
+// indexed attestations, verifying their aggregate signatures.
+func (s *AttesterSlashing) VerifyAttestations(
+	domainType common.DomainType,
+	forkDataFn func(epoch math.Epoch) *ForkData,
+	pubkeyFn func(index math.ValidatorIndex) (crypto.BLSPubkey, error),
+	aggregateVerificationFn func(
+		pubkeys []crypto.BLSPubkey,
+		message []byte,
+		signature crypto.BLSSignature,
+	) error,
+) error {
+	for _, attestation := range []*IndexedAttestation{
+		s.Attestation1, s.Attestation2,
+	} {
+		if !attestation.HasValidIndices() {
+			return errors.Wrap(
+				ErrInvalidAttesterSlashing, "invalid attesting indices",
+			)
+		}
+
+		pubkeys := make([]crypto.BLSPubkey, len(attestation.AttestingIndices))
+		for i, index := range attestation.AttestingIndices {
+			pubkey, err := pubkeyFn(math.ValidatorIndex(index))
+			if err != nil {
+				return err
+			}
+			pubkeys[i] = pubkey
+		}
+
+		if err := attestation.VerifySignature(
+			forkDataFn(attestation.Data.Target.Epoch),
+			domainType,
+			pubkeys,
+			aggregateVerificationFn,
+		); err != nil {
+			return errors.Join(err, ErrInvalidAttesterSlashing)
+		}
+	}
+	return nil
+}
+
+// AttesterSlashings is a typealias for a list of AttesterSlashings.
+type AttesterSlashings []*AttesterSlashing
+
+// HashTreeRoot returns the hash tree root of the AttesterSlashings list.
+func (as AttesterSlashings) HashTreeRoot() (common.Root, error) {
+	return ssz.MerkleizeListComposite[any, math.U64](
+		as, constants.MaxAttesterSlashingsPerBlock,
+	)
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// generateAttestationData generates attestation data for testing purposes.
+func generateAttestationData(
+	source, target math.Epoch,
+	root common.Root,
+) *types.AttestationData {
+	return &types.AttestationData{
+		Slot:            math.Slot(target * 32),
+		BeaconBlockRoot: root,
+		Source:          &types.Checkpoint{Epoch: source},
+		Target:          &types.Checkpoint{Epoch: target},
+	}
+}
+
+// generateIndexedAttestation generates a signed indexed attestation for
+// testing purposes.
+func generateIndexedAttestation(
+	t *testing.T,
+	indices []uint64,
+	data *types.AttestationData,
+) *types.IndexedAttestation {
+	t.Helper()
+	return &types.IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             data,
+		Signature:        mockSign(t, data, testForkData, testDomainType),
+	}
+}
+
+// mockAggregateVerify verifies aggregate signatures produced by mockSign.
+func mockAggregateVerify(
+	pubkeys []crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+) error {
+	if len(pubkeys) == 0 {
+		return errMockInvalidSignature
+	}
+	return mockVerify(pubkeys[0], message, signature)
+}
+
+func TestAttesterSlashing_IsSlashable(t *testing.T) {
+	tests := []struct {
+		name     string
+		data1    *types.AttestationData
+		data2    *types.AttestationData
+		expected bool
+	}{
+		{
+			name:     "double vote",
+			data1:    generateAttestationData(1, 2, common.Root{1}),
+			data2:    generateAttestationData(1, 2, common.Root{2}),
+			expected: true,
+		},
+		{
+			name:     "surround vote",
+			data1:    generateAttestationData(1, 4, common.Root{1}),
+			data2:    generateAttestationData(2, 3, common.Root{1}),
+			expected: true,
+		},
+		{
+			name:     "surrounded vote in the wrong order",
+			data1:    generateAttestationData(2, 3, common.Root{1}),
+			data2:    generateAttestationData(1, 4, common.Root{1}),
+			expected: false,
+		},
+		{
+			name:     "identical votes",
+			data1:    generateAttestationData(1, 2, common.Root{1}),
+			data2:    generateAttestationData(1, 2, common.Root{1}),
+			expected: false,
+		},
+		{
+			name:     "consecutive votes",
+			data1:    generateAttestationData(1, 2, common.Root{1}),
+			data2:    generateAttestationData(2, 3, common.Root{2}),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashing := &types.AttesterSlashing{
+				Attestation1: &types.IndexedAttestation{Data: tt.data1},
+				Attestation2: &types.IndexedAttestation{Data: tt.data2},
+			}
+			require.Equal(t, tt.expected, slashing.IsSlashable())
+		})
+	}
+}
+
+func TestAttesterSlashing_GetSlashableIndices(t *testing.T) {
+	tests := []struct {
+		name     string
+		indices1 []uint64
+		indices2 []uint64
+		expected []math.ValidatorIndex
+	}{
+		{
+			name:     "full overlap",
+			indices1: []uint64{1, 2, 3},
+			indices2: []uint64{1, 2, 3},
+			expected: []math.ValidatorIndex{1, 2, 3},
+		},
+		{
+			name:     "partial overlap",
+			indices1: []uint64{1, 3, 5, 7},
+			indices2: []uint64{2, 3, 4, 7, 9},
+			expected: []math.ValidatorIndex{3, 7},
+		},
+		{
+			name:     "no overlap",
+			indices1: []uint64{1, 2},
+			indices2: []uint64{3, 4},
+			expected: []math.ValidatorIndex{},
+		},
+		{
+			name:     "empty",
+			indices1: []uint64{},
+			indices2: []uint64{1},
+			expected: []math.ValidatorIndex{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashing := &types.AttesterSlashing{
+				Attestation1: &types.IndexedAttestation{
+					AttestingIndices: tt.indices1,
+				},
+				Attestation2: &types.IndexedAttestation{
+					AttestingIndices: tt.indices2,
+				},
+			}
+			require.Equal(t, tt.expected, slashing.GetSlashableIndices())
+		})
+	}
+}
+
+func TestAttesterSlashing_VerifyAttestations(t *testing.T) {
+	errUnknownValidator := errors.New("unknown validator")
+	tests := []struct {
+		name     string
+		malleate func(*types.AttesterSlashing)
+		pubkeyFn func(math.ValidatorIndex) (crypto.BLSPubkey, error)
+		wantErr  error
+	}{
+		{
+			name:     "valid attestations",
+			malleate: func(*types.AttesterSlashing) {},
+		},
+		{
+			name: "empty attesting indices",
+			malleate: func(as *types.AttesterSlashing) {
+				as.Attestation1.AttestingIndices = []uint64{}
+			},
+			wantErr: types.ErrInvalidAttesterSlashing,
+		},
+		{
+			name: "unsorted attesting indices",
+			malleate: func(as *types.AttesterSlashing) {
+				as.Attestation2.AttestingIndices = []uint64{3, 1}
+			},
+			wantErr: types.ErrInvalidAttesterSlashing,
+		},
+		{
+			name: "duplicate attesting indices",
+			malleate: func(as *types.AttesterSlashing) {
+				as.Attestation2.AttestingIndices = []uint64{1, 1}
+			},
+			wantErr: types.ErrInvalidAttesterSlashing,
+		},
+		{
+			name: "invalid signature",
+			malleate: func(as *types.AttesterSlashing) {
+				as.Attestation2.Signature = as.Attestation1.Signature
+			},
+			wantErr: types.ErrInvalidAttesterSlashing,
+		},
+		{
+			name:     "unknown attester",
+			malleate: func(*types.AttesterSlashing) {},
+			pubkeyFn: func(math.ValidatorIndex) (crypto.BLSPubkey, error) {
+				return crypto.BLSPubkey{}, errUnknownValidator
+			},
+			wantErr: errUnknownValidator,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashing := &types.AttesterSlashing{
+				Attestation1: generateIndexedAttestation(
+					t, []uint64{1, 2, 3},
+					generateAttestationData(1, 2, common.Root{1}),
+				),
+				Attestation2: generateIndexedAttestation(
+					t, []uint64{2, 3},
+					generateAttestationData(1, 2, common.Root{2}),
+				),
+			}
+			tt.malleate(slashing)
+
+			pubkeyFn := tt.pubkeyFn
+			if pubkeyFn == nil {
+				pubkeyFn = func(math.ValidatorIndex) (crypto.BLSPubkey, error) {
+					return crypto.BLSPubkey{}, nil
+				}
+			}
+
+			err := slashing.VerifyAttestations(
+				testDomainType,
+				func(math.Epoch) *types.ForkData { return testForkData },
+				pubkeyFn,
+				mockAggregateVerify,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAttesterSlashing_MarshalUnmarshalSSZ(t *testing.T) {
+	slashing := &types.AttesterSlashing{
+		Attestation1: generateIndexedAttestation(
+			t, []uint64{1, 2, 3},
+			generateAttestationData(1, 2, common.Root{1}),
+		),
+		Attestation2: generateIndexedAttestation(
+			t, []uint64{2, 3},
+			generateAttestationData(1, 2, common.Root{2}),
+		),
+	}
+
+	data, err := slashing.MarshalSSZ()
+	require.NoError(t, err)
+
+	var unmarshalled types.AttesterSlashing
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, slashing, &unmarshalled)
+}
@@ -39,6 +39,10 @@ func (w *BeaconBlock) Empty(forkVersion uint32) *BeaconBlock {
 		return &BeaconBlock{
 			RawBeaconBlock: (*BeaconBlockDeneb)(nil),
 		}
+	case version.Electra:
+		return &BeaconBlock{
+			RawBeaconBlock: (*BeaconBlockElectra)(nil),
+		}
 	default:
 		panic("fork version not supported")
 	}
@@ -67,6 +71,11 @@ func (w *BeaconBlock) NewWithVersion(
 			BeaconBlockHeaderBase: base,
 			Body:                  &BeaconBlockBodyDeneb{},
 		}
+	case version.Electra:
+		block = &BeaconBlockElectra{
+			BeaconBlockHeaderBase: base,
+			Body:                  &BeaconBlockBodyElectra{},
+		}
 	default:
 		return &BeaconBlock{}, ErrForkVersionNotSupported
 	}
@@ -85,6 +94,8 @@ func (w *BeaconBlock) NewFromSSZ(
 	switch forkVersion {
 	case version.Deneb:
 		block.RawBeaconBlock = &BeaconBlockDeneb{}
+	case version.Electra:
+		block.RawBeaconBlock = &BeaconBlockElectra{}
 	default:
 		return block, ErrForkVersionNotSupported
 	}
@@ -105,7 +116,7 @@ func (w *BeaconBlock) IsNil() bool {
 // BeaconBlockDeneb represents a block in the beacon chain during
 // the Deneb fork.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path block.go -objs BeaconBlockDeneb -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,..,./header.go,./withdrawal_credentials.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./deposit.go,./payload.go,./deposit.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./body.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output block.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path block.go -objs BeaconBlockDeneb -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,..,./header.go,./withdrawal_credentials.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./deposit.go,./payload.go,./deposit.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./proposer_slashing.go,./attester_slashing.go,./voluntary_exit.go,./bls_to_execution_change.go,./body.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output block.ssz.go
 type BeaconBlockDeneb struct {
 	// BeaconBlockHeaderBase is the base of the BeaconBlockDeneb.
 	BeaconBlockHeaderBase
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 008599c20cb4dc8d99d0cba4ed5aaa78bad974f6a3f5cb76f6fcc949a6ef381a
+// Hash: 87021b6398f0d9e5b7cb53d12e0d56f2f38d388799303404bdf6b39380d91d1a
 // Version: 0.1.3
 package types
 
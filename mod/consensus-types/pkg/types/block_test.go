@@ -78,7 +78,12 @@ func TestEmptyBeaconBlockInvalidForkVersion(t *testing.T) {
 func TestBeaconBlockFromSSZ(t *testing.T) {
 	originalBlock := generateValidBeaconBlockDeneb()
 
+	originalBlock.Body.ProposerSlashings = []*types.ProposerSlashing{}
+	originalBlock.Body.AttesterSlashings = []*types.AttesterSlashing{}
 	originalBlock.Body.Deposits = []*types.Deposit{}
+	originalBlock.Body.VoluntaryExits = []*types.SignedVoluntaryExit{}
+	originalBlock.Body.BLSToExecutionChanges =
+		[]*types.SignedBLSToExecutionChange{}
 
 	sszBlock, err := originalBlock.MarshalSSZ()
 	require.NoError(t, err)
@@ -128,7 +133,12 @@ func TestBeaconBlockDeneb(t *testing.T) {
 
 func TestBeaconBlockDeneb_MarshalUnmarshalSSZ(t *testing.T) {
 	block := *generateValidBeaconBlockDeneb()
+	block.Body.ProposerSlashings = []*types.ProposerSlashing{}
+	block.Body.AttesterSlashings = []*types.AttesterSlashing{}
 	block.Body.Deposits = []*types.Deposit{}
+	block.Body.VoluntaryExits = []*types.SignedVoluntaryExit{}
+	block.Body.BLSToExecutionChanges =
+		[]*types.SignedBLSToExecutionChange{}
 
 	sszBlock, err := block.MarshalSSZ()
 	require.NoError(t, err)
@@ -138,7 +148,12 @@ func TestBeaconBlockDeneb_MarshalUnmarshalSSZ(t *testing.T) {
 	err = unmarshalledBlock.UnmarshalSSZ(sszBlock)
 	require.NoError(t, err)
 
+	block.Body.ProposerSlashings = []*types.ProposerSlashing{}
+	block.Body.AttesterSlashings = []*types.AttesterSlashing{}
 	block.Body.Deposits = []*types.Deposit{}
+	block.Body.VoluntaryExits = []*types.SignedVoluntaryExit{}
+	block.Body.BLSToExecutionChanges =
+		[]*types.SignedBLSToExecutionChange{}
 
 	require.Equal(t, block, unmarshalledBlock)
 }
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// BLSToExecutionChange as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#blstoexecutionchange
+//
+//nolint:lll
+type BLSToExecutionChange struct {
+	// ValidatorIndex is the index of the validator changing its credentials.
+	ValidatorIndex math.ValidatorIndex `json:"validatorIndex"`
+	// FromBLSPubkey is the BLS public key committed to by the current
+	// withdrawal credentials of the validator.
+	FromBLSPubkey crypto.BLSPubkey `json:"fromBlsPubkey"      ssz-size:"48"`
+	// ToExecutionAddress is the execution address to withdraw to.
+	ToExecutionAddress common.ExecutionAddress `json:"toExecutionAddress" ssz-size:"20"`
+}
+
+// SignedBLSToExecutionChange as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#signedblstoexecutionchange
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./bls_to_execution_change.go -objs BLSToExecutionChange,SignedBLSToExecutionChange -include ../../../primitives/pkg/math,../../../primitives/pkg/bytes,../../../primitives/pkg/crypto,../../../primitives/pkg/common,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output bls_to_execution_change.ssz.go
+//nolint:lll
+type SignedBLSToExecutionChange struct {
+	// Message is the signed BLS to execution change.
+	Message *BLSToExecutionChange `json:"message"`
+	// Signature is the signature over the change by the BLS key committed to
+	// by the withdrawal credentials.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
+
+// GetValidatorIndex returns the index of the validator changing its
+// credentials.
+func (c *SignedBLSToExecutionChange) GetValidatorIndex() math.ValidatorIndex {
+	return c.Message.ValidatorIndex
+}
+
+// GetFromBLSPubkey returns the BLS public key of the change.
+func (c *SignedBLSToExecutionChange) GetFromBLSPubkey() crypto.BLSPubkey {
+	return c.Message.FromBLSPubkey
+}
+
+// GetToExecutionAddress returns the execution address of the change.
+//
+//nolint:lll // long signature.
+func (c *SignedBLSToExecutionChange) GetToExecutionAddress() common.ExecutionAddress {
+	return c.Message.ToExecutionAddress
+}
+
+// GetFromWithdrawalCredentials returns the BLS withdrawal credentials the
+// validator must currently have for the change to apply.
+//
+//nolint:lll // long signature.
+func (c *SignedBLSToExecutionChange) GetFromWithdrawalCredentials() WithdrawalCredentials {
+	return NewCredentialsFromBLSPubkey(c.Message.FromBLSPubkey)
+}
+
+// GetToWithdrawalCredentials returns the eth1 withdrawal credentials of the
+// validator once the change is applied.
+//
+//nolint:lll // long signature.
+func (c *SignedBLSToExecutionChange) GetToWithdrawalCredentials() WithdrawalCredentials {
+	return NewCredentialsFromExecutionAddress(c.Message.ToExecutionAddress)
+}
+
+// VerifySignature verifies the signature of the BLS key over the change.
+func (c *SignedBLSToExecutionChange) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := ssz.ComputeSigningRoot(c.Message, domain)
+	if err != nil {
+		return err
+	}
+
+	if err = signatureVerificationFn(
+		c.Message.FromBLSPubkey, signingRoot[:], c.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidBLSToExecutionChange)
+	}
+	return nil
+}
+
+// BLSToExecutionChanges is a typealias for a list of
+// SignedBLSToExecutionChanges.
+type BLSToExecutionChanges []*SignedBLSToExecutionChange
+
+// HashTreeRoot returns the hash tree root of the BLSToExecutionChanges list.
+func (c BLSToExecutionChanges) HashTreeRoot() (common.Root, error) {
+	return ssz.MerkleizeListComposite[any, math.U64](
+		c, constants.MaxBLSToExecutionChangesPerBlock,
+	)
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// generateSignedBLSToExecutionChange generates a signed BLS to execution
+// change for testing purposes.
+func generateSignedBLSToExecutionChange(
+	t *testing.T,
+	validatorIndex math.ValidatorIndex,
+) *types.SignedBLSToExecutionChange {
+	t.Helper()
+	change := &types.BLSToExecutionChange{
+		ValidatorIndex:     validatorIndex,
+		FromBLSPubkey:      crypto.BLSPubkey{0x01, 0x02},
+		ToExecutionAddress: common.ExecutionAddress{0xde, 0xad},
+	}
+	return &types.SignedBLSToExecutionChange{
+		Message:   change,
+		Signature: mockSign(t, change, testForkData, testDomainType),
+	}
+}
+
+func TestSignedBLSToExecutionChange_VerifySignature(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(*types.SignedBLSToExecutionChange)
+		wantErr  error
+	}{
+		{
+			name:     "valid signature",
+			malleate: func(*types.SignedBLSToExecutionChange) {},
+		},
+		{
+			name: "invalid signature",
+			malleate: func(c *types.SignedBLSToExecutionChange) {
+				c.Signature = crypto.BLSSignature{}
+			},
+			wantErr: types.ErrInvalidBLSToExecutionChange,
+		},
+		{
+			name: "modified message",
+			malleate: func(c *types.SignedBLSToExecutionChange) {
+				c.Message.ToExecutionAddress[0] = 0xff
+			},
+			wantErr: types.ErrInvalidBLSToExecutionChange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := generateSignedBLSToExecutionChange(t, 1)
+			tt.malleate(change)
+
+			err := change.VerifySignature(
+				testForkData, testDomainType, mockVerify,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSignedBLSToExecutionChange_WithdrawalCredentials(t *testing.T) {
+	change := generateSignedBLSToExecutionChange(t, 1)
+
+	// The current credentials commit to the hash of the BLS public key.
+	pubkey := change.GetFromBLSPubkey()
+	hash := sha256.Sum256(pubkey[:])
+	from := change.GetFromWithdrawalCredentials()
+	require.Equal(t, types.BLSCredentialPrefix, from[0])
+	require.Equal(t, hash[1:], from[1:])
+
+	// The new credentials withdraw to the execution address.
+	to := change.GetToWithdrawalCredentials()
+	address, err := to.ToExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, change.GetToExecutionAddress(), address)
+}
+
+func TestSignedBLSToExecutionChange_MarshalUnmarshalSSZ(t *testing.T) {
+	change := generateSignedBLSToExecutionChange(t, 7)
+
+	data, err := change.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, change.SizeSSZ())
+
+	var unmarshalled types.SignedBLSToExecutionChange
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, change, &unmarshalled)
+	require.Equal(t, math.ValidatorIndex(7), change.GetValidatorIndex())
+}
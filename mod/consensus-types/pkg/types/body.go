@@ -39,14 +39,26 @@ import (
 const (
 	// BodyLengthDeneb is the number of fields in the BeaconBlockBodyDeneb
 	// struct.
-	BodyLengthDeneb uint64 = 6
+	BodyLengthDeneb uint64 = 10
 
 	// KZGPosition is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
 	// in the merkle tree built from the block body.
-	KZGMerkleIndexDeneb = 26
+	KZGMerkleIndexDeneb = 50
+
+	// BodyLengthElectra is the number of fields in the
+	// BeaconBlockBodyElectra struct.
+	BodyLengthElectra uint64 = 10
+
+	// KZGPositionElectra is the position of BlobKzgCommitments in the block
+	// body.
+	KZGPositionElectra = BodyLengthElectra - 1
+
+	// KZGMerkleIndexElectra is the merkle index of BlobKzgCommitments' root
+	// in the merkle tree built from the block body.
+	KZGMerkleIndexElectra = 50
 )
 
 type BeaconBlockBody struct {
@@ -66,6 +78,16 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 				ExtraData: make([]byte, 32),
 			},
 		}}
+	case version.Electra:
+		return &BeaconBlockBody{RawBeaconBlockBody: &BeaconBlockBodyElectra{
+			BeaconBlockBodyBase: BeaconBlockBodyBase{},
+			ExecutionPayload: &ExecutableDataElectra{
+				//nolint:mnd // todo fix.
+				LogsBloom: make([]byte, 256),
+				//nolint:mnd // todo fix.
+				ExtraData: make([]byte, 32),
+			},
+		}}
 	default:
 		panic("unsupported fork version")
 	}
@@ -81,6 +103,8 @@ func BlockBodyKZGOffset(
 	switch cs.ActiveForkVersionForSlot(slot) {
 	case version.Deneb:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
+	case version.Electra:
+		return KZGMerkleIndexElectra * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic("unsupported fork version")
 	}
@@ -95,8 +119,16 @@ type BeaconBlockBodyBase struct {
 	Eth1Data *Eth1Data
 	// Graffiti is for a fun message or meme.
 	Graffiti [32]byte `ssz-size:"32"`
+	// ProposerSlashings is the list of proposer slashings included in the
+	// body.
+	ProposerSlashings []*ProposerSlashing `ssz-max:"16"`
+	// AttesterSlashings is the list of attester slashings included in the
+	// body.
+	AttesterSlashings []*AttesterSlashing `ssz-max:"2"`
 	// Deposits is the list of deposits included in the body.
 	Deposits []*Deposit `              ssz-max:"16"`
+	// VoluntaryExits is the list of voluntary exits included in the body.
+	VoluntaryExits []*SignedVoluntaryExit `ssz-max:"16"`
 }
 
 // GetRandaoReveal returns the RandaoReveal of the Body.
@@ -125,6 +157,32 @@ func (b *BeaconBlockBodyBase) GetGraffiti() bytes.B32 {
 	return b.Graffiti
 }
 
+// GetProposerSlashings returns the ProposerSlashings of the
+// BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) GetProposerSlashings() []*ProposerSlashing {
+	return b.ProposerSlashings
+}
+
+// SetProposerSlashings sets the ProposerSlashings of the BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) SetProposerSlashings(
+	proposerSlashings []*ProposerSlashing,
+) {
+	b.ProposerSlashings = proposerSlashings
+}
+
+// GetAttesterSlashings returns the AttesterSlashings of the
+// BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) GetAttesterSlashings() []*AttesterSlashing {
+	return b.AttesterSlashings
+}
+
+// SetAttesterSlashings sets the AttesterSlashings of the BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) SetAttesterSlashings(
+	attesterSlashings []*AttesterSlashing,
+) {
+	b.AttesterSlashings = attesterSlashings
+}
+
 // GetDeposits returns the Deposits of the BeaconBlockBodyBase.
 func (b *BeaconBlockBodyBase) GetDeposits() []*Deposit {
 	return b.Deposits
@@ -135,14 +193,29 @@ func (b *BeaconBlockBodyBase) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
 
+// GetVoluntaryExits returns the VoluntaryExits of the BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) GetVoluntaryExits() []*SignedVoluntaryExit {
+	return b.VoluntaryExits
+}
+
+// SetVoluntaryExits sets the VoluntaryExits of the BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) SetVoluntaryExits(
+	voluntaryExits []*SignedVoluntaryExit,
+) {
+	b.VoluntaryExits = voluntaryExits
+}
+
 // BeaconBlockBodyDeneb represents the body of a beacon block in the Deneb
 // chain.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,./header.go,./proposer_slashing.go,./attester_slashing.go,./voluntary_exit.go,./bls_to_execution_change.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
 type BeaconBlockBodyDeneb struct {
 	BeaconBlockBodyBase
 	// ExecutionPayload is the execution payload of the body.
 	ExecutionPayload *ExecutableDataDeneb
+	// BLSToExecutionChanges is the list of BLS to execution changes included
+	// in the body.
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `ssz-max:"16"`
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment `ssz-size:"?,48" ssz-max:"16"`
 }
@@ -172,6 +245,22 @@ func (b *BeaconBlockBodyDeneb) SetExecutionData(
 	return nil
 }
 
+// GetBLSToExecutionChanges returns the BLSToExecutionChanges of the
+// BeaconBlockBodyDeneb.
+func (
+	b *BeaconBlockBodyDeneb,
+) GetBLSToExecutionChanges() []*SignedBLSToExecutionChange {
+	return b.BLSToExecutionChanges
+}
+
+// SetBLSToExecutionChanges sets the BLSToExecutionChanges of the
+// BeaconBlockBodyDeneb.
+func (b *BeaconBlockBodyDeneb) SetBLSToExecutionChanges(
+	changes []*SignedBLSToExecutionChange,
+) {
+	b.BLSToExecutionChanges = changes
+}
+
 // GetBlobKzgCommitments returns the BlobKzgCommitments of the Body.
 func (
 	b *BeaconBlockBodyDeneb,
@@ -204,12 +293,38 @@ func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
 
 	layer[2] = b.GetGraffiti()
 
-	layer[3], err = Deposits(b.GetDeposits()).HashTreeRoot()
+	layer[3], err = ProposerSlashings(
+		b.GetProposerSlashings(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[4], err = AttesterSlashings(
+		b.GetAttesterSlashings(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[5], err = Deposits(b.GetDeposits()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[6], err = VoluntaryExits(b.GetVoluntaryExits()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[7], err = b.GetExecutionPayload().HashTreeRoot()
 	if err != nil {
 		return nil, err
 	}
 
-	layer[4], err = b.GetExecutionPayload().HashTreeRoot()
+	layer[8], err = BLSToExecutionChanges(
+		b.GetBLSToExecutionChanges(),
+	).HashTreeRoot()
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 9560a974d9e02c8a41c741b532f8c552fb3ef6b8323909846159af1886af1e70
+// Hash: 2b918702c9510cb40785339c4856e635b347cc6f9aa57dc5987a49094a2188c2
 // Version: 0.1.3
 package types
 
@@ -16,7 +16,7 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the BeaconBlockBodyDeneb object to a target array
 func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(212)
+	offset := int(228)
 
 	// Field (0) 'RandaoReveal'
 	dst = append(dst, b.RandaoReveal[:]...)
@@ -32,21 +32,69 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 	// Field (2) 'Graffiti'
 	dst = append(dst, b.Graffiti[:]...)
 
-	// Offset (3) 'Deposits'
+	// Offset (3) 'ProposerSlashings'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 192
+	offset += len(b.ProposerSlashings) * 416
 
-	// Offset (4) 'ExecutionPayload'
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1248
+
+	// Offset (6) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (7) 'ExecutionPayload'
 	dst = ssz.WriteOffset(dst, offset)
 	if b.ExecutionPayload == nil {
 		b.ExecutionPayload = new(ExecutableDataDeneb)
 	}
 	offset += b.ExecutionPayload.SizeSSZ()
 
-	// Offset (5) 'BlobKzgCommitments'
+	// Offset (8) 'BLSToExecutionChanges'
 	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BLSToExecutionChanges) * 172
+
+	// Offset (9) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (3) 'Deposits'
+	// Field (5) 'Deposits'
 	if size := len(b.Deposits); size > 16 {
 		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.Deposits", size, 16)
 		return
@@ -57,12 +105,34 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 		}
 	}
 
-	// Field (4) 'ExecutionPayload'
+	// Field (6) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'ExecutionPayload'
 	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (8) 'BLSToExecutionChanges'
+	if size := len(b.BLSToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.BLSToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BLSToExecutionChanges); ii++ {
+		if dst, err = b.BLSToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'BlobKzgCommitments'
 	if size := len(b.BlobKzgCommitments); size > 16 {
 		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.BlobKzgCommitments", size, 16)
 		return
@@ -78,12 +148,12 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 212 {
+	if size < 228 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5 uint64
+	var o3, o4, o5, o6, o7, o8, o9 uint64
 
 	// Field (0) 'RandaoReveal'
 	copy(b.RandaoReveal[:], buf[0:96])
@@ -99,29 +169,89 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 	// Field (2) 'Graffiti'
 	copy(b.Graffiti[:], buf[168:200])
 
-	// Offset (3) 'Deposits'
+	// Offset (3) 'ProposerSlashings'
 	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 212 {
+	if o3 < 228 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Offset (4) 'ExecutionPayload'
+	// Offset (4) 'AttesterSlashings'
 	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (5) 'BlobKzgCommitments'
+	// Offset (5) 'Deposits'
 	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
 		return ssz.ErrOffset
 	}
 
-	// Field (3) 'Deposits'
+	// Offset (6) 'VoluntaryExits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'ExecutionPayload'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'BLSToExecutionChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (9) 'BlobKzgCommitments'
+	if o9 = ssz.ReadOffset(buf[224:228]); o9 > size || o8 > o9 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
 	{
 		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 192, 16)
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Deposits'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DivideInt2(len(buf), 1248, 16)
 		if err != nil {
 			return err
 		}
@@ -130,15 +260,33 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 			if b.Deposits[ii] == nil {
 				b.Deposits[ii] = new(Deposit)
 			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*192 : (ii+1)*192]); err != nil {
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1248 : (ii+1)*1248]); err != nil {
 				return err
 			}
 		}
 	}
 
-	// Field (4) 'ExecutionPayload'
+	// Field (6) 'VoluntaryExits'
 	{
-		buf = tail[o4:o5]
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'ExecutionPayload'
+	{
+		buf = tail[o7:o8]
 		if b.ExecutionPayload == nil {
 			b.ExecutionPayload = new(ExecutableDataDeneb)
 		}
@@ -147,9 +295,27 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (8) 'BLSToExecutionChanges'
+	{
+		buf = tail[o8:o9]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		if err != nil {
+			return err
+		}
+		b.BLSToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BLSToExecutionChanges[ii] == nil {
+				b.BLSToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			}
+			if err = b.BLSToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (9) 'BlobKzgCommitments'
 	{
-		buf = tail[o5:]
+		buf = tail[o9:]
 		num, err := ssz.DivideInt2(len(buf), 48, 16)
 		if err != nil {
 			return err
@@ -164,18 +330,33 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyDeneb object
 func (b *BeaconBlockBodyDeneb) SizeSSZ() (size int) {
-	size = 212
+	size = 228
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Deposits'
+	size += len(b.Deposits) * 1248
 
-	// Field (3) 'Deposits'
-	size += len(b.Deposits) * 192
+	// Field (6) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
 
-	// Field (4) 'ExecutionPayload'
+	// Field (7) 'ExecutionPayload'
 	if b.ExecutionPayload == nil {
 		b.ExecutionPayload = new(ExecutableDataDeneb)
 	}
 	size += b.ExecutionPayload.SizeSSZ()
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (8) 'BLSToExecutionChanges'
+	size += len(b.BLSToExecutionChanges) * 172
+
+	// Field (9) 'BlobKzgCommitments'
 	size += len(b.BlobKzgCommitments) * 48
 
 	return
@@ -204,7 +385,39 @@ func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (2) 'Graffiti'
 	hh.PutBytes(b.Graffiti[:])
 
-	// Field (3) 'Deposits'
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	// Field (5) 'Deposits'
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.Deposits))
@@ -220,12 +433,44 @@ func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
-	// Field (4) 'ExecutionPayload'
+	// Field (6) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (7) 'ExecutionPayload'
 	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (8) 'BLSToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BLSToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BLSToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (9) 'BlobKzgCommitments'
 	{
 		if size := len(b.BlobKzgCommitments); size > 16 {
 			err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.BlobKzgCommitments", size, 16)
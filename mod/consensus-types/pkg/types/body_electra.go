@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// BeaconBlockBodyElectra represents the body of a beacon block in the
+// Electra chain.
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body_electra.go -objs BeaconBlockBodyElectra -include ../../../primitives/pkg/crypto,./payload_electra.go,../../../engine-primitives/pkg/engine-primitives/deposit_request.go,../../../engine-primitives/pkg/engine-primitives/withdrawal_request.go,./body.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,./header.go,./proposer_slashing.go,./attester_slashing.go,./voluntary_exit.go,./bls_to_execution_change.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body_electra.ssz.go
+type BeaconBlockBodyElectra struct {
+	BeaconBlockBodyBase
+	// ExecutionPayload is the execution payload of the body.
+	ExecutionPayload *ExecutableDataElectra
+	// BLSToExecutionChanges is the list of BLS to execution changes included
+	// in the body.
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `ssz-max:"16"`
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `ssz-size:"?,48" ssz-max:"16"`
+}
+
+// SetEth1Data sets the Eth1Data of the BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetEth1Data(eth1Data *Eth1Data) {
+	b.Eth1Data = eth1Data
+}
+
+// IsNil checks if the BeaconBlockBodyElectra is nil.
+func (b *BeaconBlockBodyElectra) IsNil() bool {
+	return b == nil
+}
+
+// GetExecutionPayload returns the ExecutionPayload of the Body.
+func (
+	b *BeaconBlockBodyElectra,
+) GetExecutionPayload() *ExecutionPayload {
+	return &ExecutionPayload{InnerExecutionPayload: b.ExecutionPayload}
+}
+
+// SetExecutionData sets the ExecutionData of the BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetExecutionData(
+	executionData *ExecutionPayload,
+) error {
+	var ok bool
+	b.ExecutionPayload, ok = executionData.
+		InnerExecutionPayload.(*ExecutableDataElectra)
+	if !ok {
+		return errors.New("invalid execution data type")
+	}
+	return nil
+}
+
+// GetBLSToExecutionChanges returns the BLSToExecutionChanges of the
+// BeaconBlockBodyElectra.
+func (
+	b *BeaconBlockBodyElectra,
+) GetBLSToExecutionChanges() []*SignedBLSToExecutionChange {
+	return b.BLSToExecutionChanges
+}
+
+// SetBLSToExecutionChanges sets the BLSToExecutionChanges of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetBLSToExecutionChanges(
+	changes []*SignedBLSToExecutionChange,
+) {
+	b.BLSToExecutionChanges = changes
+}
+
+// GetBlobKzgCommitments returns the BlobKzgCommitments of the Body.
+func (
+	b *BeaconBlockBodyElectra,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.BlobKzgCommitments
+}
+
+// SetBlobKzgCommitments sets the BlobKzgCommitments of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetBlobKzgCommitments(
+	commitments eip4844.KZGCommitments[common.ExecutionHash],
+) {
+	b.BlobKzgCommitments = commitments
+}
+
+// GetTopLevelRoots returns the top-level roots of the BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) GetTopLevelRoots() ([][32]byte, error) {
+	layer := make([][32]byte, BodyLengthElectra)
+	var err error
+	randao := b.GetRandaoReveal()
+	layer[0], err = ssz.MerkleizeByteSlice[math.U64, [32]byte](randao[:])
+	if err != nil {
+		return nil, err
+	}
+
+	layer[1], err = b.Eth1Data.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[2] = b.GetGraffiti()
+
+	layer[3], err = ProposerSlashings(
+		b.GetProposerSlashings(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[4], err = AttesterSlashings(
+		b.GetAttesterSlashings(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[5], err = Deposits(b.GetDeposits()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[6], err = VoluntaryExits(b.GetVoluntaryExits()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[7], err = b.GetExecutionPayload().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[8], err = BLSToExecutionChanges(
+		b.GetBLSToExecutionChanges(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	// KZG commitments is not needed
+	return layer, nil
+}
+
+// Length returns the number of fields in the BeaconBlockBodyElectra struct.
+func (b *BeaconBlockBodyElectra) Length() uint64 {
+	return BodyLengthElectra
+}
@@ -133,3 +133,35 @@ func TestBeaconBlockBodyDeneb_GetTopLevelRoots(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, roots)
 }
+
+func TestBeaconBlockBodyDeneb_SetProposerSlashings(t *testing.T) {
+	body := types.BeaconBlockBodyDeneb{}
+	proposerSlashings := []*types.ProposerSlashing{{}}
+	body.SetProposerSlashings(proposerSlashings)
+
+	require.Equal(t, proposerSlashings, body.GetProposerSlashings())
+}
+
+func TestBeaconBlockBodyDeneb_SetAttesterSlashings(t *testing.T) {
+	body := types.BeaconBlockBodyDeneb{}
+	attesterSlashings := []*types.AttesterSlashing{{}}
+	body.SetAttesterSlashings(attesterSlashings)
+
+	require.Equal(t, attesterSlashings, body.GetAttesterSlashings())
+}
+
+func TestBeaconBlockBodyDeneb_SetVoluntaryExits(t *testing.T) {
+	body := types.BeaconBlockBodyDeneb{}
+	voluntaryExits := []*types.SignedVoluntaryExit{{}}
+	body.SetVoluntaryExits(voluntaryExits)
+
+	require.Equal(t, voluntaryExits, body.GetVoluntaryExits())
+}
+
+func TestBeaconBlockBodyDeneb_SetBLSToExecutionChanges(t *testing.T) {
+	body := types.BeaconBlockBodyDeneb{}
+	changes := []*types.SignedBLSToExecutionChange{{}}
+	body.SetBLSToExecutionChanges(changes)
+
+	require.Equal(t, changes, body.GetBLSToExecutionChanges())
+}
@@ -21,10 +21,12 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
@@ -45,6 +47,8 @@ type Deposit struct {
 	Signature crypto.BLSSignature `json:"signature"   ssz-max:"96"`
 	// Index of the deposit in the deposit contract.
 	Index uint64 `json:"index"`
+	// Proof is the Merkle proof of the deposit data against the deposit root.
+	Proof [33]common.Root `json:"proof"       ssz-size:"33,32"`
 }
 
 // NewDeposit creates a new Deposit instance.
@@ -106,6 +110,52 @@ func (d *Deposit) VerifySignature(
 	)
 }
 
+// GetDepositDataRoot returns the hash tree root of the deposit data, i.e. the
+// leaf inserted into the deposit contract's Merkle tree.
+func (d *Deposit) GetDepositDataRoot() (common.Root, error) {
+	return (&DepositData{
+		Pubkey:      d.Pubkey,
+		Credentials: d.Credentials,
+		Amount:      d.Amount,
+		Signature:   d.Signature,
+	}).HashTreeRoot()
+}
+
+// VerifyMerkleProof verifies the deposit's Merkle proof against the given
+// deposit root. The proof is expected to contain the branch of the deposit
+// contract tree followed by the mixed in deposit count.
+func (d *Deposit) VerifyMerkleProof(depositRoot common.Root) error {
+	leaf, err := d.GetDepositDataRoot()
+	if err != nil {
+		return err
+	}
+
+	if !merkle.IsValidMerkleBranch(
+		leaf,
+		d.Proof[:],
+		constants.DepositContractTreeDepth+1,
+		d.Index,
+		depositRoot,
+	) {
+		return errors.Wrapf(
+			ErrInvalidDepositProof,
+			"deposit index %d, deposit root %s",
+			d.Index, depositRoot,
+		)
+	}
+	return nil
+}
+
+// GetProof returns the Merkle proof of the deposit.
+func (d *Deposit) GetProof() [33]common.Root {
+	return d.Proof
+}
+
+// SetProof sets the Merkle proof of the deposit.
+func (d *Deposit) SetProof(proof [33]common.Root) {
+	d.Proof = proof
+}
+
 // GetAmount returns the deposit amount in gwei.
 func (d *Deposit) GetAmount() math.Gwei {
 	return d.Amount
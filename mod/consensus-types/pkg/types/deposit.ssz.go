@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 34c1f0dc91b4546f6e13116aeaed47520f82a3afeaf073d799406eec3a4baf61
+// Hash: 7b046e7509af02666d00423d6e49fe6af3093279575344055592d61a727a0458
 // Version: 0.1.3
 package types
 
@@ -32,6 +32,11 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Field (4) 'Index'
 	dst = ssz.MarshalUint64(dst, d.Index)
 
+	// Field (5) 'Proof'
+	for ii := 0; ii < 33; ii++ {
+		dst = append(dst, d.Proof[ii][:]...)
+	}
+
 	return
 }
 
@@ -39,7 +44,7 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 192 {
+	if size != 1248 {
 		return ssz.ErrSize
 	}
 
@@ -58,12 +63,18 @@ func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	// Field (4) 'Index'
 	d.Index = ssz.UnmarshallUint64(buf[184:192])
 
+	// Field (5) 'Proof'
+
+	for ii := 0; ii < 33; ii++ {
+		copy(d.Proof[ii][:], buf[192:1248][ii*32:(ii+1)*32])
+	}
+
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the Deposit object
 func (d *Deposit) SizeSSZ() (size int) {
-	size = 192
+	size = 1248
 	return
 }
 
@@ -91,6 +102,15 @@ func (d *Deposit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (4) 'Index'
 	hh.PutUint64(d.Index)
 
+	// Field (5) 'Proof'
+	{
+		subIndx := hh.Index()
+		for _, i := range d.Proof {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
 	hh.Merkleize(indx)
 	return
 }
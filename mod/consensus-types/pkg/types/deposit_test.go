@@ -25,8 +25,10 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/stretchr/testify/require"
 )
@@ -93,7 +95,7 @@ func TestDeposit_HashTreeRoot(t *testing.T) {
 func TestDeposit_SizeSSZ(t *testing.T) {
 	deposit := generateValidDeposit()
 
-	require.Equal(t, 192, deposit.SizeSSZ())
+	require.Equal(t, 1248, deposit.SizeSSZ())
 }
 
 func TestDeposit_HashTreeRootWith(t *testing.T) {
@@ -113,7 +115,7 @@ func TestDeposit_GetTree(t *testing.T) {
 
 func TestDeposit_UnmarshalSSZ_ErrSize(t *testing.T) {
 	// Create a byte slice of incorrect size
-	buf := make([]byte, 10) // size less than 192
+	buf := make([]byte, 10) // size less than 1248
 
 	var unmarshalledDeposit types.Deposit
 	err := unmarshalledDeposit.UnmarshalSSZ(buf)
@@ -150,3 +152,52 @@ func TestDeposit_Getters(t *testing.T) {
 	require.Equal(t, deposit.Signature, deposit.GetSignature())
 	require.Equal(t, deposit.Index, deposit.GetIndex())
 }
+
+func TestDeposit_VerifyMerkleProof(t *testing.T) {
+	deposits := make([]*types.Deposit, 3)
+	leaves := make([]common.Root, len(deposits))
+	for i := range deposits {
+		deposits[i] = &types.Deposit{
+			Pubkey: crypto.BLSPubkey{byte(i + 1)},
+			Amount: math.Gwei(32e9),
+			Index:  uint64(i),
+		}
+		leaf, err := deposits[i].GetDepositDataRoot()
+		require.NoError(t, err)
+		leaves[i] = leaf
+	}
+
+	tree, err := merkle.NewTreeFromLeavesWithDepth[common.Root, common.Root](
+		leaves, constants.DepositContractTreeDepth,
+	)
+	require.NoError(t, err)
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+
+	for i, deposit := range deposits {
+		proof, errProof := tree.MerkleProofWithMixin(uint64(i))
+		require.NoError(t, errProof)
+		var branch [33]common.Root
+		for j := range proof {
+			branch[j] = proof[j]
+		}
+		deposit.SetProof(branch)
+		require.Equal(t, branch, deposit.GetProof())
+		require.NoError(t, deposit.VerifyMerkleProof(root))
+	}
+
+	// A proof against a different root must be rejected.
+	require.ErrorIs(
+		t,
+		deposits[0].VerifyMerkleProof(common.Root{0x01}),
+		types.ErrInvalidDepositProof,
+	)
+
+	// A proof for a tampered deposit must be rejected.
+	deposits[1].Amount++
+	require.ErrorIs(
+		t,
+		deposits[1].VerifyMerkleProof(root),
+		types.ErrInvalidDepositProof,
+	)
+}
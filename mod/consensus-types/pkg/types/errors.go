@@ -35,4 +35,26 @@ var (
 	// ErrForkVersionNotSupported is an error for when the fork
 	// version is not supported.
 	ErrForkVersionNotSupported = errors.New("fork version not supported")
+
+	// ErrInvalidProposerSlashing is an error for when a proposer slashing
+	// is invalid.
+	ErrInvalidProposerSlashing = errors.New("invalid proposer slashing")
+
+	// ErrInvalidAttesterSlashing is an error for when an attester slashing
+	// is invalid.
+	ErrInvalidAttesterSlashing = errors.New("invalid attester slashing")
+
+	// ErrInvalidDepositProof is an error for when the Merkle proof of a
+	// deposit does not match the deposit root.
+	ErrInvalidDepositProof = errors.New("invalid deposit merkle proof")
+
+	// ErrInvalidVoluntaryExit is an error for when a voluntary exit is
+	// invalid.
+	ErrInvalidVoluntaryExit = errors.New("invalid voluntary exit")
+
+	// ErrInvalidBLSToExecutionChange is an error for when a BLS to execution
+	// change is invalid.
+	ErrInvalidBLSToExecutionChange = errors.New(
+		"invalid bls to execution change",
+	)
 )
@@ -53,3 +53,13 @@ func (e *Eth1Data) New(
 func (e *Eth1Data) GetDepositCount() math.U64 {
 	return math.U64(e.DepositCount)
 }
+
+// GetDepositRoot returns the deposit root.
+func (e *Eth1Data) GetDepositRoot() common.Root {
+	return e.DepositRoot
+}
+
+// GetBlockHash returns the block hash.
+func (e *Eth1Data) GetBlockHash() common.ExecutionHash {
+	return e.BlockHash
+}
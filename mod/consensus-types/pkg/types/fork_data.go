@@ -76,6 +76,18 @@ func (fd *ForkData) ComputeDomain(
 	), nil
 }
 
+// ComputeForkDigest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+//
+//nolint:lll
+func (fd *ForkData) ComputeForkDigest() (common.ForkDigest, error) {
+	forkDataRoot, err := fd.HashTreeRoot()
+	if err != nil {
+		return common.ForkDigest{}, err
+	}
+	return common.ForkDigest(forkDataRoot[:4]), nil
+}
+
 // ComputeRandaoSigningRoot computes the randao signing root.
 func (fd *ForkData) ComputeRandaoSigningRoot(
 	domainType common.DomainType,
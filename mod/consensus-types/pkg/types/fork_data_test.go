@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,6 +92,31 @@ func TestForkData_ComputeDomain(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestForkData_ComputeForkDigest(t *testing.T) {
+	var (
+		genesisValidatorsRoot = common.Root{0x01}
+		deneb                 = types.NewForkData(
+			version.FromUint32[common.Version](version.Deneb),
+			genesisValidatorsRoot,
+		)
+		electra = types.NewForkData(
+			version.FromUint32[common.Version](version.Electra),
+			genesisValidatorsRoot,
+		)
+	)
+
+	denebDigest, err := deneb.ComputeForkDigest()
+	require.NoError(t, err)
+	root, err := deneb.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, common.ForkDigest(root[:4]), denebDigest)
+
+	// Each fork of the schedule has its own digest.
+	electraDigest, err := electra.ComputeForkDigest()
+	require.NoError(t, err)
+	require.NotEqual(t, denebDigest, electraDigest)
+}
+
 func TestForkData_ComputeRandaoSigningRoot(t *testing.T) {
 	fd := &types.ForkData{
 		CurrentVersion:        common.Version{},
@@ -42,6 +42,10 @@ type RawBeaconBlockBody interface {
 // WriteOnlyBeaconBlockBody is the interface for a write-only beacon block body.
 type WriteOnlyBeaconBlockBody interface {
 	SetDeposits([]*Deposit)
+	SetProposerSlashings([]*ProposerSlashing)
+	SetAttesterSlashings([]*AttesterSlashing)
+	SetVoluntaryExits([]*SignedVoluntaryExit)
+	SetBLSToExecutionChanges([]*SignedBLSToExecutionChange)
 	SetEth1Data(*Eth1Data)
 	SetExecutionData(*ExecutionPayload) error
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
@@ -56,6 +60,10 @@ type ReadOnlyBeaconBlockBody interface {
 
 	// Execution returns the execution data of the block.
 	GetDeposits() []*Deposit
+	GetProposerSlashings() []*ProposerSlashing
+	GetAttesterSlashings() []*AttesterSlashing
+	GetVoluntaryExits() []*SignedVoluntaryExit
+	GetBLSToExecutionChanges() []*SignedBLSToExecutionChange
 	GetEth1Data() *Eth1Data
 	GetGraffiti() bytes.B32
 	GetRandaoReveal() crypto.BLSSignature
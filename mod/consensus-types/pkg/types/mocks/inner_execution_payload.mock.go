@@ -165,6 +165,53 @@ func (_c *InnerExecutionPayload_GetBlockHash_Call) RunAndReturn(run func() commo
 	return _c
 }
 
+// GetDepositRequests provides a mock function with given fields:
+func (_m *InnerExecutionPayload) GetDepositRequests() []*engineprimitives.DepositRequest {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDepositRequests")
+	}
+
+	var r0 []*engineprimitives.DepositRequest
+	if rf, ok := ret.Get(0).(func() []*engineprimitives.DepositRequest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*engineprimitives.DepositRequest)
+		}
+	}
+
+	return r0
+}
+
+// InnerExecutionPayload_GetDepositRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDepositRequests'
+type InnerExecutionPayload_GetDepositRequests_Call struct {
+	*mock.Call
+}
+
+// GetDepositRequests is a helper method to define mock.On call
+func (_e *InnerExecutionPayload_Expecter) GetDepositRequests() *InnerExecutionPayload_GetDepositRequests_Call {
+	return &InnerExecutionPayload_GetDepositRequests_Call{Call: _e.mock.On("GetDepositRequests")}
+}
+
+func (_c *InnerExecutionPayload_GetDepositRequests_Call) Run(run func()) *InnerExecutionPayload_GetDepositRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InnerExecutionPayload_GetDepositRequests_Call) Return(_a0 []*engineprimitives.DepositRequest) *InnerExecutionPayload_GetDepositRequests_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InnerExecutionPayload_GetDepositRequests_Call) RunAndReturn(run func() []*engineprimitives.DepositRequest) *InnerExecutionPayload_GetDepositRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExcessBlobGas provides a mock function with given fields:
 func (_m *InnerExecutionPayload) GetExcessBlobGas() math.U64 {
 	ret := _m.Called()
@@ -766,6 +813,53 @@ func (_c *InnerExecutionPayload_GetTransactions_Call) RunAndReturn(run func() []
 	return _c
 }
 
+// GetWithdrawalRequests provides a mock function with given fields:
+func (_m *InnerExecutionPayload) GetWithdrawalRequests() []*engineprimitives.WithdrawalRequest {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithdrawalRequests")
+	}
+
+	var r0 []*engineprimitives.WithdrawalRequest
+	if rf, ok := ret.Get(0).(func() []*engineprimitives.WithdrawalRequest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*engineprimitives.WithdrawalRequest)
+		}
+	}
+
+	return r0
+}
+
+// InnerExecutionPayload_GetWithdrawalRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithdrawalRequests'
+type InnerExecutionPayload_GetWithdrawalRequests_Call struct {
+	*mock.Call
+}
+
+// GetWithdrawalRequests is a helper method to define mock.On call
+func (_e *InnerExecutionPayload_Expecter) GetWithdrawalRequests() *InnerExecutionPayload_GetWithdrawalRequests_Call {
+	return &InnerExecutionPayload_GetWithdrawalRequests_Call{Call: _e.mock.On("GetWithdrawalRequests")}
+}
+
+func (_c *InnerExecutionPayload_GetWithdrawalRequests_Call) Run(run func()) *InnerExecutionPayload_GetWithdrawalRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InnerExecutionPayload_GetWithdrawalRequests_Call) Return(_a0 []*engineprimitives.WithdrawalRequest) *InnerExecutionPayload_GetWithdrawalRequests_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InnerExecutionPayload_GetWithdrawalRequests_Call) RunAndReturn(run func() []*engineprimitives.WithdrawalRequest) *InnerExecutionPayload_GetWithdrawalRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithdrawals provides a mock function with given fields:
 func (_m *InnerExecutionPayload) GetWithdrawals() []*engineprimitives.Withdrawal {
 	ret := _m.Called()
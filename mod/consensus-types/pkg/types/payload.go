@@ -44,6 +44,8 @@ type InnerExecutionPayload interface {
 	executionPayloadBody
 	GetTransactions() [][]byte
 	GetWithdrawals() []*engineprimitives.Withdrawal
+	GetDepositRequests() []*engineprimitives.DepositRequest
+	GetWithdrawalRequests() []*engineprimitives.WithdrawalRequest
 }
 
 // Empty returns an empty ExecutionPayload for the given fork version.
@@ -52,6 +54,8 @@ func (e *ExecutionPayload) Empty(forkVersion uint32) *ExecutionPayload {
 	switch forkVersion {
 	case version.Deneb:
 		e.InnerExecutionPayload = &ExecutableDataDeneb{}
+	case version.Electra:
+		e.InnerExecutionPayload = &ExecutableDataElectra{}
 	default:
 		panic("unknown fork version")
 	}
@@ -111,11 +115,57 @@ func (e *ExecutionPayload) ToHeader() (*ExecutionPayloadHeader, error) {
 				ExcessBlobGas:    e.GetExcessBlobGas(),
 			},
 		}, nil
+	case version.Electra:
+		return e.toElectraHeader(txsRoot, withdrawalsRoot)
 	default:
 		return nil, errors.New("unknown fork version")
 	}
 }
 
+// toElectraHeader converts the ExecutionPayload to an Electra
+// ExecutionPayloadHeader, committing to the requests of the payload.
+func (e *ExecutionPayload) toElectraHeader(
+	txsRoot, withdrawalsRoot primitives.Root,
+) (*ExecutionPayloadHeader, error) {
+	depositRequestsRoot, err := engineprimitives.DepositRequests(
+		e.GetDepositRequests(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawalRequestsRoot, err := engineprimitives.WithdrawalRequests(
+		e.GetWithdrawalRequests(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExecutionPayloadHeader{
+		InnerExecutionPayloadHeader: &ExecutionPayloadHeaderElectra{
+			ParentHash:             e.GetParentHash(),
+			FeeRecipient:           e.GetFeeRecipient(),
+			StateRoot:              e.GetStateRoot(),
+			ReceiptsRoot:           e.GetReceiptsRoot(),
+			LogsBloom:              e.GetLogsBloom(),
+			Random:                 e.GetPrevRandao(),
+			Number:                 e.GetNumber(),
+			GasLimit:               e.GetGasLimit(),
+			GasUsed:                e.GetGasUsed(),
+			Timestamp:              e.GetTimestamp(),
+			ExtraData:              e.GetExtraData(),
+			BaseFeePerGas:          e.GetBaseFeePerGas(),
+			BlockHash:              e.GetBlockHash(),
+			TransactionsRoot:       txsRoot,
+			WithdrawalsRoot:        withdrawalsRoot,
+			BlobGasUsed:            e.GetBlobGasUsed(),
+			ExcessBlobGas:          e.GetExcessBlobGas(),
+			DepositRequestsRoot:    depositRequestsRoot,
+			WithdrawalRequestsRoot: withdrawalRequestsRoot,
+		},
+	}, nil
+}
+
 // ExecutableDataDeneb is the execution payload for Deneb.
 //
 //go:generate go run github.com/ferranbt/fastssz/sszgen -path payload.go -objs ExecutableDataDeneb -include ../../../primitives/pkg/common,../../../primitives/pkg/bytes,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,../../../primitives/pkg/common,../../../primitives/pkg/math,../../../primitives/pkg/bytes,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil,$GOPATH/pkg/mod/github.com/holiman/uint256@v1.2.4 -output payload.ssz.go
@@ -247,3 +297,19 @@ func (d *ExecutableDataDeneb) GetBlobGasUsed() math.U64 {
 func (d *ExecutableDataDeneb) GetExcessBlobGas() math.U64 {
 	return d.ExcessBlobGas
 }
+
+// GetDepositRequests returns the deposit requests of the ExecutableDataDeneb,
+// which are not part of the payload before Electra.
+func (
+	d *ExecutableDataDeneb,
+) GetDepositRequests() []*engineprimitives.DepositRequest {
+	return nil
+}
+
+// GetWithdrawalRequests returns the withdrawal requests of the
+// ExecutableDataDeneb, which are not part of the payload before Electra.
+func (
+	d *ExecutableDataDeneb,
+) GetWithdrawalRequests() []*engineprimitives.WithdrawalRequest {
+	return nil
+}
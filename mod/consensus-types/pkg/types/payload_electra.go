@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ExecutableDataElectra is the execution payload for Electra. It extends the
+// Deneb payload with the requests made by the execution layer to the consensus
+// layer.
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path payload_electra.go -objs ExecutableDataElectra -include ../../../primitives/pkg/common,../../../primitives/pkg/bytes,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,../../../engine-primitives/pkg/engine-primitives/deposit_request.go,../../../engine-primitives/pkg/engine-primitives/withdrawal_request.go,../../../primitives/pkg/crypto,../../../primitives/pkg/common,../../../primitives/pkg/math,../../../primitives/pkg/bytes,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil,$GOPATH/pkg/mod/github.com/holiman/uint256@v1.2.4 -output payload_electra.ssz.go
+//go:generate go run github.com/fjl/gencodec -type ExecutableDataElectra -field-override executableDataElectraMarshaling -out payload_electra.json.go
+//nolint:lll
+type ExecutableDataElectra struct {
+	ParentHash         common.ExecutionHash                  `json:"parentHash"    ssz-size:"32"  gencodec:"required"`
+	FeeRecipient       common.ExecutionAddress               `json:"feeRecipient"  ssz-size:"20"  gencodec:"required"`
+	StateRoot          bytes.B32                             `json:"stateRoot"     ssz-size:"32"  gencodec:"required"`
+	ReceiptsRoot       bytes.B32                             `json:"receiptsRoot"  ssz-size:"32"  gencodec:"required"`
+	LogsBloom          []byte                                `json:"logsBloom"     ssz-size:"256" gencodec:"required"`
+	Random             bytes.B32                             `json:"prevRandao"    ssz-size:"32"  gencodec:"required"`
+	Number             math.U64                              `json:"blockNumber"                  gencodec:"required"`
+	GasLimit           math.U64                              `json:"gasLimit"                     gencodec:"required"`
+	GasUsed            math.U64                              `json:"gasUsed"                      gencodec:"required"`
+	Timestamp          math.U64                              `json:"timestamp"                    gencodec:"required"`
+	ExtraData          []byte                                `json:"extraData"                    gencodec:"required" ssz-max:"32"`
+	BaseFeePerGas      math.Wei                              `json:"baseFeePerGas" ssz-size:"32"  gencodec:"required"`
+	BlockHash          common.ExecutionHash                  `json:"blockHash"     ssz-size:"32"  gencodec:"required"`
+	Transactions       [][]byte                              `json:"transactions"  ssz-size:"?,?" gencodec:"required" ssz-max:"1048576,1073741824"`
+	Withdrawals        []*engineprimitives.Withdrawal        `json:"withdrawals"                                      ssz-max:"16"`
+	BlobGasUsed        math.U64                              `json:"blobGasUsed"`
+	ExcessBlobGas      math.U64                              `json:"excessBlobGas"`
+	DepositRequests    []*engineprimitives.DepositRequest    `json:"depositRequests"    ssz-max:"8192"`
+	WithdrawalRequests []*engineprimitives.WithdrawalRequest `json:"withdrawalRequests" ssz-max:"16"`
+}
+
+// JSON type overrides for ExecutableDataElectra.
+type executableDataElectraMarshaling struct {
+	ExtraData    bytes.Bytes
+	LogsBloom    bytes.Bytes
+	Transactions []bytes.Bytes
+}
+
+// Version returns the version of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) Version() uint32 {
+	return version.Electra
+}
+
+// IsNil checks if the ExecutableDataElectra is nil.
+func (d *ExecutableDataElectra) IsNil() bool {
+	return d == nil
+}
+
+// IsBlinded checks if the ExecutableDataElectra is blinded.
+func (d *ExecutableDataElectra) IsBlinded() bool {
+	return false
+}
+
+// GetParentHash returns the parent hash of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetParentHash() common.ExecutionHash {
+	return d.ParentHash
+}
+
+// GetFeeRecipient returns the fee recipient address of the
+// ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetFeeRecipient() common.ExecutionAddress {
+	return d.FeeRecipient
+}
+
+// GetStateRoot returns the state root of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetStateRoot() bytes.B32 {
+	return d.StateRoot
+}
+
+// GetReceiptsRoot returns the receipts root of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetReceiptsRoot() bytes.B32 {
+	return d.ReceiptsRoot
+}
+
+// GetLogsBloom returns the logs bloom of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetLogsBloom() []byte {
+	return d.LogsBloom
+}
+
+// GetPrevRandao returns the previous Randao value of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetPrevRandao() bytes.B32 {
+	return d.Random
+}
+
+// GetNumber returns the block number of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetNumber() math.U64 {
+	return d.Number
+}
+
+// GetGasLimit returns the gas limit of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetGasLimit() math.U64 {
+	return d.GasLimit
+}
+
+// GetGasUsed returns the gas used of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetGasUsed() math.U64 {
+	return d.GasUsed
+}
+
+// GetTimestamp returns the timestamp of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetTimestamp() math.U64 {
+	return d.Timestamp
+}
+
+// GetExtraData returns the extra data of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetExtraData() []byte {
+	return d.ExtraData
+}
+
+// GetBaseFeePerGas returns the base fee per gas of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetBaseFeePerGas() math.Wei {
+	return d.BaseFeePerGas
+}
+
+// GetBlockHash returns the block hash of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetBlockHash() common.ExecutionHash {
+	return d.BlockHash
+}
+
+// GetTransactions returns the transactions of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetTransactions() [][]byte {
+	return d.Transactions
+}
+
+// GetWithdrawals returns the withdrawals of the ExecutableDataElectra.
+func (
+	d *ExecutableDataElectra,
+) GetWithdrawals() []*engineprimitives.Withdrawal {
+	return d.Withdrawals
+}
+
+// GetBlobGasUsed returns the blob gas used of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetBlobGasUsed() math.U64 {
+	return d.BlobGasUsed
+}
+
+// GetExcessBlobGas returns the excess blob gas of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) GetExcessBlobGas() math.U64 {
+	return d.ExcessBlobGas
+}
+
+// GetDepositRequests returns the deposit requests of the
+// ExecutableDataElectra.
+func (
+	d *ExecutableDataElectra,
+) GetDepositRequests() []*engineprimitives.DepositRequest {
+	return d.DepositRequests
+}
+
+// GetWithdrawalRequests returns the withdrawal requests of the
+// ExecutableDataElectra.
+func (
+	d *ExecutableDataElectra,
+) GetWithdrawalRequests() []*engineprimitives.WithdrawalRequest {
+	return d.WithdrawalRequests
+}
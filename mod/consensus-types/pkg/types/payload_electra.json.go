@@ -0,0 +1,170 @@
+// Code generated by github.com/fjl/gencodec. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ = (*executableDataElectraMarshaling)(nil)
+
+// MarshalJSON marshals as JSON.
+func (e ExecutableDataElectra) MarshalJSON() ([]byte, error) {
+	type ExecutableDataElectra struct {
+		ParentHash         common.Hash                           `json:"parentHash"    ssz-size:"32"  gencodec:"required"`
+		FeeRecipient       common.Address                        `json:"feeRecipient"  ssz-size:"20"  gencodec:"required"`
+		StateRoot          bytes.B32                             `json:"stateRoot"     ssz-size:"32"  gencodec:"required"`
+		ReceiptsRoot       bytes.B32                             `json:"receiptsRoot"  ssz-size:"32"  gencodec:"required"`
+		LogsBloom          bytes.Bytes                           `json:"logsBloom"     ssz-size:"256" gencodec:"required"`
+		Random             bytes.B32                             `json:"prevRandao"    ssz-size:"32"  gencodec:"required"`
+		Number             math.U64                              `json:"blockNumber"                  gencodec:"required"`
+		GasLimit           math.U64                              `json:"gasLimit"                     gencodec:"required"`
+		GasUsed            math.U64                              `json:"gasUsed"                      gencodec:"required"`
+		Timestamp          math.U64                              `json:"timestamp"                    gencodec:"required"`
+		ExtraData          bytes.Bytes                           `json:"extraData"                    gencodec:"required" ssz-max:"32"`
+		BaseFeePerGas      math.U256L                            `json:"baseFeePerGas" ssz-size:"32"  gencodec:"required"`
+		BlockHash          common.Hash                           `json:"blockHash"     ssz-size:"32"  gencodec:"required"`
+		Transactions       []bytes.Bytes                         `json:"transactions"  ssz-size:"?,?" gencodec:"required" ssz-max:"1048576,1073741824"`
+		Withdrawals        []*engineprimitives.Withdrawal        `json:"withdrawals"                                      ssz-max:"16"`
+		BlobGasUsed        math.U64                              `json:"blobGasUsed"`
+		ExcessBlobGas      math.U64                              `json:"excessBlobGas"`
+		DepositRequests    []*engineprimitives.DepositRequest    `json:"depositRequests"    ssz-max:"8192"`
+		WithdrawalRequests []*engineprimitives.WithdrawalRequest `json:"withdrawalRequests" ssz-max:"16"`
+	}
+	var enc ExecutableDataElectra
+	enc.ParentHash = e.ParentHash
+	enc.FeeRecipient = e.FeeRecipient
+	enc.StateRoot = e.StateRoot
+	enc.ReceiptsRoot = e.ReceiptsRoot
+	enc.LogsBloom = e.LogsBloom
+	enc.Random = e.Random
+	enc.Number = e.Number
+	enc.GasLimit = e.GasLimit
+	enc.GasUsed = e.GasUsed
+	enc.Timestamp = e.Timestamp
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash
+	if e.Transactions != nil {
+		enc.Transactions = make([]bytes.Bytes, len(e.Transactions))
+		for k, v := range e.Transactions {
+			enc.Transactions[k] = v
+		}
+	}
+	enc.Withdrawals = e.Withdrawals
+	enc.BlobGasUsed = e.BlobGasUsed
+	enc.ExcessBlobGas = e.ExcessBlobGas
+	enc.DepositRequests = e.DepositRequests
+	enc.WithdrawalRequests = e.WithdrawalRequests
+	return json.Marshal(&enc)
+}
+
+// UnmarshalJSON unmarshals from JSON.
+func (e *ExecutableDataElectra) UnmarshalJSON(input []byte) error {
+	type ExecutableDataElectra struct {
+		ParentHash         *common.Hash                          `json:"parentHash"    ssz-size:"32"  gencodec:"required"`
+		FeeRecipient       *common.Address                       `json:"feeRecipient"  ssz-size:"20"  gencodec:"required"`
+		StateRoot          *bytes.B32                            `json:"stateRoot"     ssz-size:"32"  gencodec:"required"`
+		ReceiptsRoot       *bytes.B32                            `json:"receiptsRoot"  ssz-size:"32"  gencodec:"required"`
+		LogsBloom          *bytes.Bytes                          `json:"logsBloom"     ssz-size:"256" gencodec:"required"`
+		Random             *bytes.B32                            `json:"prevRandao"    ssz-size:"32"  gencodec:"required"`
+		Number             *math.U64                             `json:"blockNumber"                  gencodec:"required"`
+		GasLimit           *math.U64                             `json:"gasLimit"                     gencodec:"required"`
+		GasUsed            *math.U64                             `json:"gasUsed"                      gencodec:"required"`
+		Timestamp          *math.U64                             `json:"timestamp"                    gencodec:"required"`
+		ExtraData          *bytes.Bytes                          `json:"extraData"                    gencodec:"required" ssz-max:"32"`
+		BaseFeePerGas      *math.U256L                           `json:"baseFeePerGas" ssz-size:"32"  gencodec:"required"`
+		BlockHash          *common.Hash                          `json:"blockHash"     ssz-size:"32"  gencodec:"required"`
+		Transactions       []bytes.Bytes                         `json:"transactions"  ssz-size:"?,?" gencodec:"required" ssz-max:"1048576,1073741824"`
+		Withdrawals        []*engineprimitives.Withdrawal        `json:"withdrawals"                                      ssz-max:"16"`
+		BlobGasUsed        *math.U64                             `json:"blobGasUsed"`
+		ExcessBlobGas      *math.U64                             `json:"excessBlobGas"`
+		DepositRequests    []*engineprimitives.DepositRequest    `json:"depositRequests"    ssz-max:"8192"`
+		WithdrawalRequests []*engineprimitives.WithdrawalRequest `json:"withdrawalRequests" ssz-max:"16"`
+	}
+	var dec ExecutableDataElectra
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.ParentHash == nil {
+		return errors.New("missing required field 'parentHash' for ExecutableDataElectra")
+	}
+	e.ParentHash = *dec.ParentHash
+	if dec.FeeRecipient == nil {
+		return errors.New("missing required field 'feeRecipient' for ExecutableDataElectra")
+	}
+	e.FeeRecipient = *dec.FeeRecipient
+	if dec.StateRoot == nil {
+		return errors.New("missing required field 'stateRoot' for ExecutableDataElectra")
+	}
+	e.StateRoot = *dec.StateRoot
+	if dec.ReceiptsRoot == nil {
+		return errors.New("missing required field 'receiptsRoot' for ExecutableDataElectra")
+	}
+	e.ReceiptsRoot = *dec.ReceiptsRoot
+	if dec.LogsBloom == nil {
+		return errors.New("missing required field 'logsBloom' for ExecutableDataElectra")
+	}
+	e.LogsBloom = *dec.LogsBloom
+	if dec.Random == nil {
+		return errors.New("missing required field 'prevRandao' for ExecutableDataElectra")
+	}
+	e.Random = *dec.Random
+	if dec.Number == nil {
+		return errors.New("missing required field 'blockNumber' for ExecutableDataElectra")
+	}
+	e.Number = *dec.Number
+	if dec.GasLimit == nil {
+		return errors.New("missing required field 'gasLimit' for ExecutableDataElectra")
+	}
+	e.GasLimit = *dec.GasLimit
+	if dec.GasUsed == nil {
+		return errors.New("missing required field 'gasUsed' for ExecutableDataElectra")
+	}
+	e.GasUsed = *dec.GasUsed
+	if dec.Timestamp == nil {
+		return errors.New("missing required field 'timestamp' for ExecutableDataElectra")
+	}
+	e.Timestamp = *dec.Timestamp
+	if dec.ExtraData == nil {
+		return errors.New("missing required field 'extraData' for ExecutableDataElectra")
+	}
+	e.ExtraData = *dec.ExtraData
+	if dec.BaseFeePerGas == nil {
+		return errors.New("missing required field 'baseFeePerGas' for ExecutableDataElectra")
+	}
+	e.BaseFeePerGas = *dec.BaseFeePerGas
+	if dec.BlockHash == nil {
+		return errors.New("missing required field 'blockHash' for ExecutableDataElectra")
+	}
+	e.BlockHash = *dec.BlockHash
+	if dec.Transactions == nil {
+		return errors.New("missing required field 'transactions' for ExecutableDataElectra")
+	}
+	e.Transactions = make([][]byte, len(dec.Transactions))
+	for k, v := range dec.Transactions {
+		e.Transactions[k] = v
+	}
+	if dec.Withdrawals != nil {
+		e.Withdrawals = dec.Withdrawals
+	}
+	if dec.BlobGasUsed != nil {
+		e.BlobGasUsed = *dec.BlobGasUsed
+	}
+	if dec.ExcessBlobGas != nil {
+		e.ExcessBlobGas = *dec.ExcessBlobGas
+	}
+	if dec.DepositRequests != nil {
+		e.DepositRequests = dec.DepositRequests
+	}
+	if dec.WithdrawalRequests != nil {
+		e.WithdrawalRequests = dec.WithdrawalRequests
+	}
+	return nil
+}
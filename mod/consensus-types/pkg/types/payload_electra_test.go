@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func generateExecutableDataElectra() *types.ExecutableDataElectra {
+	return &types.ExecutableDataElectra{
+		ParentHash:    common.ExecutionHash{1},
+		FeeRecipient:  common.ExecutionAddress{2},
+		StateRoot:     bytes.B32{3},
+		ReceiptsRoot:  bytes.B32{4},
+		LogsBloom:     make([]byte, 256),
+		Random:        bytes.B32{5},
+		Number:        math.U64(6),
+		GasLimit:      math.U64(7),
+		GasUsed:       math.U64(8),
+		Timestamp:     math.U64(9),
+		ExtraData:     []byte{},
+		BaseFeePerGas: math.Wei{},
+		BlockHash:     common.ExecutionHash{10},
+		Transactions:  [][]byte{},
+		Withdrawals:   []*engineprimitives.Withdrawal{},
+		BlobGasUsed:   math.U64(11),
+		ExcessBlobGas: math.U64(12),
+		DepositRequests: []*engineprimitives.DepositRequest{
+			{
+				Pubkey:      crypto.BLSPubkey{13},
+				Credentials: bytes.B32{0x01},
+				Amount:      math.Gwei(32e9),
+				Signature:   crypto.BLSSignature{14},
+				Index:       math.U64(15),
+			},
+		},
+		WithdrawalRequests: []*engineprimitives.WithdrawalRequest{
+			{
+				SourceAddress:   common.ExecutionAddress{16},
+				ValidatorPubkey: crypto.BLSPubkey{17},
+				Amount:          math.Gwei(0),
+			},
+		},
+	}
+}
+
+func TestExecutableDataElectra_Serialization(t *testing.T) {
+	original := generateExecutableDataElectra()
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.NotNil(t, data)
+
+	var unmarshalled types.ExecutableDataElectra
+	err = unmarshalled.UnmarshalSSZ(data)
+	require.NoError(t, err)
+	require.Equal(t, original, &unmarshalled)
+}
+
+func TestExecutableDataElectra_MarshalJSON(t *testing.T) {
+	payload := generateExecutableDataElectra()
+
+	data, err := payload.MarshalJSON()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"depositRequests":[`)
+	require.Contains(t, string(data), `"withdrawalRequests":[`)
+
+	var unmarshalled types.ExecutableDataElectra
+	err = unmarshalled.UnmarshalJSON(data)
+	require.NoError(t, err)
+	require.Equal(t, payload, &unmarshalled)
+}
+
+func TestExecutableDataElectra_Version(t *testing.T) {
+	payload := generateExecutableDataElectra()
+	require.Equal(t, version.Electra, payload.Version())
+	require.False(t, payload.IsBlinded())
+	require.False(t, payload.IsNil())
+}
+
+func TestExecutableDataDeneb_Requests(t *testing.T) {
+	payload := generateExecutableDataDeneb()
+	require.Nil(t, payload.GetDepositRequests())
+	require.Nil(t, payload.GetWithdrawalRequests())
+}
+
+func TestExecutionPayloadElectra_ToHeader(t *testing.T) {
+	inner := generateExecutableDataElectra()
+	payload := &types.ExecutionPayload{InnerExecutionPayload: inner}
+
+	header, err := payload.ToHeader()
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, header.Version())
+
+	raw := header.InnerExecutionPayloadHeader
+	electraHeader, ok := raw.(*types.ExecutionPayloadHeaderElectra)
+	require.True(t, ok)
+
+	depositRequestsRoot, err := engineprimitives.DepositRequests(
+		inner.DepositRequests,
+	).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, depositRequestsRoot, electraHeader.GetDepositRequestsRoot())
+
+	withdrawalRequestsRoot, err := engineprimitives.WithdrawalRequests(
+		inner.WithdrawalRequests,
+	).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(
+		t, withdrawalRequestsRoot, electraHeader.GetWithdrawalRequestsRoot(),
+	)
+
+	// The header commits to the same root as the full payload.
+	payloadRoot, err := inner.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, payloadRoot, headerRoot)
+}
+
+func TestExecutionPayloadHeaderElectra_Serialization(t *testing.T) {
+	header, err := (&types.ExecutionPayload{
+		InnerExecutionPayload: generateExecutableDataElectra(),
+	}).ToHeader()
+	require.NoError(t, err)
+
+	data, err := header.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded, err := new(types.ExecutionPayloadHeader).NewFromSSZ(
+		data, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, header, decoded)
+}
+
+func TestBeaconBlockElectra_FromSSZ(t *testing.T) {
+	original := &types.BeaconBlockElectra{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            10,
+			ProposerIndex:   5,
+			ParentBlockRoot: bytes.B32{1, 2, 3, 4, 5},
+			StateRoot:       bytes.B32{5, 4, 3, 2, 1},
+		},
+		Body: &types.BeaconBlockBodyElectra{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				ProposerSlashings: []*types.ProposerSlashing{},
+				AttesterSlashings: []*types.AttesterSlashing{},
+				Deposits:          []*types.Deposit{},
+				VoluntaryExits:    []*types.SignedVoluntaryExit{},
+			},
+			ExecutionPayload:      generateExecutableDataElectra(),
+			BLSToExecutionChanges: []*types.SignedBLSToExecutionChange{},
+			BlobKzgCommitments:    []eip4844.KZGCommitment{},
+		},
+	}
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+
+	wrapped, err := new(types.BeaconBlock).NewFromSSZ(data, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, wrapped.Version())
+
+	block, ok := wrapped.RawBeaconBlock.(*types.BeaconBlockElectra)
+	require.True(t, ok)
+	require.Equal(t, original, block)
+
+	// The body exposes the requests of the Electra payload.
+	payload := wrapped.GetBody().GetExecutionPayload()
+	require.Len(t, payload.GetDepositRequests(), 1)
+	require.Len(t, payload.GetWithdrawalRequests(), 1)
+}
+
+func TestBeaconBlockBodyElectra_Empty(t *testing.T) {
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	require.Equal(
+		t, version.Electra, body.GetExecutionPayload().Version(),
+	)
+	require.Equal(t, types.BodyLengthElectra, body.Length())
+
+	// Execution data from another fork is rejected.
+	err := body.SetExecutionData(&types.ExecutionPayload{
+		InnerExecutionPayload: generateExecutableDataDeneb(),
+	})
+	require.Error(t, err)
+}
@@ -51,6 +51,8 @@ func (e *ExecutionPayloadHeader) Empty(
 	switch forkVersion {
 	case version.Deneb:
 		e.InnerExecutionPayloadHeader = &ExecutionPayloadHeaderDeneb{}
+	case version.Electra:
+		e.InnerExecutionPayloadHeader = &ExecutionPayloadHeaderElectra{}
 	default:
 		panic(
 			"unknown fork version, cannot create empty ExecutionPayloadHeader",
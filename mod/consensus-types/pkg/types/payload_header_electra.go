@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ExecutionPayloadHeaderElectra is the execution header payload of Electra.
+// It commits to the requests of the payload through their roots.
+//
+//go:generate go run github.com/fjl/gencodec -type ExecutionPayloadHeaderElectra -out payload_header_electra.json.go -field-override executionPayloadHeaderElectraMarshaling
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path payload_header_electra.go -objs ExecutionPayloadHeaderElectra -include ../../../primitives/pkg/bytes,../../../primitives/mod.go,../../../primitives/pkg/common,../../../primitives/pkg/math,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil,$GOPATH/pkg/mod/github.com/holiman/uint256@v1.2.4 -output payload_header_electra.ssz.go
+//nolint:lll
+type ExecutionPayloadHeaderElectra struct {
+	ParentHash             common.ExecutionHash    `json:"parentHash"       ssz-size:"32"  gencodec:"required"`
+	FeeRecipient           common.ExecutionAddress `json:"feeRecipient"     ssz-size:"20"  gencodec:"required"`
+	StateRoot              primitives.Bytes32      `json:"stateRoot"        ssz-size:"32"  gencodec:"required"`
+	ReceiptsRoot           primitives.Bytes32      `json:"receiptsRoot"     ssz-size:"32"  gencodec:"required"`
+	LogsBloom              []byte                  `json:"logsBloom"        ssz-size:"256" gencodec:"required"`
+	Random                 primitives.Bytes32      `json:"prevRandao"       ssz-size:"32"  gencodec:"required"`
+	Number                 math.U64                `json:"blockNumber"                     gencodec:"required"`
+	GasLimit               math.U64                `json:"gasLimit"                        gencodec:"required"`
+	GasUsed                math.U64                `json:"gasUsed"                         gencodec:"required"`
+	Timestamp              math.U64                `json:"timestamp"                       gencodec:"required"`
+	ExtraData              []byte                  `json:"extraData"                       gencodec:"required" ssz-max:"32"`
+	BaseFeePerGas          math.Wei                `json:"baseFeePerGas"    ssz-size:"32"  gencodec:"required"`
+	BlockHash              common.ExecutionHash    `json:"blockHash"        ssz-size:"32"  gencodec:"required"`
+	TransactionsRoot       primitives.Root         `json:"transactionsRoot" ssz-size:"32"  gencodec:"required"`
+	WithdrawalsRoot        primitives.Root         `json:"withdrawalsRoot"  ssz-size:"32"`
+	BlobGasUsed            math.U64                `json:"blobGasUsed"`
+	ExcessBlobGas          math.U64                `json:"excessBlobGas"`
+	DepositRequestsRoot    primitives.Root         `json:"depositRequestsRoot"    ssz-size:"32"`
+	WithdrawalRequestsRoot primitives.Root         `json:"withdrawalRequestsRoot" ssz-size:"32"`
+}
+
+type executionPayloadHeaderElectraMarshaling struct {
+	ExtraData bytes.Bytes
+	LogsBloom bytes.Bytes
+}
+
+// Version returns the version of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) Version() uint32 {
+	return version.Electra
+}
+
+// IsNil checks if the ExecutionPayloadHeaderElectra is nil.
+func (d *ExecutionPayloadHeaderElectra) IsNil() bool {
+	return d == nil
+}
+
+// IsBlinded checks if the ExecutionPayloadHeaderElectra is blinded.
+func (d *ExecutionPayloadHeaderElectra) IsBlinded() bool {
+	return false
+}
+
+// GetParentHash returns the parent hash of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetParentHash() common.ExecutionHash {
+	return d.ParentHash
+}
+
+// GetFeeRecipient returns the fee recipient address of the
+// ExecutionPayloadHeaderElectra.
+//
+//nolint:lll // long variable names.
+func (d *ExecutionPayloadHeaderElectra) GetFeeRecipient() common.ExecutionAddress {
+	return d.FeeRecipient
+}
+
+// GetStateRoot returns the state root of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetStateRoot() primitives.Bytes32 {
+	return d.StateRoot
+}
+
+// GetReceiptsRoot returns the receipts root of the
+// ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetReceiptsRoot() primitives.Bytes32 {
+	return d.ReceiptsRoot
+}
+
+// GetLogsBloom returns the logs bloom of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetLogsBloom() []byte {
+	return d.LogsBloom
+}
+
+// GetPrevRandao returns the previous Randao value of the
+// ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetPrevRandao() primitives.Bytes32 {
+	return d.Random
+}
+
+// GetNumber returns the block number of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetNumber() math.U64 {
+	return d.Number
+}
+
+// GetGasLimit returns the gas limit of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetGasLimit() math.U64 {
+	return d.GasLimit
+}
+
+// GetGasUsed returns the gas used of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetGasUsed() math.U64 {
+	return d.GasUsed
+}
+
+// GetTimestamp returns the timestamp of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetTimestamp() math.U64 {
+	return d.Timestamp
+}
+
+// GetExtraData returns the extra data of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetExtraData() []byte {
+	return d.ExtraData
+}
+
+// GetBaseFeePerGas returns the base fee per gas of the
+// ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetBaseFeePerGas() math.Wei {
+	return d.BaseFeePerGas
+}
+
+// GetBlockHash returns the block hash of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetBlockHash() common.ExecutionHash {
+	return d.BlockHash
+}
+
+// GetTransactionsRoot returns the transactions root of the
+// ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetTransactionsRoot() primitives.Root {
+	return d.TransactionsRoot
+}
+
+// GetWithdrawalsRoot returns the withdrawals root of the
+// ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetWithdrawalsRoot() primitives.Root {
+	return d.WithdrawalsRoot
+}
+
+// GetBlobGasUsed returns the blob gas used of the
+// ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetBlobGasUsed() math.U64 {
+	return d.BlobGasUsed
+}
+
+// GetExcessBlobGas returns the excess blob gas of the
+// ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) GetExcessBlobGas() math.U64 {
+	return d.ExcessBlobGas
+}
+
+// GetDepositRequestsRoot returns the deposit requests root of the
+// ExecutionPayloadHeaderElectra.
+func (
+	d *ExecutionPayloadHeaderElectra,
+) GetDepositRequestsRoot() primitives.Root {
+	return d.DepositRequestsRoot
+}
+
+// GetWithdrawalRequestsRoot returns the withdrawal requests root of the
+// ExecutionPayloadHeaderElectra.
+func (
+	d *ExecutionPayloadHeaderElectra,
+) GetWithdrawalRequestsRoot() primitives.Root {
+	return d.WithdrawalRequestsRoot
+}
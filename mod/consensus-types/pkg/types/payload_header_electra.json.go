@@ -0,0 +1,161 @@
+// Code generated by github.com/fjl/gencodec. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ = (*executionPayloadHeaderElectraMarshaling)(nil)
+
+// MarshalJSON marshals as JSON.
+func (e ExecutionPayloadHeaderElectra) MarshalJSON() ([]byte, error) {
+	type ExecutionPayloadHeaderElectra struct {
+		ParentHash             common.Hash    `json:"parentHash"       ssz-size:"32"  gencodec:"required"`
+		FeeRecipient           common.Address `json:"feeRecipient"     ssz-size:"20"  gencodec:"required"`
+		StateRoot              bytes.B32      `json:"stateRoot"        ssz-size:"32"  gencodec:"required"`
+		ReceiptsRoot           bytes.B32      `json:"receiptsRoot"     ssz-size:"32"  gencodec:"required"`
+		LogsBloom              bytes.Bytes    `json:"logsBloom"        ssz-size:"256" gencodec:"required"`
+		Random                 bytes.B32      `json:"prevRandao"       ssz-size:"32"  gencodec:"required"`
+		Number                 math.U64       `json:"blockNumber"                     gencodec:"required"`
+		GasLimit               math.U64       `json:"gasLimit"                        gencodec:"required"`
+		GasUsed                math.U64       `json:"gasUsed"                         gencodec:"required"`
+		Timestamp              math.U64       `json:"timestamp"                       gencodec:"required"`
+		ExtraData              bytes.Bytes    `json:"extraData"                       gencodec:"required" ssz-max:"32"`
+		BaseFeePerGas          math.U256L     `json:"baseFeePerGas"    ssz-size:"32"  gencodec:"required"`
+		BlockHash              common.Hash    `json:"blockHash"        ssz-size:"32"  gencodec:"required"`
+		TransactionsRoot       bytes.B32      `json:"transactionsRoot" ssz-size:"32"  gencodec:"required"`
+		WithdrawalsRoot        bytes.B32      `json:"withdrawalsRoot"  ssz-size:"32"`
+		BlobGasUsed            math.U64       `json:"blobGasUsed"`
+		ExcessBlobGas          math.U64       `json:"excessBlobGas"`
+		DepositRequestsRoot    bytes.B32      `json:"depositRequestsRoot"    ssz-size:"32"`
+		WithdrawalRequestsRoot bytes.B32      `json:"withdrawalRequestsRoot" ssz-size:"32"`
+	}
+	var enc ExecutionPayloadHeaderElectra
+	enc.ParentHash = e.ParentHash
+	enc.FeeRecipient = e.FeeRecipient
+	enc.StateRoot = e.StateRoot
+	enc.ReceiptsRoot = e.ReceiptsRoot
+	enc.LogsBloom = e.LogsBloom
+	enc.Random = e.Random
+	enc.Number = e.Number
+	enc.GasLimit = e.GasLimit
+	enc.GasUsed = e.GasUsed
+	enc.Timestamp = e.Timestamp
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash
+	enc.TransactionsRoot = e.TransactionsRoot
+	enc.WithdrawalsRoot = e.WithdrawalsRoot
+	enc.BlobGasUsed = e.BlobGasUsed
+	enc.ExcessBlobGas = e.ExcessBlobGas
+	enc.DepositRequestsRoot = e.DepositRequestsRoot
+	enc.WithdrawalRequestsRoot = e.WithdrawalRequestsRoot
+	return json.Marshal(&enc)
+}
+
+// UnmarshalJSON unmarshals from JSON.
+func (e *ExecutionPayloadHeaderElectra) UnmarshalJSON(input []byte) error {
+	type ExecutionPayloadHeaderElectra struct {
+		ParentHash             *common.Hash    `json:"parentHash"       ssz-size:"32"  gencodec:"required"`
+		FeeRecipient           *common.Address `json:"feeRecipient"     ssz-size:"20"  gencodec:"required"`
+		StateRoot              *bytes.B32      `json:"stateRoot"        ssz-size:"32"  gencodec:"required"`
+		ReceiptsRoot           *bytes.B32      `json:"receiptsRoot"     ssz-size:"32"  gencodec:"required"`
+		LogsBloom              *bytes.Bytes    `json:"logsBloom"        ssz-size:"256" gencodec:"required"`
+		Random                 *bytes.B32      `json:"prevRandao"       ssz-size:"32"  gencodec:"required"`
+		Number                 *math.U64       `json:"blockNumber"                     gencodec:"required"`
+		GasLimit               *math.U64       `json:"gasLimit"                        gencodec:"required"`
+		GasUsed                *math.U64       `json:"gasUsed"                         gencodec:"required"`
+		Timestamp              *math.U64       `json:"timestamp"                       gencodec:"required"`
+		ExtraData              *bytes.Bytes    `json:"extraData"                       gencodec:"required" ssz-max:"32"`
+		BaseFeePerGas          *math.U256L     `json:"baseFeePerGas"    ssz-size:"32"  gencodec:"required"`
+		BlockHash              *common.Hash    `json:"blockHash"        ssz-size:"32"  gencodec:"required"`
+		TransactionsRoot       *bytes.B32      `json:"transactionsRoot" ssz-size:"32"  gencodec:"required"`
+		WithdrawalsRoot        *bytes.B32      `json:"withdrawalsRoot"  ssz-size:"32"`
+		BlobGasUsed            *math.U64       `json:"blobGasUsed"`
+		ExcessBlobGas          *math.U64       `json:"excessBlobGas"`
+		DepositRequestsRoot    *bytes.B32      `json:"depositRequestsRoot"    ssz-size:"32"`
+		WithdrawalRequestsRoot *bytes.B32      `json:"withdrawalRequestsRoot" ssz-size:"32"`
+	}
+	var dec ExecutionPayloadHeaderElectra
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.ParentHash == nil {
+		return errors.New("missing required field 'parentHash' for ExecutionPayloadHeaderElectra")
+	}
+	e.ParentHash = *dec.ParentHash
+	if dec.FeeRecipient == nil {
+		return errors.New("missing required field 'feeRecipient' for ExecutionPayloadHeaderElectra")
+	}
+	e.FeeRecipient = *dec.FeeRecipient
+	if dec.StateRoot == nil {
+		return errors.New("missing required field 'stateRoot' for ExecutionPayloadHeaderElectra")
+	}
+	e.StateRoot = *dec.StateRoot
+	if dec.ReceiptsRoot == nil {
+		return errors.New("missing required field 'receiptsRoot' for ExecutionPayloadHeaderElectra")
+	}
+	e.ReceiptsRoot = *dec.ReceiptsRoot
+	if dec.LogsBloom == nil {
+		return errors.New("missing required field 'logsBloom' for ExecutionPayloadHeaderElectra")
+	}
+	e.LogsBloom = *dec.LogsBloom
+	if dec.Random == nil {
+		return errors.New("missing required field 'prevRandao' for ExecutionPayloadHeaderElectra")
+	}
+	e.Random = *dec.Random
+	if dec.Number == nil {
+		return errors.New("missing required field 'blockNumber' for ExecutionPayloadHeaderElectra")
+	}
+	e.Number = *dec.Number
+	if dec.GasLimit == nil {
+		return errors.New("missing required field 'gasLimit' for ExecutionPayloadHeaderElectra")
+	}
+	e.GasLimit = *dec.GasLimit
+	if dec.GasUsed == nil {
+		return errors.New("missing required field 'gasUsed' for ExecutionPayloadHeaderElectra")
+	}
+	e.GasUsed = *dec.GasUsed
+	if dec.Timestamp == nil {
+		return errors.New("missing required field 'timestamp' for ExecutionPayloadHeaderElectra")
+	}
+	e.Timestamp = *dec.Timestamp
+	if dec.ExtraData == nil {
+		return errors.New("missing required field 'extraData' for ExecutionPayloadHeaderElectra")
+	}
+	e.ExtraData = *dec.ExtraData
+	if dec.BaseFeePerGas == nil {
+		return errors.New("missing required field 'baseFeePerGas' for ExecutionPayloadHeaderElectra")
+	}
+	e.BaseFeePerGas = *dec.BaseFeePerGas
+	if dec.BlockHash == nil {
+		return errors.New("missing required field 'blockHash' for ExecutionPayloadHeaderElectra")
+	}
+	e.BlockHash = *dec.BlockHash
+	if dec.TransactionsRoot == nil {
+		return errors.New("missing required field 'transactionsRoot' for ExecutionPayloadHeaderElectra")
+	}
+	e.TransactionsRoot = *dec.TransactionsRoot
+	if dec.WithdrawalsRoot != nil {
+		e.WithdrawalsRoot = *dec.WithdrawalsRoot
+	}
+	if dec.BlobGasUsed != nil {
+		e.BlobGasUsed = *dec.BlobGasUsed
+	}
+	if dec.ExcessBlobGas != nil {
+		e.ExcessBlobGas = *dec.ExcessBlobGas
+	}
+	if dec.DepositRequestsRoot != nil {
+		e.DepositRequestsRoot = *dec.DepositRequestsRoot
+	}
+	if dec.WithdrawalRequestsRoot != nil {
+		e.WithdrawalRequestsRoot = *dec.WithdrawalRequestsRoot
+	}
+	return nil
+}
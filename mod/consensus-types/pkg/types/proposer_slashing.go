@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// SignedBeaconBlockHeader as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedbeaconblockheader
+//
+//nolint:lll
+type SignedBeaconBlockHeader struct {
+	// Header is the signed beacon block header.
+	Header *BeaconBlockHeader `json:"message"`
+	// Signature is the signature of the proposer over the header.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
+
+// ProposerSlashing as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposerslashing
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./proposer_slashing.go -objs SignedBeaconBlockHeader,ProposerSlashing -include ./header.go,../../../primitives/pkg/common,../../../primitives/pkg/math,../../../primitives/pkg/bytes,../../../primitives/pkg/crypto,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output proposer_slashing.ssz.go
+//nolint:lll
+type ProposerSlashing struct {
+	// SignedHeader1 is the first of the two conflicting headers.
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signedHeader1"`
+	// SignedHeader2 is the second of the two conflicting headers.
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signedHeader2"`
+}
+
+// GetSlot returns the slot of the conflicting headers.
+func (p *ProposerSlashing) GetSlot() math.Slot {
+	return p.SignedHeader1.Header.GetSlot()
+}
+
+// GetProposerIndex returns the index of the proposer that signed the
+// conflicting headers.
+func (p *ProposerSlashing) GetProposerIndex() math.ValidatorIndex {
+	return p.SignedHeader1.Header.GetProposerIndex()
+}
+
+// IsSlashable returns true if the two headers were proposed by the same
+// proposer for the same slot, but are not identical.
+func (p *ProposerSlashing) IsSlashable() bool {
+	header1, header2 := p.SignedHeader1.Header, p.SignedHeader2.Header
+	return header1.GetSlot() == header2.GetSlot() &&
+		header1.GetProposerIndex() == header2.GetProposerIndex() &&
+		*header1 != *header2
+}
+
+// VerifySignatures verifies the signatures of the proposer over both of the
+// conflicting headers.
+func (p *ProposerSlashing) VerifySignatures(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	for _, signedHeader := range []*SignedBeaconBlockHeader{
+		p.SignedHeader1, p.SignedHeader2,
+	} {
+		var signingRoot common.Root
+		signingRoot, err = ssz.ComputeSigningRoot(signedHeader.Header, domain)
+		if err != nil {
+			return err
+		}
+
+		if err = signatureVerificationFn(
+			pubkey, signingRoot[:], signedHeader.Signature,
+		); err != nil {
+			return errors.Join(err, ErrInvalidProposerSlashing)
+		}
+	}
+	return nil
+}
+
+// ProposerSlashings is a typealias for a list of ProposerSlashings.
+type ProposerSlashings []*ProposerSlashing
+
+// HashTreeRoot returns the hash tree root of the ProposerSlashings list.
+func (ps ProposerSlashings) HashTreeRoot() (common.Root, error) {
+	return ssz.MerkleizeListComposite[any, math.U64](
+		ps, constants.MaxProposerSlashingsPerBlock,
+	)
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errMockInvalidSignature = errors.New("invalid signature")
+	testForkData            = types.NewForkData(
+		common.Version{0x04, 0x00, 0x00, 0x00}, common.Root{0x01},
+	)
+	testDomainType = common.DomainType{0x00, 0x00, 0x00, 0x00}
+)
+
+// mockSign "signs" the object by placing its signing root in the signature.
+func mockSign(
+	t *testing.T,
+	obj interface{ HashTreeRoot() ([32]byte, error) },
+	forkData *types.ForkData,
+	domainType common.DomainType,
+) crypto.BLSSignature {
+	t.Helper()
+	domain, err := forkData.ComputeDomain(domainType)
+	require.NoError(t, err)
+	signingRoot, err := ssz.ComputeSigningRoot(obj, domain)
+	require.NoError(t, err)
+
+	var signature crypto.BLSSignature
+	copy(signature[:], signingRoot[:])
+	return signature
+}
+
+// mockVerify verifies signatures produced by mockSign.
+func mockVerify(
+	_ crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+) error {
+	if !bytes.Equal(signature[:len(message)], message) {
+		return errMockInvalidSignature
+	}
+	return nil
+}
+
+// generateSignedHeader generates a signed header for testing purposes.
+func generateSignedHeader(
+	t *testing.T,
+	slot, proposerIndex uint64,
+	bodyRoot common.Root,
+) *types.SignedBeaconBlockHeader {
+	t.Helper()
+	header := &types.BeaconBlockHeader{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+		},
+		BodyRoot: bodyRoot,
+	}
+	return &types.SignedBeaconBlockHeader{
+		Header:    header,
+		Signature: mockSign(t, header, testForkData, testDomainType),
+	}
+}
+
+func TestProposerSlashing_IsSlashable(t *testing.T) {
+	tests := []struct {
+		name      string
+		header1   []uint64
+		header2   []uint64
+		bodyRoot1 common.Root
+		bodyRoot2 common.Root
+		expected  bool
+	}{
+		{
+			name:      "conflicting headers",
+			header1:   []uint64{10, 1},
+			header2:   []uint64{10, 1},
+			bodyRoot1: common.Root{0x01},
+			bodyRoot2: common.Root{0x02},
+			expected:  true,
+		},
+		{
+			name:      "identical headers",
+			header1:   []uint64{10, 1},
+			header2:   []uint64{10, 1},
+			bodyRoot1: common.Root{0x01},
+			bodyRoot2: common.Root{0x01},
+			expected:  false,
+		},
+		{
+			name:      "different slots",
+			header1:   []uint64{10, 1},
+			header2:   []uint64{11, 1},
+			bodyRoot1: common.Root{0x01},
+			bodyRoot2: common.Root{0x02},
+			expected:  false,
+		},
+		{
+			name:      "different proposers",
+			header1:   []uint64{10, 1},
+			header2:   []uint64{10, 2},
+			bodyRoot1: common.Root{0x01},
+			bodyRoot2: common.Root{0x02},
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashing := &types.ProposerSlashing{
+				SignedHeader1: generateSignedHeader(
+					t, tt.header1[0], tt.header1[1], tt.bodyRoot1,
+				),
+				SignedHeader2: generateSignedHeader(
+					t, tt.header2[0], tt.header2[1], tt.bodyRoot2,
+				),
+			}
+			require.Equal(t, tt.expected, slashing.IsSlashable())
+		})
+	}
+}
+
+func TestProposerSlashing_VerifySignatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(*types.ProposerSlashing)
+		wantErr  error
+	}{
+		{
+			name:     "valid signatures",
+			malleate: func(*types.ProposerSlashing) {},
+		},
+		{
+			name: "invalid first signature",
+			malleate: func(ps *types.ProposerSlashing) {
+				ps.SignedHeader1.Signature = crypto.BLSSignature{}
+			},
+			wantErr: types.ErrInvalidProposerSlashing,
+		},
+		{
+			name: "invalid second signature",
+			malleate: func(ps *types.ProposerSlashing) {
+				ps.SignedHeader2.Signature = ps.SignedHeader1.Signature
+			},
+			wantErr: types.ErrInvalidProposerSlashing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashing := &types.ProposerSlashing{
+				SignedHeader1: generateSignedHeader(t, 10, 1, common.Root{1}),
+				SignedHeader2: generateSignedHeader(t, 10, 1, common.Root{2}),
+			}
+			tt.malleate(slashing)
+
+			err := slashing.VerifySignatures(
+				testForkData, testDomainType, crypto.BLSPubkey{}, mockVerify,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestProposerSlashing_MarshalUnmarshalSSZ(t *testing.T) {
+	slashing := &types.ProposerSlashing{
+		SignedHeader1: generateSignedHeader(t, 10, 1, common.Root{1}),
+		SignedHeader2: generateSignedHeader(t, 10, 1, common.Root{2}),
+	}
+
+	data, err := slashing.MarshalSSZ()
+	require.NoError(t, err)
+
+	var unmarshalled types.ProposerSlashing
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, slashing, &unmarshalled)
+
+	require.Equal(t, uint64(1), uint64(slashing.GetProposerIndex()))
+	require.Equal(t, uint64(10), uint64(slashing.GetSlot()))
+}
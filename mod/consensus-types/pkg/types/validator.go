@@ -154,6 +154,11 @@ func (v Validator) IsSlashed() bool {
 	return v.Slashed
 }
 
+// SetSlashed sets the slashed status of the validator.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
 // IsFullyWithdrawable as defined in the Ethereum 2.0 specfication:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#is_fully_withdrawable_validator
 //
@@ -199,12 +204,56 @@ func (v *Validator) SetEffectiveBalance(balance math.Gwei) {
 	v.EffectiveBalance = balance
 }
 
+// GetActivationEligibilityEpoch returns the epoch in which the validator
+// became eligible for activation.
+func (v Validator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.ActivationEligibilityEpoch
+}
+
+// SetActivationEligibilityEpoch sets the epoch in which the validator became
+// eligible for activation.
+func (v *Validator) SetActivationEligibilityEpoch(epoch math.Epoch) {
+	v.ActivationEligibilityEpoch = epoch
+}
+
+// GetActivationEpoch returns the epoch in which the validator activates.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// SetActivationEpoch sets the epoch in which the validator activates.
+func (v *Validator) SetActivationEpoch(epoch math.Epoch) {
+	v.ActivationEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch in which the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch in which the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
 // GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
 }
+
+// SetWithdrawalCredentials sets the withdrawal credentials of the validator.
+func (v *Validator) SetWithdrawalCredentials(
+	credentials WithdrawalCredentials,
+) {
+	v.WithdrawalCredentials = credentials
+}
@@ -555,3 +555,23 @@ func TestValidator_HasMaxEffectiveBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_LifecycleEpochs(t *testing.T) {
+	v := types.NewValidatorFromDeposit(
+		crypto.BLSPubkey{}, types.WithdrawalCredentials{},
+		math.Gwei(32e9), math.Gwei(1e9), math.Gwei(32e9),
+	)
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	require.Equal(t, farFuture, v.GetActivationEligibilityEpoch())
+	require.Equal(t, farFuture, v.GetActivationEpoch())
+	require.Equal(t, farFuture, v.GetExitEpoch())
+
+	v.SetActivationEligibilityEpoch(1)
+	v.SetActivationEpoch(2)
+	v.SetExitEpoch(3)
+	require.Equal(t, math.Epoch(1), v.GetActivationEligibilityEpoch())
+	require.Equal(t, math.Epoch(2), v.GetActivationEpoch())
+	require.Equal(t, math.Epoch(3), v.GetExitEpoch())
+	require.True(t, v.IsActive(2))
+	require.False(t, v.IsActive(3))
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// VoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntaryexit
+//
+//nolint:lll
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch at which the exit can be processed.
+	Epoch math.Epoch `json:"epoch"`
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex `json:"validatorIndex"`
+}
+
+// SignedVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedvoluntaryexit
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./voluntary_exit.go -objs VoluntaryExit,SignedVoluntaryExit -include ../../../primitives/pkg/math,../../../primitives/pkg/bytes,../../../primitives/pkg/crypto,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output voluntary_exit.ssz.go
+//nolint:lll
+type SignedVoluntaryExit struct {
+	// Message is the signed voluntary exit.
+	Message *VoluntaryExit `json:"message"`
+	// Signature is the signature of the validator over the voluntary exit.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
+
+// GetEpoch returns the earliest epoch at which the exit can be processed.
+func (e *SignedVoluntaryExit) GetEpoch() math.Epoch {
+	return e.Message.Epoch
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (e *SignedVoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return e.Message.ValidatorIndex
+}
+
+// VerifySignature verifies the signature of the validator over the voluntary
+// exit.
+func (e *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := ssz.ComputeSigningRoot(e.Message, domain)
+	if err != nil {
+		return err
+	}
+
+	if err = signatureVerificationFn(
+		pubkey, signingRoot[:], e.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidVoluntaryExit)
+	}
+	return nil
+}
+
+// VoluntaryExits is a typealias for a list of SignedVoluntaryExits.
+type VoluntaryExits []*SignedVoluntaryExit
+
+// HashTreeRoot returns the hash tree root of the VoluntaryExits list.
+func (ve VoluntaryExits) HashTreeRoot() (common.Root, error) {
+	return ssz.MerkleizeListComposite[any, math.U64](
+		ve, constants.MaxVoluntaryExitsPerBlock,
+	)
+}
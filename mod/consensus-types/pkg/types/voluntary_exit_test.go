@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// generateSignedVoluntaryExit generates a signed voluntary exit for testing
+// purposes.
+func generateSignedVoluntaryExit(
+	t *testing.T,
+	epoch math.Epoch,
+	validatorIndex math.ValidatorIndex,
+) *types.SignedVoluntaryExit {
+	t.Helper()
+	exit := &types.VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: validatorIndex,
+	}
+	return &types.SignedVoluntaryExit{
+		Message:   exit,
+		Signature: mockSign(t, exit, testForkData, testDomainType),
+	}
+}
+
+func TestSignedVoluntaryExit_VerifySignature(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(*types.SignedVoluntaryExit)
+		wantErr  error
+	}{
+		{
+			name:     "valid signature",
+			malleate: func(*types.SignedVoluntaryExit) {},
+		},
+		{
+			name: "invalid signature",
+			malleate: func(e *types.SignedVoluntaryExit) {
+				e.Signature = crypto.BLSSignature{}
+			},
+			wantErr: types.ErrInvalidVoluntaryExit,
+		},
+		{
+			name: "modified message",
+			malleate: func(e *types.SignedVoluntaryExit) {
+				e.Message.ValidatorIndex++
+			},
+			wantErr: types.ErrInvalidVoluntaryExit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exit := generateSignedVoluntaryExit(t, 5, 1)
+			tt.malleate(exit)
+
+			err := exit.VerifySignature(
+				testForkData, testDomainType, crypto.BLSPubkey{}, mockVerify,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSignedVoluntaryExit_MarshalUnmarshalSSZ(t *testing.T) {
+	exit := generateSignedVoluntaryExit(t, 5, 1)
+
+	data, err := exit.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, exit.SizeSSZ())
+
+	var unmarshalled types.SignedVoluntaryExit
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, exit, &unmarshalled)
+
+	require.Equal(t, math.Epoch(5), exit.GetEpoch())
+	require.Equal(t, math.ValidatorIndex(1), exit.GetValidatorIndex())
+}
@@ -26,12 +26,19 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
-// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
-const EthSecp256k1CredentialPrefix = byte(iota + 1)
+const (
+	// BLSCredentialPrefix is the prefix for a BLS public key.
+	BLSCredentialPrefix = byte(iota)
+	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
+	EthSecp256k1CredentialPrefix
+)
 
 // WithdrawalCredentials is a staking credential that is used to identify a
 // validator.
@@ -48,6 +55,16 @@ func NewCredentialsFromExecutionAddress(
 	return credentials
 }
 
+// NewCredentialsFromBLSPubkey creates a new WithdrawalCredentials committing
+// to the hash of a BLS public key.
+func NewCredentialsFromBLSPubkey(
+	pubkey crypto.BLSPubkey,
+) WithdrawalCredentials {
+	credentials := WithdrawalCredentials(sha256.Sum256(pubkey[:]))
+	credentials[0] = BLSCredentialPrefix
+	return credentials
+}
+
 // ToExecutionAddress converts the WithdrawalCredentials to an ExecutionAddress.
 func (wc WithdrawalCredentials) ToExecutionAddress() (
 	common.ExecutionAddress,
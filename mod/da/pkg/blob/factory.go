@@ -143,7 +143,7 @@ func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildBlockBodyProof(
 
 	tree, err := merkle.NewTreeWithMaxLeaves[
 		[32]byte, [32]byte,
-	](membersRoots, body.Length()-1)
+	](membersRoots, body.Length())
 	if err != nil {
 		return nil, err
 	}
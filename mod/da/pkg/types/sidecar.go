@@ -46,7 +46,7 @@ type BlobSidecar struct {
 	BeaconBlockHeader *types.BeaconBlockHeader
 	// InclusionProof is the inclusion proof of the blob in the beacon block
 	// body.
-	InclusionProof [][32]byte `ssz-size:"8,32"`
+	InclusionProof [][32]byte `ssz-size:"9,32"`
 }
 
 // BuildBlobSidecar creates a blob sidecar from the given blobs and
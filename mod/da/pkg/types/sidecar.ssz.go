@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 87616b362a95b5d611c31c3da910696faa57c6a5177125ae5943f918fdcdbab7
+// Hash: a43a5ac7639535825442ec2351fff340c04add9254792c1d2e9eac311d2e23ef
 // Version: 0.1.3
 package types
 
@@ -38,11 +38,11 @@ func (b *BlobSidecar) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 
 	// Field (5) 'InclusionProof'
-	if size := len(b.InclusionProof); size != 8 {
-		err = ssz.ErrVectorLengthFn("BlobSidecar.InclusionProof", size, 8)
+	if size := len(b.InclusionProof); size != 9 {
+		err = ssz.ErrVectorLengthFn("BlobSidecar.InclusionProof", size, 9)
 		return
 	}
-	for ii := 0; ii < 8; ii++ {
+	for ii := 0; ii < 9; ii++ {
 		dst = append(dst, b.InclusionProof[ii][:]...)
 	}
 
@@ -53,7 +53,7 @@ func (b *BlobSidecar) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 131544 {
+	if size != 131576 {
 		return ssz.ErrSize
 	}
 
@@ -78,9 +78,9 @@ func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (5) 'InclusionProof'
-	b.InclusionProof = make([][32]byte, 8)
-	for ii := 0; ii < 8; ii++ {
-		copy(b.InclusionProof[ii][:], buf[131288:131544][ii*32:(ii+1)*32])
+	b.InclusionProof = make([][32]byte, 9)
+	for ii := 0; ii < 9; ii++ {
+		copy(b.InclusionProof[ii][:], buf[131288:131576][ii*32:(ii+1)*32])
 	}
 
 	return err
@@ -88,7 +88,7 @@ func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the BlobSidecar object
 func (b *BlobSidecar) SizeSSZ() (size int) {
-	size = 131544
+	size = 131576
 	return
 }
 
@@ -123,8 +123,8 @@ func (b *BlobSidecar) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 
 	// Field (5) 'InclusionProof'
 	{
-		if size := len(b.InclusionProof); size != 8 {
-			err = ssz.ErrVectorLengthFn("BlobSidecar.InclusionProof", size, 8)
+		if size := len(b.InclusionProof); size != 9 {
+			err = ssz.ErrVectorLengthFn("BlobSidecar.InclusionProof", size, 9)
 			return
 		}
 		subIndx := hh.Index()
@@ -52,6 +52,7 @@ func TestSidecarMarshalling(t *testing.T) {
 			byteslib.ToBytes32([]byte("6")),
 			byteslib.ToBytes32([]byte("7")),
 			byteslib.ToBytes32([]byte("8")),
+			byteslib.ToBytes32([]byte("9")),
 		},
 	}
 
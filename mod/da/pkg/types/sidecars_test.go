@@ -45,6 +45,7 @@ func TestEmptySidecarMarshalling(t *testing.T) {
 			byteslib.ToBytes32([]byte("6")),
 			byteslib.ToBytes32([]byte("7")),
 			byteslib.ToBytes32([]byte("8")),
+			byteslib.ToBytes32([]byte("9")),
 		},
 	}
 
@@ -98,6 +99,7 @@ func TestValidateBlockRoots(t *testing.T) {
 			byteslib.ToBytes32([]byte("6")),
 			byteslib.ToBytes32([]byte("7")),
 			byteslib.ToBytes32([]byte("8")),
+			byteslib.ToBytes32([]byte("9")),
 		},
 	}
 
@@ -131,6 +133,7 @@ func TestValidateBlockRoots(t *testing.T) {
 			byteslib.ToBytes32([]byte("6")),
 			byteslib.ToBytes32([]byte("7")),
 			byteslib.ToBytes32([]byte("8")),
+			byteslib.ToBytes32([]byte("9")),
 		},
 	}
 	// Validate the sidecar with invalid roots
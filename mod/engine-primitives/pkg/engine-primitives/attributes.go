@@ -132,3 +132,20 @@ func (p *PayloadAttributes[Withdrawal]) Validate() error {
 
 	return nil
 }
+
+// PayloadAttributesEvent describes payload attributes that were sent to the
+// execution client to start building a payload on top of a parent block.
+type PayloadAttributesEvent struct {
+	// ProposalSlot is the slot the payload is built for.
+	ProposalSlot math.Slot
+	// ParentBlockRoot is the root of the beacon block the payload builds on.
+	ParentBlockRoot primitives.Root
+	// ParentBlockHash is the hash of the execution block the payload builds
+	// on.
+	ParentBlockHash common.ExecutionHash
+	// ParentBlockNumber is the number of the execution block the payload
+	// builds on.
+	ParentBlockNumber math.U64
+	// Attributes are the payload attributes that were sent.
+	Attributes *PayloadAttributes[*Withdrawal]
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// DepositRequest is a deposit made to the deposit contract, as included by
+// the execution layer in an execution payload (EIP-6110).
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path deposit_request.go -objs DepositRequest -include ../../../primitives/pkg/crypto,../../../primitives/pkg/bytes,../../../primitives/pkg/math,../../../primitives/pkg/common,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output deposit_request.ssz.go
+//nolint:lll // struct tags.
+type DepositRequest struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"                ssz-size:"48"`
+	// Credentials are the withdrawal credentials of the validator.
+	Credentials bytes.B32 `json:"withdrawalCredentials" ssz-size:"32"`
+	// Amount is the amount of the deposit in Gwei.
+	Amount math.Gwei `json:"amount"`
+	// Signature is the signature of the deposit message.
+	Signature crypto.BLSSignature `json:"signature"             ssz-size:"96"`
+	// Index is the index of the deposit in the deposit contract.
+	Index math.U64 `json:"index"`
+}
+
+// GetPubkey returns the public key of the validator.
+func (d *DepositRequest) GetPubkey() crypto.BLSPubkey {
+	return d.Pubkey
+}
+
+// GetWithdrawalCredentials returns the withdrawal credentials of the
+// validator.
+func (d *DepositRequest) GetWithdrawalCredentials() bytes.B32 {
+	return d.Credentials
+}
+
+// GetAmount returns the amount of the deposit in Gwei.
+func (d *DepositRequest) GetAmount() math.Gwei {
+	return d.Amount
+}
+
+// GetSignature returns the signature of the deposit message.
+func (d *DepositRequest) GetSignature() crypto.BLSSignature {
+	return d.Signature
+}
+
+// GetIndex returns the index of the deposit in the deposit contract.
+func (d *DepositRequest) GetIndex() math.U64 {
+	return d.Index
+}
+
+// DepositRequests represents a slice of deposit requests.
+type DepositRequests []*DepositRequest
+
+// HashTreeRoot returns the hash tree root of the DepositRequests list.
+func (d DepositRequests) HashTreeRoot() (common.Root, error) {
+	return ssz.MerkleizeListComposite[any, math.U64](
+		d, constants.MaxDepositRequestsPerPayload,
+	)
+}
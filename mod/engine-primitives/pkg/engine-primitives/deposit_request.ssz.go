@@ -0,0 +1,101 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 365c333b1af0406379b7e0d8dfefd69e357e7826788bad3195eab3fbb261f5e7
+// Version: 0.1.3
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the DepositRequest object
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositRequest object to a target array
+func (d *DepositRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, d.Pubkey[:]...)
+
+	// Field (1) 'Credentials'
+	dst = append(dst, d.Credentials[:]...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	dst = append(dst, d.Signature[:]...)
+
+	// Field (4) 'Index'
+	dst = ssz.MarshalUint64(dst, uint64(d.Index))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositRequest object
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 192 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	copy(d.Pubkey[:], buf[0:48])
+
+	// Field (1) 'Credentials'
+	copy(d.Credentials[:], buf[48:80])
+
+	// Field (2) 'Amount'
+	d.Amount = math.Gwei(ssz.UnmarshallUint64(buf[80:88]))
+
+	// Field (3) 'Signature'
+	copy(d.Signature[:], buf[88:184])
+
+	// Field (4) 'Index'
+	d.Index = math.U64(ssz.UnmarshallUint64(buf[184:192]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the DepositRequest object
+func (d *DepositRequest) SizeSSZ() (size int) {
+	size = 192
+	return
+}
+
+// HashTreeRoot ssz hashes the DepositRequest object
+func (d *DepositRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher
+func (d *DepositRequest) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(d.Pubkey[:])
+
+	// Field (1) 'Credentials'
+	hh.PutBytes(d.Credentials[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(d.Signature[:])
+
+	// Field (4) 'Index'
+	hh.PutUint64(uint64(d.Index))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the DepositRequest object
+func (d *DepositRequest) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/trie"
 )
@@ -174,6 +175,13 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalT]) HasValidVersionedAnd
 		}
 	}
 
+	// From Electra onwards the block hash also commits to the requests of
+	// the payload, which is left to the execution client to verify as part
+	// of newPayload.
+	if payload.Version() >= version.Electra {
+		return nil
+	}
+
 	// Construct the withdrawals and withdrawals hash.
 	if payload.GetWithdrawals() != nil {
 		gethWithdrawals = make(
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// WithdrawalRequest is a request to withdraw from a validator, triggered from
+// the execution layer by its withdrawal address (EIP-7002).
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path withdrawal_request.go -objs WithdrawalRequest -include ../../../primitives/pkg/crypto,../../../primitives/pkg/bytes,../../../primitives/pkg/math,../../../primitives/pkg/common,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output withdrawal_request.ssz.go
+//nolint:lll // struct tags.
+type WithdrawalRequest struct {
+	// SourceAddress is the execution address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"   ssz-size:"20"`
+	// ValidatorPubkey is the public key of the validator to withdraw from.
+	ValidatorPubkey crypto.BLSPubkey `json:"validatorPubkey" ssz-size:"48"`
+	// Amount is the amount to withdraw in Gwei. An amount of zero requests
+	// the full exit of the validator.
+	Amount math.Gwei `json:"amount"`
+}
+
+// GetSourceAddress returns the execution address that sent the request.
+func (w *WithdrawalRequest) GetSourceAddress() common.ExecutionAddress {
+	return w.SourceAddress
+}
+
+// GetValidatorPubkey returns the public key of the validator to withdraw
+// from.
+func (w *WithdrawalRequest) GetValidatorPubkey() crypto.BLSPubkey {
+	return w.ValidatorPubkey
+}
+
+// GetAmount returns the amount to withdraw in Gwei.
+func (w *WithdrawalRequest) GetAmount() math.Gwei {
+	return w.Amount
+}
+
+// IsFullExit returns true if the request is for the full exit of the
+// validator.
+func (w *WithdrawalRequest) IsFullExit() bool {
+	return w.Amount.Unwrap() == constants.FullExitRequestAmount
+}
+
+// WithdrawalRequests represents a slice of withdrawal requests.
+type WithdrawalRequests []*WithdrawalRequest
+
+// HashTreeRoot returns the hash tree root of the WithdrawalRequests list.
+func (w WithdrawalRequests) HashTreeRoot() (common.Root, error) {
+	return ssz.MerkleizeListComposite[any, math.U64](
+		w, constants.MaxWithdrawalRequestsPerPayload,
+	)
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// engineMethod is a versioned engine API method together with the range of
+// fork versions it is compatible with.
+type engineMethod struct {
+	name    string
+	minFork uint32
+	maxFork uint32
+}
+
+// The versions of each routed engine API method, from highest to lowest.
+//
+//nolint:gochecknoglobals // static routing tables.
+var (
+	newPayloadMethods = []engineMethod{
+		{ethclient.NewPayloadMethodV4, version.Electra, version.Electra},
+		{ethclient.NewPayloadMethodV3, version.Deneb, version.Deneb},
+	}
+	forkchoiceUpdatedMethods = []engineMethod{
+		// Electra does not change the forkchoice state or the payload
+		// attributes, hence the V3 method is used for both forks.
+		{ethclient.ForkchoiceUpdatedMethodV3, version.Deneb, version.Electra},
+	}
+	getPayloadMethods = []engineMethod{
+		{ethclient.GetPayloadMethodV4, version.Electra, version.Electra},
+		{ethclient.GetPayloadMethodV3, version.Deneb, version.Deneb},
+	}
+)
+
+// capabilitySet is the set of engine API methods supported by the execution
+// client, as negotiated via engine_exchangeCapabilities.
+type capabilitySet struct {
+	// mu protects methods.
+	mu sync.RWMutex
+	// methods is nil until the capabilities have been negotiated.
+	methods map[string]struct{}
+}
+
+// newCapabilitySet creates a new capabilitySet that is not negotiated yet.
+func newCapabilitySet() *capabilitySet {
+	return &capabilitySet{}
+}
+
+// IsNegotiated returns true if the capabilities have been negotiated.
+func (c *capabilitySet) IsNegotiated() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.methods != nil
+}
+
+// Has returns true if the execution client supports the given method.
+func (c *capabilitySet) Has(method string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.methods[method]
+	return ok
+}
+
+// Update replaces the negotiated methods and returns the methods that were
+// added and removed since the previous negotiation.
+func (c *capabilitySet) Update(methods []string) ([]string, []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var added, removed []string
+	next := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		next[method] = struct{}{}
+		if _, ok := c.methods[method]; !ok {
+			added = append(added, method)
+		}
+	}
+	for method := range c.methods {
+		if _, ok := next[method]; !ok {
+			removed = append(removed, method)
+		}
+	}
+	c.methods = next
+
+	slices.Sort(added)
+	slices.Sort(removed)
+	return added, removed
+}
+
+// route returns the highest version of the given engine API method that is
+// compatible with the fork version and supported by the execution client.
+// If the capabilities have not been negotiated yet, the highest compatible
+// version is returned. An empty method is returned if no version is
+// compatible with the fork version.
+func (c *capabilitySet) route(
+	methods []engineMethod,
+	forkVersion uint32,
+) (string, error) {
+	var compatible []string
+	for _, m := range methods {
+		if forkVersion < m.minFork || forkVersion > m.maxFork {
+			continue
+		}
+		if !c.IsNegotiated() || c.Has(m.name) {
+			return m.name, nil
+		}
+		compatible = append(compatible, m.name)
+	}
+
+	if len(compatible) == 0 {
+		return "", nil
+	}
+	return "", errors.Wrapf(
+		ErrUnsupportedEngineMethod,
+		"%s for fork version %d",
+		strings.Join(compatible, " or "), forkVersion,
+	)
+}
+
+// verifyRequired returns an error for every routed engine API call that the
+// execution client cannot serve for any of the required fork versions.
+func (c *capabilitySet) verifyRequired(forkVersions []uint32) error {
+	var errs []error
+	for _, forkVersion := range forkVersions {
+		for _, methods := range [][]engineMethod{
+			newPayloadMethods, forkchoiceUpdatedMethods, getPayloadMethods,
+		} {
+			if _, err := c.route(methods, forkVersion); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// observeForkVersion records that an engine API call has been routed for the
+// given fork version.
+func (s *EngineClient[ExecutionPayloadT]) observeForkVersion(
+	forkVersion uint32,
+) {
+	for {
+		current := s.forkVersion.Load()
+		if forkVersion <= current ||
+			s.forkVersion.CompareAndSwap(current, forkVersion) {
+			return
+		}
+	}
+}
+
+// requiredForkVersions returns the versions of the scheduled forks that are
+// not past yet. Until the first engine API call has been routed, every
+// scheduled fork is required.
+func (s *EngineClient[ExecutionPayloadT]) requiredForkVersions() []uint32 {
+	current := s.forkVersion.Load()
+	required := make([]uint32, 0, len(s.forkVersions))
+	for _, forkVersion := range s.forkVersions {
+		if forkVersion >= current {
+			required = append(required, forkVersion)
+		}
+	}
+	return required
+}
+
+// verifyCapabilities verifies that the negotiated endpoints can serve the
+// engine API calls of every scheduled fork that is not past. Endpoints that
+// cannot are penalized, so that calls fail over to the ones that can. An
+// error is returned only if no endpoint can serve the calls.
+func (s *EngineClient[ExecutionPayloadT]) verifyCapabilities() error {
+	var (
+		required = s.requiredForkVersions()
+		verified bool
+		errs     []error
+	)
+	for _, e := range s.endpoints {
+		if !e.capabilities.IsNegotiated() {
+			continue
+		}
+		err := e.capabilities.verifyRequired(required)
+		if err == nil {
+			verified = true
+			continue
+		}
+		s.logger.Warn(
+			"execution client endpoint is missing required methods 🚸",
+			"dial_url", e.String(),
+			"err", err,
+		)
+		s.recordResult(e, err)
+		errs = append(errs, errors.Wrap(err, e.String()))
+	}
+
+	if verified {
+		return nil
+	}
+	return errors.Join(errs...)
+}
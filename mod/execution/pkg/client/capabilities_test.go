@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// denebCapabilities are the capabilities of an execution client that has
+// not been upgraded for Electra.
+//
+//nolint:gochecknoglobals // this is a test.
+var denebCapabilities = []string{
+	ethclient.NewPayloadMethodV3,
+	ethclient.ForkchoiceUpdatedMethodV3,
+	ethclient.GetPayloadMethodV3,
+}
+
+func TestCapabilitySet_Route(t *testing.T) {
+	c := newCapabilitySet()
+
+	// Before negotiating, the highest compatible version is used.
+	method, err := c.route(newPayloadMethods, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, ethclient.NewPayloadMethodV4, method)
+
+	c.Update(denebCapabilities)
+	method, err = c.route(newPayloadMethods, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, ethclient.NewPayloadMethodV3, method)
+
+	// The Deneb method is not compatible with Electra payloads.
+	_, err = c.route(newPayloadMethods, version.Electra)
+	require.ErrorIs(t, err, ErrUnsupportedEngineMethod)
+	require.ErrorContains(t, err, ethclient.NewPayloadMethodV4)
+
+	// Forkchoice updates are served by the same version in both forks.
+	method, err = c.route(forkchoiceUpdatedMethods, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, ethclient.ForkchoiceUpdatedMethodV3, method)
+
+	// No method is compatible with an unknown fork version.
+	method, err = c.route(getPayloadMethods, version.Electra+1)
+	require.NoError(t, err)
+	require.Empty(t, method)
+}
+
+func TestCapabilitySet_Update(t *testing.T) {
+	c := newCapabilitySet()
+	require.False(t, c.IsNegotiated())
+
+	added, removed := c.Update(denebCapabilities)
+	require.True(t, c.IsNegotiated())
+	require.ElementsMatch(t, denebCapabilities, added)
+	require.Empty(t, removed)
+
+	added, removed = c.Update([]string{
+		ethclient.NewPayloadMethodV3,
+		ethclient.NewPayloadMethodV4,
+		ethclient.ForkchoiceUpdatedMethodV3,
+	})
+	require.Equal(t, []string{ethclient.NewPayloadMethodV4}, added)
+	require.Equal(t, []string{ethclient.GetPayloadMethodV3}, removed)
+	require.False(t, c.Has(ethclient.GetPayloadMethodV3))
+
+	// A client without getPayloadV3 cannot serve Deneb.
+	err := c.verifyRequired([]uint32{version.Deneb})
+	require.ErrorIs(t, err, ErrUnsupportedEngineMethod)
+	require.ErrorContains(t, err, ethclient.GetPayloadMethodV3)
+
+	c.Update(denebCapabilities)
+	require.NoError(t, c.verifyRequired([]uint32{version.Deneb}))
+
+	// Every required fork is verified.
+	err = c.verifyRequired([]uint32{version.Deneb, version.Electra})
+	require.ErrorIs(t, err, ErrUnsupportedEngineMethod)
+	require.ErrorContains(t, err, ethclient.NewPayloadMethodV4)
+	require.ErrorContains(t, err, ethclient.GetPayloadMethodV4)
+}
+
+func TestEngineClient_VerifyCapabilities(t *testing.T) {
+	// An execution client that dropped the Deneb methods.
+	electra := &mockEngine{capabilities: []string{
+		ethclient.NewPayloadMethodV4,
+		ethclient.ForkchoiceUpdatedMethodV3,
+		ethclient.GetPayloadMethodV4,
+	}}
+	c := newTestEngineClient(t, electra)
+	c.forkVersions = []uint32{version.Deneb, version.Electra}
+
+	_, err := c.ExchangeCapabilities(context.Background())
+	require.NoError(t, err)
+	err = c.verifyCapabilities()
+	require.ErrorIs(t, err, ErrUnsupportedEngineMethod)
+	require.ErrorContains(t, err, ethclient.NewPayloadMethodV3)
+
+	// Once an Electra payload has been processed, Deneb is past.
+	parentRoot := primitives.Root{0x06}
+	_, err = c.NewPayload(
+		context.Background(), newTestElectraPayload(), nil, &parentRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{version.Electra}, c.requiredForkVersions())
+	require.NoError(t, c.verifyCapabilities())
+}
+
+func TestEngineClient_VerifyCapabilitiesPerEndpoint(t *testing.T) {
+	primary := &mockEngine{capabilities: denebCapabilities}
+	c, _ := newTestMultiEngineClient(t, testSink{}, primary, &mockEngine{})
+	c.forkVersions = []uint32{version.Deneb, version.Electra}
+
+	_, err := c.ExchangeCapabilities(context.Background())
+	require.NoError(t, err)
+
+	// The fallback can serve every fork, the primary is penalized.
+	require.NoError(t, c.verifyCapabilities())
+	require.Less(t, c.endpoints[0].Score(), maxHealthScore)
+	require.Equal(t, maxHealthScore, c.endpoints[1].Score())
+
+	// No endpoint can serve Electra.
+	c.endpoints[1].capabilities.Update(denebCapabilities)
+	err = c.verifyCapabilities()
+	require.ErrorIs(t, err, ErrUnsupportedEngineMethod)
+	require.ErrorContains(t, err, c.endpoints[0].String())
+	require.ErrorContains(t, err, c.endpoints[1].String())
+}
+
+func TestEngineClient_UnsupportedMethod(t *testing.T) {
+	engine := &mockEngine{capabilities: denebCapabilities}
+	c := newTestEngineClient(t, engine)
+
+	_, err := c.ExchangeCapabilities(context.Background())
+	require.NoError(t, err)
+
+	// An Electra payload fails without calling the execution client.
+	parentRoot := primitives.Root{0x06}
+	_, err = c.NewPayload(
+		context.Background(), newTestElectraPayload(), nil, &parentRoot,
+	)
+	require.ErrorIs(t, err, ErrUnsupportedEngineMethod)
+	require.Equal(t, []string{ethclient.ExchangeCapabilities}, engine.calls)
+
+	// A Deneb payload is routed to the supported version.
+	_, err = c.NewPayload(
+		context.Background(),
+		&testPayload{version: version.Deneb},
+		nil,
+		&parentRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		ethclient.ExchangeCapabilities, ethclient.NewPayloadMethodV3,
+	}, engine.calls)
+}
@@ -27,17 +27,26 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/cache"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru/v2/expirable"
 )
 
-// EngineClient is a struct that holds a pointer to an Eth1Client.
+// payloadEndpointsSize is the number of payload IDs whose endpoints are
+// remembered.
+const payloadEndpointsSize = 64
+
+// EngineClient is a struct that multiplexes the Eth1Clients of the execution
+// client endpoints.
 type EngineClient[
 	ExecutionPayloadT interface {
 		Empty(uint32) ExecutionPayloadT
@@ -46,9 +55,6 @@ type EngineClient[
 		json.Unmarshaler
 	},
 ] struct {
-	// Eth1Client is a struct that holds the Ethereum 1 client and
-	// its configuration.
-	*ethclient.Eth1Client[ExecutionPayloadT]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
@@ -59,8 +65,12 @@ type EngineClient[
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
+	// forkVersions are the versions of the scheduled forks, in activation
+	// order.
+	forkVersions []uint32
+	// forkVersion is the highest fork version an engine API call has been
+	// routed for. The scheduled forks before it are past.
+	forkVersion atomic.Uint32
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
@@ -70,6 +80,19 @@ type EngineClient[
 	statusErrMu *sync.RWMutex
 	// statusErr is the status error of the engine client.
 	statusErr error
+	// endpoints are the execution client endpoints, starting with the
+	// configured primary.
+	endpoints []*endpoint[ExecutionPayloadT]
+	// endpointsMu protects primary.
+	endpointsMu sync.RWMutex
+	// primary is the endpoint that serves calls that are not fanned out to
+	// all endpoints.
+	primary *endpoint[ExecutionPayloadT]
+	// payloadEndpoints maps the IDs of the payloads being built to the
+	// endpoints that returned them.
+	payloadEndpoints *lru.LRU[
+		engineprimitives.PayloadID, *endpoint[ExecutionPayloadT],
+	]
 }
 
 // New creates a new engine client EngineClient.
@@ -86,19 +109,30 @@ func New[ExecutionPayloadT interface {
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
+	forkVersions []uint32,
 ) *EngineClient[ExecutionPayloadT] {
 	statusErrMu := new(sync.RWMutex)
+	endpoints := make(
+		[]*endpoint[ExecutionPayloadT], 0, len(cfg.RPCFallbackDialURLs)+1,
+	)
+	for _, u := range cfg.DialURLs() {
+		endpoints = append(endpoints, newEndpoint[ExecutionPayloadT](u))
+	}
 	return &EngineClient[ExecutionPayloadT]{
 		cfg:           cfg,
 		logger:        logger,
 		jwtSecret:     jwtSecret,
-		Eth1Client:    new(ethclient.Eth1Client[ExecutionPayloadT]),
-		capabilities:  make(map[string]struct{}),
+		forkVersions:  forkVersions,
 		statusErrMu:   statusErrMu,
 		statusErrCond: sync.NewCond(statusErrMu),
 		engineCache:   cache.NewEngineCacheWithDefaultConfig(),
 		eth1ChainID:   eth1ChainID,
 		metrics:       newClientMetrics(telemetrySink, logger),
+		endpoints:     endpoints,
+		primary:       endpoints[0],
+		payloadEndpoints: lru.NewLRU[
+			engineprimitives.PayloadID, *endpoint[ExecutionPayloadT],
+		](payloadEndpointsSize, nil, 0),
 	}
 }
 
@@ -106,7 +140,12 @@ func New[ExecutionPayloadT interface {
 func (s *EngineClient[ExecutionPayloadT]) Start(
 	ctx context.Context,
 ) error {
-	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
+	// Keep health checking the endpoints even if the startup fails.
+	defer func() {
+		go s.healthCheckLoop(ctx)
+	}()
+
+	if s.hasHTTPEndpoint() {
 		// If we are dialing with HTTP(S), start the JWT refresh loop.
 		defer func() {
 			if s.jwtSecret == nil {
@@ -125,8 +164,8 @@ func (s *EngineClient[ExecutionPayloadT]) Start(
 // Status verifies the chain ID via JSON-RPC. By proxy
 // we will also verify the connection to the execution client.
 func (s *EngineClient[ExecutionPayloadT]) Status() error {
-	s.statusErrMu.RLock()
-	defer s.statusErrMu.RUnlock()
+	s.statusErrMu.Lock()
+	defer s.statusErrMu.Unlock()
 	return s.status(context.Background())
 }
 
@@ -154,7 +193,21 @@ func (s *EngineClient[ExecutionPayloadT]) WaitForHealthy(
 func (s *EngineClient[ExecutionPayloadT]) VerifyChainID(
 	ctx context.Context,
 ) error {
-	chainID, err := s.Client.ChainID(ctx)
+	client, err := s.primaryClient()
+	if err != nil {
+		return err
+	}
+	return s.verifyChainID(ctx, client)
+}
+
+// ============================== HELPERS ==============================
+
+// verifyChainID checks the chain ID of the given execution client.
+func (s *EngineClient[ExecutionPayloadT]) verifyChainID(
+	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) error {
+	chainID, err := client.ChainID(ctx)
 	if err != nil {
 		return err
 	}
@@ -170,12 +223,21 @@ func (s *EngineClient[ExecutionPayloadT]) VerifyChainID(
 	return nil
 }
 
-// ============================== HELPERS ==============================
+// hasHTTPEndpoint returns true if any of the endpoints is dialed over
+// HTTP(S).
+func (s *EngineClient[ExecutionPayloadT]) hasHTTPEndpoint() bool {
+	for _, e := range s.endpoints {
+		if e.url.IsHTTP() || e.url.IsHTTPS() {
+			return true
+		}
+	}
+	return false
+}
 
 func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	ctx context.Context,
 ) error {
-	// Initialize the connection to the execution client.
+	// Initialize the connection to the execution clients.
 	var (
 		err     error
 		chainID *big.Int
@@ -183,14 +245,31 @@ func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	for {
 		s.logger.Info(
 			"waiting for execution client to start 🍺🕔",
-			"dial_url", s.cfg.RPCDialURL,
+			"dial_urls", s.cfg.DialURLs(),
 		)
-		if err = s.setupExecutionClientConnection(ctx); err != nil {
+		for _, e := range s.endpoints {
+			if c := e.Client(); c != nil && c.Client != nil {
+				continue
+			}
+			if err = s.setupEndpoint(ctx, e); err != nil {
+				s.logger.Error(
+					"failed to setup execution client",
+					"dial_url", e.String(),
+					"err", err,
+				)
+			}
+			s.recordResult(e, err)
+		}
+
+		if err = s.selectPrimary(); err != nil {
 			s.statusErrMu.Lock()
 			s.statusErr = err
 			s.statusErrMu.Unlock()
-			time.Sleep(s.cfg.RPCStartupCheckInterval)
-			s.logger.Error("failed to setup execution client", "err", err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.cfg.RPCStartupCheckInterval):
+			}
 			continue
 		}
 		break
@@ -206,7 +285,7 @@ func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	s.logger.Info(
 		"connected to execution client 🔌",
 		"dial_url",
-		s.cfg.RPCDialURL.String(),
+		s.primary.String(),
 		"chain_id",
 		chainID.Uint64(),
 		"required_chain_id",
@@ -218,37 +297,53 @@ func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
+
+	// Fail fast if no endpoint can serve the engine API calls required by
+	// the scheduled forks.
+	if err = s.verifyCapabilities(); err != nil {
+		s.logger.Error(
+			"execution client is missing required engine API methods",
+			"err", err,
+		)
+		return err
+	}
 	return nil
 }
 
-// setupExecutionClientConnections dials the execution client and
-// ensures the chain ID is correct.
-func (s *EngineClient[ExecutionPayloadT]) setupExecutionClientConnection(
+// setupEndpoint dials the execution client endpoint and ensures the chain
+// ID is correct.
+func (s *EngineClient[ExecutionPayloadT]) setupEndpoint(
 	ctx context.Context,
+	e *endpoint[ExecutionPayloadT],
 ) error {
 	// Dial the execution client.
-	if err := s.dialExecutionRPCClient(ctx); err != nil {
+	client, err := s.dialExecutionRPCClient(ctx, e.url)
+	if err != nil {
 		return err
 	}
 
 	// Ensure the execution client is connected to the correct chain.
-	if err := s.VerifyChainID(ctx); err != nil {
-		s.Client.Close()
+	if err = s.verifyChainID(ctx, client); err != nil {
+		client.Close()
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
 			s.logger.Error(UnauthenticatedConnectionErrorStr)
 		}
 		return err
 	}
+
+	e.setClient(client)
 	return nil
 }
 
 // ================================ Dialing ================================
 
-// dialExecutionRPCClient dials the execution client's RPC endpoint.
+// dialExecutionRPCClient dials the execution client's RPC endpoint at the
+// given URL.
 func (s *EngineClient[ExecutionPayloadT]) dialExecutionRPCClient(
 	ctx context.Context,
-) error {
+	dialURL *url.ConnectionURL,
+) (*ethclient.Eth1Client[ExecutionPayloadT], error) {
 	var (
 		client *ethrpc.Client
 		err    error
@@ -256,42 +351,38 @@ func (s *EngineClient[ExecutionPayloadT]) dialExecutionRPCClient(
 
 	// Dial the execution client based on the URL scheme.
 	switch {
-	case s.cfg.RPCDialURL.IsHTTP(), s.cfg.RPCDialURL.IsHTTPS():
+	case dialURL.IsHTTP(), dialURL.IsHTTPS():
 		// Build an http.Header with the JWT token attached.
 		if s.jwtSecret != nil {
 			var header http.Header
 			if header, err = s.buildJWTHeader(); err != nil {
-				return err
+				return nil, err
 			}
 			if client, err = ethrpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), ethrpc.WithHeaders(header),
+				ctx, dialURL.String(), ethrpc.WithHeaders(header),
 			); err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			if client, err = ethrpc.DialContext(
-				ctx, s.cfg.RPCDialURL.String()); err != nil {
-				return err
+				ctx, dialURL.String()); err != nil {
+				return nil, err
 			}
 		}
-	case s.cfg.RPCDialURL.IsIPC():
+	case dialURL.IsIPC():
 		if client, err = ethrpc.DialIPC(
-			ctx, s.cfg.RPCDialURL.Path); err != nil {
+			ctx, dialURL.Path); err != nil {
 			s.logger.Error("failed to dial IPC", "err", err)
-			return err
+			return nil, err
 		}
 	default:
-		return errors.Newf(
+		return nil, errors.Newf(
 			"no known transport for URL scheme %q",
-			s.cfg.RPCDialURL.Scheme,
+			dialURL.Scheme,
 		)
 	}
 
-	// Refresh the execution client with the new client.
-	s.Eth1Client, err = ethclient.NewFromRPCClient[ExecutionPayloadT](
-		client,
-	)
-	return err
+	return ethclient.NewFromRPCClient[ExecutionPayloadT](client)
 }
 
 // ================================ JWT ================================
@@ -308,19 +399,30 @@ func (s *EngineClient[ExecutionPayloadT]) jwtRefreshLoop(
 			ticker.Stop()
 			return
 		case <-ticker.C:
-			s.statusErrMu.Lock()
-			if err := s.dialExecutionRPCClient(ctx); err != nil {
-				s.logger.Error("failed to refresh JWT token", "err", err)
-				//#nosec:G703 wtf is even this problem here.
-				s.statusErr = errors.Newf(
-					"%w: failed to refresh JWT token",
-					err,
-				)
-			} else {
-				s.statusErr = nil
-			}
-			s.statusErrMu.Unlock()
+			s.refreshJWT(ctx)
+		}
+	}
+}
+
+// refreshJWT redials the connected HTTP(S) endpoints with a fresh JWT token.
+func (s *EngineClient[ExecutionPayloadT]) refreshJWT(ctx context.Context) {
+	for _, e := range s.endpoints {
+		if e.Client() == nil || !(e.url.IsHTTP() || e.url.IsHTTPS()) {
+			continue
 		}
+		client, err := s.dialExecutionRPCClient(ctx, e.url)
+		if err != nil {
+			s.logger.Error(
+				"failed to refresh JWT token",
+				"dial_url", e.String(),
+				"err", err,
+			)
+			s.recordResult(e, errors.Newf(
+				"%w: failed to refresh JWT token", err,
+			))
+			continue
+		}
+		e.setClient(client)
 	}
 }
 
@@ -355,15 +457,26 @@ func (s *EngineClient[ExecutionPayloadT]) status(
 	ctx context.Context,
 ) error {
 	// If the client is not started, we return an error.
-	if s.Eth1Client.Client == nil {
-		return ErrNotStarted
+	if _, err := s.primaryClient(); err != nil {
+		return err
 	}
 
+	// Health check every endpoint, failing over the primary if needed.
+	//#nosec:G703 wtf is even this problem here.
+	s.statusErr = s.checkEndpoints(ctx)
+
+	// Renegotiate the capabilities, since the execution client may have
+	// been upgraded or replaced by a failover.
 	if s.statusErr == nil {
-		// If we have an error, we will attempt
-		// to verify the chain ID again.
-		//#nosec:G703 wtf is even this problem here.
-		s.statusErr = s.VerifyChainID(ctx)
+		if _, err := s.exchangeCapabilities(ctx); err != nil {
+			s.logger.Error("failed to exchange capabilities", "err", err)
+		}
+		if err := s.verifyCapabilities(); err != nil {
+			s.logger.Error(
+				"execution client is missing required engine API methods",
+				"err", err,
+			)
+		}
 	}
 
 	if s.statusErr == nil {
@@ -32,6 +32,7 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 30 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -42,10 +43,12 @@ func DefaultConfig() Config {
 	dialURL, _ := url.NewFromRaw(defaultDialURL)
 	return Config{
 		RPCDialURL:              dialURL,
+		RPCFallbackDialURLs:     []*url.ConnectionURL{},
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
 	}
 }
@@ -56,6 +59,10 @@ func DefaultConfig() Config {
 type Config struct {
 	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
+	// RPCFallbackDialURLs are the urls of additional execution client
+	// JSON-RPC endpoints that are kept in sync and failed over to when the
+	// primary endpoint is unhealthy.
+	RPCFallbackDialURLs []*url.ConnectionURL `mapstructure:"rpc-fallback-dial-urls"`
 	// RPCRetries is the number of retries before shutting down consensus
 	// client.
 	RPCRetries uint64 `mapstructure:"rpc-retries"`
@@ -65,6 +72,14 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the Interval for the endpoint health check.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
 }
+
+// DialURLs returns the urls of all execution client endpoints, starting with
+// the primary endpoint.
+func (c Config) DialURLs() []*url.ConnectionURL {
+	return append([]*url.ConnectionURL{c.RPCDialURL}, c.RPCFallbackDialURLs...)
+}
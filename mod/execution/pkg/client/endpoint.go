@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+)
+
+const (
+	// maxHealthScore is the health score of an endpoint that has not
+	// failed recently.
+	maxHealthScore = 10
+	// failurePenalty is the amount the health score of an endpoint is
+	// reduced by on every failed call or health check.
+	failurePenalty = 5
+)
+
+// endpoint is a single execution client JSON-RPC endpoint together with
+// its health score. An endpoint is healthy while it is connected and its
+// score is above zero. Successful calls raise the score by one, failed
+// calls lower it by failurePenalty.
+type endpoint[
+	ExecutionPayloadT interface {
+		json.Marshaler
+		json.Unmarshaler
+		Empty(uint32) ExecutionPayloadT
+	},
+] struct {
+	// url is the dial URL of the endpoint.
+	url *url.ConnectionURL
+	// capabilities is the set of engine API methods negotiated with the
+	// endpoint.
+	capabilities *capabilitySet
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// client is the connected client, nil until the endpoint is dialed.
+	client *ethclient.Eth1Client[ExecutionPayloadT]
+	// score is the health score of the endpoint.
+	score int
+	// err is the last error observed on the endpoint.
+	err error
+}
+
+// newEndpoint creates a new endpoint for the given dial URL.
+func newEndpoint[
+	ExecutionPayloadT interface {
+		json.Marshaler
+		json.Unmarshaler
+		Empty(uint32) ExecutionPayloadT
+	},
+](u *url.ConnectionURL) *endpoint[ExecutionPayloadT] {
+	return &endpoint[ExecutionPayloadT]{
+		url:          u,
+		capabilities: newCapabilitySet(),
+		score:        maxHealthScore,
+	}
+}
+
+// String returns the dial URL of the endpoint with any password redacted.
+func (e *endpoint[_]) String() string {
+	return e.url.Redacted()
+}
+
+// Client returns the connected client of the endpoint.
+//
+//nolint:lll
+func (e *endpoint[ExecutionPayloadT]) Client() *ethclient.Eth1Client[ExecutionPayloadT] {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.client
+}
+
+// setClient replaces the connected client of the endpoint.
+func (e *endpoint[ExecutionPayloadT]) setClient(
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.client = client
+}
+
+// Score returns the health score of the endpoint.
+func (e *endpoint[_]) Score() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.score
+}
+
+// Err returns the last error observed on the endpoint.
+func (e *endpoint[_]) Err() error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.err
+}
+
+// IsHealthy returns true if the endpoint is connected and its health score
+// is above zero.
+func (e *endpoint[_]) IsHealthy() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.client != nil && e.client.Client != nil && e.score > 0
+}
+
+// recordSuccess raises the health score of the endpoint and returns it.
+func (e *endpoint[_]) recordSuccess() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.score = min(e.score+1, maxHealthScore)
+	e.err = nil
+	return e.score
+}
+
+// recordFailure lowers the health score of the endpoint and returns it.
+func (e *endpoint[_]) recordFailure(err error) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.score = max(e.score-failurePenalty, 0)
+	e.err = err
+	return e.score
+}
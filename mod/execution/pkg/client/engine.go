@@ -47,12 +47,32 @@ func (s *EngineClient[ExecutionPayloadT]) NewPayload(
 	)
 	defer cancel()
 
-	// Call the appropriate RPC method based on the payload version.
-	result, err := s.callNewPayloadRPC(
-		dctx,
-		payload,
-		versionedHashes,
-		parentBeaconBlockRoot,
+	// Call the RPC method on every endpoint, routed to the highest version
+	// of the method supported by the endpoint.
+	s.observeForkVersion(payload.Version())
+	result, _, err := callEndpoints(
+		dctx, s, "new_payload",
+		func(
+			ctx context.Context,
+			client *ethclient.Eth1Client[ExecutionPayloadT],
+			capabilities *capabilitySet,
+		) (*engineprimitives.PayloadStatusV1, error) {
+			method, err := capabilities.route(
+				newPayloadMethods, payload.Version(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			return s.callNewPayloadRPC(
+				ctx,
+				client,
+				method,
+				payload,
+				versionedHashes,
+				parentBeaconBlockRoot,
+			)
+		},
+		samePayloadStatus,
 	)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -79,20 +99,27 @@ func (s *EngineClient[ExecutionPayloadT]) NewPayload(
 // callNewPayloadRPC calls the engine_newPayloadVX method via JSON-RPC.
 func (s *EngineClient[ExecutionPayloadT]) callNewPayloadRPC(
 	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+	method string,
 	payload ExecutionPayload,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *primitives.Root,
 ) (*engineprimitives.PayloadStatusV1, error) {
-	switch payload.Version() {
-	case version.Deneb:
-		return s.NewPayloadV3(
+	switch method {
+	case ethclient.NewPayloadMethodV3:
+		return client.NewPayloadV3(
+			ctx,
+			payload,
+			versionedHashes,
+			parentBeaconBlockRoot,
+		)
+	case ethclient.NewPayloadMethodV4:
+		return client.NewPayloadV4(
 			ctx,
 			payload,
 			versionedHashes,
 			parentBeaconBlockRoot,
 		)
-	case version.Electra:
-		return nil, errors.New("TODO: implement Electra payload")
 	default:
 		return nil, engineerrors.ErrInvalidPayloadType
 	}
@@ -122,7 +149,29 @@ func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 		)
 	}
 
-	result, err := s.callUpdatedForkchoiceRPC(dctx, state, attrs, forkVersion)
+	// Call the RPC method on every endpoint, routed to the highest version
+	// of the method supported by the endpoint.
+	s.observeForkVersion(forkVersion)
+	result, e, err := callEndpoints(
+		dctx, s, "forkchoice_updated",
+		func(
+			ctx context.Context,
+			client *ethclient.Eth1Client[ExecutionPayloadT],
+			capabilities *capabilitySet,
+		) (*engineprimitives.ForkchoiceResponseV1, error) {
+			method, err := capabilities.route(
+				forkchoiceUpdatedMethods, forkVersion,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return s.callUpdatedForkchoiceRPC(ctx, client, method, state, attrs)
+		},
+		func(a, b *engineprimitives.ForkchoiceResponseV1) bool {
+			return a == nil || b == nil ||
+				samePayloadStatus(&a.PayloadStatus, &b.PayloadStatus)
+		},
+	)
 
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -137,6 +186,12 @@ func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 	if err != nil {
 		return nil, latestValidHash, err
 	}
+
+	// The payload is built by every endpoint under its own ID, so the
+	// returned ID has to be retrieved from the endpoint that returned it.
+	if result.PayloadID != nil {
+		s.pinPayload(*result.PayloadID, e)
+	}
 	return result.PayloadID, latestValidHash, nil
 }
 
@@ -144,15 +199,14 @@ func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 // JSON-RPC.
 func (s *EngineClient[ExecutionPayloadT]) callUpdatedForkchoiceRPC(
 	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+	method string,
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs engineprimitives.PayloadAttributer,
-	forkVersion uint32,
 ) (*engineprimitives.ForkchoiceResponseV1, error) {
-	switch forkVersion {
-	case version.Deneb:
-		return s.ForkchoiceUpdatedV3(ctx, state, attrs)
-	case version.Electra:
-		return nil, errors.New("TODO: implement Electra hardfork")
+	switch method {
+	case ethclient.ForkchoiceUpdatedMethodV3:
+		return client.ForkchoiceUpdatedV3(ctx, state, attrs)
 	default:
 		return nil, engineerrors.ErrInvalidPayloadAttributes
 	}
@@ -172,21 +226,37 @@ func (s *EngineClient[ExecutionPayloadT]) GetPayload(
 	)
 	defer cancel()
 
-	// Determine what version we want to call.
+	// The payload can only be retrieved from the endpoint that returned its
+	// ID, even if the primary has failed over since.
+	e := s.payloadEndpoint(payloadID)
+	client := e.Client()
+	if client == nil || client.Client == nil {
+		return nil, ErrNotStarted
+	}
+
+	// Route the call to the highest version of the method supported by the
+	// endpoint.
+	s.observeForkVersion(forkVersion)
+	method, err := e.capabilities.route(getPayloadMethods, forkVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	var fn func(
 		context.Context, engineprimitives.PayloadID,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
-	switch forkVersion {
-	case version.Deneb:
-		fn = s.GetPayloadV3
-	case version.Electra:
-		return nil, errors.New("TODO: implement Electra getPayload")
+	switch method {
+	case ethclient.GetPayloadMethodV3:
+		fn = client.GetPayloadV3
+	case ethclient.GetPayloadMethodV4:
+		fn = client.GetPayloadV4
 	default:
 		return nil, engineerrors.ErrInvalidGetPayloadVersion
 	}
 
 	// Call and check for errors.
 	result, err := fn(dctx, payloadID)
+	s.recordResult(e, err)
 	switch {
 	case err != nil:
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -207,33 +277,98 @@ func (s *EngineClient[ExecutionPayloadT]) GetPayload(
 func (s *EngineClient[ExecutionPayloadT]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Eth1Client.ExchangeCapabilities(
+	result, err := s.exchangeCapabilities(ctx)
+	s.statusErrMu.Lock()
+	defer s.statusErrMu.Unlock()
+	//#nosec:G703 wtf is even this problem here.
+	s.statusErr = err
+	return result, err
+}
+
+// exchangeCapabilities negotiates the capabilities with every connected
+// endpoint and returns the capabilities of the primary endpoint.
+func (s *EngineClient[ExecutionPayloadT]) exchangeCapabilities(
+	ctx context.Context,
+) ([]string, error) {
+	var (
+		primary = s.primaryEndpoint()
+		result  []string
+		err     = ErrNotStarted
+	)
+	for _, e := range s.endpoints {
+		client := e.Client()
+		if client == nil || client.Client == nil {
+			continue
+		}
+		capabilities, exchangeErr := s.exchangeEndpointCapabilities(
+			ctx, e, client,
+		)
+		if exchangeErr != nil && e != primary {
+			s.logger.Error(
+				"failed to exchange capabilities",
+				"dial_url", e.String(),
+				"err", exchangeErr,
+			)
+		}
+		if e == primary {
+			result, err = capabilities, exchangeErr
+		}
+	}
+	return result, err
+}
+
+// exchangeEndpointCapabilities negotiates the capabilities with the endpoint
+// and caches the engine API methods it supports. Changes to the negotiated
+// methods, e.g. after an upgrade of the execution client, are logged.
+func (s *EngineClient[ExecutionPayloadT]) exchangeEndpointCapabilities(
+	ctx context.Context,
+	e *endpoint[ExecutionPayloadT],
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) ([]string, error) {
+	result, err := client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
-		s.statusErrMu.Lock()
-		defer s.statusErrMu.Unlock()
-		//#nosec:G703 wtf is even this problem here.
-		s.statusErr = s.handleRPCError(err)
-		return nil, s.statusErr
+		return nil, s.handleRPCError(err)
 	}
 
-	// Capture and log the capabilities that the execution client has.
-	for _, capability := range result {
-		s.logger.Info("exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+	negotiated := e.capabilities.IsNegotiated()
+	added, removed := e.capabilities.Update(result)
+	switch {
+	case !negotiated:
+		// Capture and log the capabilities that the execution client has.
+		for _, capability := range result {
+			s.logger.Info(
+				"exchanged capability",
+				"dial_url", e.String(),
+				"capability", capability,
+			)
+		}
+	case len(added) > 0 || len(removed) > 0:
+		s.logger.Warn(
+			"execution client capabilities changed 🔀",
+			"dial_url", e.String(),
+			"added", added,
+			"removed", removed,
+		)
+	default:
+		return result, nil
 	}
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if !e.capabilities.Has(capability) {
 			s.logger.Warn(
 				"your execution client may require an update 🚸",
+				"dial_url", e.String(),
 				"unsupported_capability", capability,
 			)
 		}
 	}
-
-	s.statusErr = nil
 	return result, nil
 }
+
+// samePayloadStatus returns true if both payload statuses are equal.
+func samePayloadStatus(a, b *engineprimitives.PayloadStatusV1) bool {
+	return a == nil || b == nil || a.Status == b.Status
+}
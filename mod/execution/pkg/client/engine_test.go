@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayload is a minimal execution payload that carries the execution
+// requests introduced in Electra.
+type testPayload struct {
+	version uint32
+
+	BlockHash          common.ExecutionHash                  `json:"blockHash"`
+	DepositRequests    []*engineprimitives.DepositRequest    `json:"depositRequests,omitempty"`
+	WithdrawalRequests []*engineprimitives.WithdrawalRequest `json:"withdrawalRequests,omitempty"`
+}
+
+func (p *testPayload) Empty(forkVersion uint32) *testPayload {
+	return &testPayload{version: forkVersion}
+}
+
+func (p *testPayload) Version() uint32 {
+	return p.version
+}
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(bz []byte) error {
+	type payload testPayload
+	return json.Unmarshal(bz, (*payload)(p))
+}
+
+// testSink is a telemetry sink that drops all metrics.
+type testSink struct{}
+
+func (testSink) IncrementCounter(string, ...string)        {}
+func (testSink) SetGauge(string, int64, ...string)         {}
+func (testSink) MeasureSince(string, time.Time, ...string) {}
+
+// mockEngine is an in-process engine API server that records the methods
+// it is called with.
+type mockEngine struct {
+	mu       sync.Mutex
+	calls    []string
+	payloads []json.RawMessage
+	built    json.RawMessage
+	// status overrides the returned payload status when set.
+	status string
+	// capabilities overrides the exchanged capabilities when set.
+	capabilities []string
+}
+
+func (e *mockEngine) record(method string, payload json.RawMessage) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, method)
+	if payload != nil {
+		e.payloads = append(e.payloads, payload)
+	}
+}
+
+func (e *mockEngine) valid() *engineprimitives.PayloadStatusV1 {
+	status := engineprimitives.PayloadStatusValid
+	if e.status != "" {
+		status = e.status
+	}
+	return &engineprimitives.PayloadStatusV1{
+		Status:          status,
+		LatestValidHash: &common.ExecutionHash{0x01},
+	}
+}
+
+func (e *mockEngine) NewPayloadV3(
+	payload json.RawMessage, _ []common.ExecutionHash, _ *common.ExecutionHash,
+) (*engineprimitives.PayloadStatusV1, error) {
+	e.record(ethclient.NewPayloadMethodV3, payload)
+	return e.valid(), nil
+}
+
+func (e *mockEngine) NewPayloadV4(
+	payload json.RawMessage, _ []common.ExecutionHash, _ *common.ExecutionHash,
+) (*engineprimitives.PayloadStatusV1, error) {
+	e.record(ethclient.NewPayloadMethodV4, payload)
+	return e.valid(), nil
+}
+
+func (e *mockEngine) ForkchoiceUpdatedV3(
+	_ *engineprimitives.ForkchoiceStateV1, _ json.RawMessage,
+) (*engineprimitives.ForkchoiceResponseV1, error) {
+	e.record(ethclient.ForkchoiceUpdatedMethodV3, nil)
+	return &engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: *e.valid(),
+		PayloadID:     &engineprimitives.PayloadID{0x02},
+	}, nil
+}
+
+func (e *mockEngine) GetPayloadV3(
+	_ engineprimitives.PayloadID,
+) (json.RawMessage, error) {
+	e.record(ethclient.GetPayloadMethodV3, nil)
+	return e.built, nil
+}
+
+func (e *mockEngine) GetPayloadV4(
+	_ engineprimitives.PayloadID,
+) (json.RawMessage, error) {
+	e.record(ethclient.GetPayloadMethodV4, nil)
+	return e.built, nil
+}
+
+func (e *mockEngine) ExchangeCapabilities(capabilities []string) []string {
+	e.record(ethclient.ExchangeCapabilities, nil)
+	if e.capabilities != nil {
+		return e.capabilities
+	}
+	return capabilities
+}
+
+// newTestEngineClient returns an engine client connected to the given mock
+// engine.
+func newTestEngineClient(
+	t *testing.T,
+	engine *mockEngine,
+) *EngineClient[*testPayload] {
+	t.Helper()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("engine", engine))
+	t.Cleanup(server.Stop)
+
+	rpcClient := rpc.DialInProc(server)
+	t.Cleanup(rpcClient.Close)
+
+	eth1Client, err := ethclient.NewFromRPCClient[*testPayload](rpcClient)
+	require.NoError(t, err)
+
+	cfg := DefaultConfig()
+	c := New[*testPayload](
+		&cfg, noop.NewLogger(), nil, testSink{}, nil, nil,
+	)
+	c.endpoints[0].setClient(eth1Client)
+	return c
+}
+
+func newTestElectraPayload() *testPayload {
+	return &testPayload{
+		version:   version.Electra,
+		BlockHash: common.ExecutionHash{0x03},
+		DepositRequests: []*engineprimitives.DepositRequest{{
+			Pubkey: crypto.BLSPubkey{0x04},
+			Amount: math.Gwei(32e9),
+			Index:  7,
+		}},
+		WithdrawalRequests: []*engineprimitives.WithdrawalRequest{{
+			SourceAddress:   common.ExecutionAddress{0x05},
+			ValidatorPubkey: crypto.BLSPubkey{0x04},
+		}},
+	}
+}
+
+func TestEngineClient_NewPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload *testPayload
+		method  string
+	}{
+		{
+			name:    "deneb",
+			payload: &testPayload{version: version.Deneb},
+			method:  ethclient.NewPayloadMethodV3,
+		},
+		{
+			name:    "electra",
+			payload: newTestElectraPayload(),
+			method:  ethclient.NewPayloadMethodV4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &mockEngine{}
+			c := newTestEngineClient(t, engine)
+
+			parentRoot := primitives.Root{0x06}
+			lvh, err := c.NewPayload(
+				context.Background(), tt.payload, nil, &parentRoot,
+			)
+			require.NoError(t, err)
+			require.Equal(t, &common.ExecutionHash{0x01}, lvh)
+			require.Equal(t, []string{tt.method}, engine.calls)
+
+			// The payload is sent with its requests.
+			sent := new(testPayload)
+			require.NoError(t, json.Unmarshal(engine.payloads[0], sent))
+			require.Equal(t, tt.payload.DepositRequests, sent.DepositRequests)
+			require.Equal(
+				t, tt.payload.WithdrawalRequests, sent.WithdrawalRequests,
+			)
+		})
+	}
+}
+
+func TestEngineClient_ForkchoiceUpdated(t *testing.T) {
+	for _, forkVersion := range []uint32{version.Deneb, version.Electra} {
+		engine := &mockEngine{}
+		c := newTestEngineClient(t, engine)
+
+		payloadID, _, err := c.ForkchoiceUpdated(
+			context.Background(),
+			&engineprimitives.ForkchoiceStateV1{},
+			nil,
+			forkVersion,
+		)
+		require.NoError(t, err)
+		require.Equal(t, &engineprimitives.PayloadID{0x02}, payloadID)
+
+		// Electra does not introduce a new forkchoiceUpdated method.
+		require.Equal(
+			t, []string{ethclient.ForkchoiceUpdatedMethodV3}, engine.calls,
+		)
+	}
+}
+
+func TestEngineClient_GetPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		forkVersion uint32
+		method      string
+	}{
+		{
+			name:        "deneb",
+			forkVersion: version.Deneb,
+			method:      ethclient.GetPayloadMethodV3,
+		},
+		{
+			name:        "electra",
+			forkVersion: version.Electra,
+			method:      ethclient.GetPayloadMethodV4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := newTestElectraPayload()
+			payloadJSON, err := expected.MarshalJSON()
+			require.NoError(t, err)
+
+			engine := &mockEngine{
+				built: json.RawMessage(`{"executionPayload":` +
+					string(payloadJSON) + `,"blockValue":"0x0",` +
+					`"blobsBundle":{"commitments":[],"proofs":[],"blobs":[]},` +
+					`"shouldOverrideBuilder":false}`),
+			}
+			c := newTestEngineClient(t, engine)
+
+			env, err := c.GetPayload(
+				context.Background(),
+				engineprimitives.PayloadID{0x02},
+				tt.forkVersion,
+			)
+			require.NoError(t, err)
+			require.Equal(t, []string{tt.method}, engine.calls)
+
+			// The payload is decoded as the requested fork version.
+			payload := env.GetExecutionPayload()
+			require.Equal(t, tt.forkVersion, payload.Version())
+			require.Equal(t, expected.DepositRequests, payload.DepositRequests)
+			require.Equal(
+				t, expected.WithdrawalRequests, payload.WithdrawalRequests,
+			)
+		})
+	}
+}
+
+func TestEngineClient_ExchangeCapabilities(t *testing.T) {
+	engine := &mockEngine{}
+	c := newTestEngineClient(t, engine)
+
+	capabilities, err := c.ExchangeCapabilities(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, capabilities, ethclient.NewPayloadMethodV4)
+	require.Contains(t, capabilities, ethclient.GetPayloadMethodV4)
+}
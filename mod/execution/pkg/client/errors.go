@@ -47,6 +47,15 @@ const (
 var (
 	// ErrNotStarted indicates that the execution client is not started.
 	ErrNotStarted = errors.New("engine client is not started")
+
+	// ErrNoHealthyEndpoint indicates that none of the execution client
+	// endpoints is healthy.
+	ErrNoHealthyEndpoint = errors.New("no healthy execution client endpoint")
+
+	// ErrUnsupportedEngineMethod indicates that the execution client does
+	// not support an engine API method required by the client.
+	ErrUnsupportedEngineMethod = errors.New(
+		"execution client does not support required engine API method")
 )
 
 // Handles errors received from the RPC server according to the specification.
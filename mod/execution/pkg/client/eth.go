@@ -26,8 +26,47 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// The methods below are served by the primary endpoint, which is looked up
+// on every call since it may change on failover.
+
+// ChainID retrieves the chain ID of the execution client.
+func (s *EngineClient[ExecutionPayloadDenebT]) ChainID(
+	ctx context.Context,
+) (*big.Int, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.ChainID(ctx)
+}
+
+// BlockNumber returns the most recent block number.
+func (s *EngineClient[ExecutionPayloadDenebT]) BlockNumber(
+	ctx context.Context,
+) (uint64, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return 0, err
+	}
+	return client.BlockNumber(ctx)
+}
+
+// BlockByNumber retrieves the block by its number.
+func (s *EngineClient[ExecutionPayloadDenebT]) BlockByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*engineprimitives.Block, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.BlockByNumber(ctx, number)
+}
+
 // HeaderByNumber retrieves the block header by its number.
 func (s *EngineClient[ExecutionPayloadDenebT]) HeaderByNumber(
 	ctx context.Context,
@@ -47,7 +86,11 @@ func (s *EngineClient[ExecutionPayloadDenebT]) HeaderByNumber(
 		return header, nil
 	}
 
-	header, err := s.Client.HeaderByNumber(ctx, number)
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	header, err := client.HeaderByNumber(ctx, number)
 	if err != nil {
 		return nil, err
 	}
@@ -68,10 +111,137 @@ func (s *EngineClient[ExecutionPayloadDenebT]) HeaderByHash(
 	if ok {
 		return header, nil
 	}
-	header, err := s.Client.HeaderByHash(ctx, hash)
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	header, err = client.HeaderByHash(ctx, hash)
 	if err != nil {
 		return nil, err
 	}
 	s.engineCache.AddHeader(header)
 	return header, nil
 }
+
+// CodeAt returns the contract code of the given account at the given block.
+func (s *EngineClient[ExecutionPayloadDenebT]) CodeAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.CodeAt(ctx, account, blockNumber)
+}
+
+// CallContract executes a message call at the given block.
+func (s *EngineClient[ExecutionPayloadDenebT]) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.CallContract(ctx, msg, blockNumber)
+}
+
+// PendingCodeAt returns the contract code of the given account in the
+// pending state.
+func (s *EngineClient[ExecutionPayloadDenebT]) PendingCodeAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+) ([]byte, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.PendingCodeAt(ctx, account)
+}
+
+// PendingNonceAt returns the nonce of the given account in the pending
+// state.
+func (s *EngineClient[ExecutionPayloadDenebT]) PendingNonceAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+) (uint64, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return 0, err
+	}
+	return client.PendingNonceAt(ctx, account)
+}
+
+// SuggestGasPrice retrieves the currently suggested gas price.
+func (s *EngineClient[ExecutionPayloadDenebT]) SuggestGasPrice(
+	ctx context.Context,
+) (*big.Int, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.SuggestGasPrice(ctx)
+}
+
+// SuggestGasTipCap retrieves the currently suggested gas tip cap.
+func (s *EngineClient[ExecutionPayloadDenebT]) SuggestGasTipCap(
+	ctx context.Context,
+) (*big.Int, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.SuggestGasTipCap(ctx)
+}
+
+// EstimateGas estimates the gas needed to execute the given message.
+func (s *EngineClient[ExecutionPayloadDenebT]) EstimateGas(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+) (uint64, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return 0, err
+	}
+	return client.EstimateGas(ctx, msg)
+}
+
+// SendTransaction injects a signed transaction into the pending pool.
+func (s *EngineClient[ExecutionPayloadDenebT]) SendTransaction(
+	ctx context.Context,
+	tx *coretypes.Transaction,
+) error {
+	client, err := s.primaryClient()
+	if err != nil {
+		return err
+	}
+	return client.SendTransaction(ctx, tx)
+}
+
+// FilterLogs executes the given filter query.
+func (s *EngineClient[ExecutionPayloadDenebT]) FilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+) ([]engineprimitives.Log, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.FilterLogs(ctx, q)
+}
+
+// SubscribeFilterLogs subscribes to the results of the given filter query.
+func (s *EngineClient[ExecutionPayloadDenebT]) SubscribeFilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- engineprimitives.Log,
+) (ethereum.Subscription, error) {
+	client, err := s.primaryClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.SubscribeFilterLogs(ctx, q, ch)
+}
@@ -77,10 +77,36 @@ func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV3(
 	payload any,
 	versionedHashes []common.ExecutionHash,
 	parentBlockRoot *primitives.Root,
+) (*engineprimitives.PayloadStatusV1, error) {
+	return s.newPayloadCall(
+		ctx, NewPayloadMethodV3, payload, versionedHashes, parentBlockRoot,
+	)
+}
+
+// NewPayloadV4 calls the engine_newPayloadV4 method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload any,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *primitives.Root,
+) (*engineprimitives.PayloadStatusV1, error) {
+	return s.newPayloadCall(
+		ctx, NewPayloadMethodV4, payload, versionedHashes, parentBlockRoot,
+	)
+}
+
+// newPayloadCall is a helper function to call to any version of the
+// newPayload method from Deneb onwards.
+func (s *Eth1Client[ExecutionPayloadT]) newPayloadCall(
+	ctx context.Context,
+	method string,
+	payload any,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *primitives.Root,
 ) (*engineprimitives.PayloadStatusV1, error) {
 	result := &engineprimitives.PayloadStatusV1{}
 	if err := s.Client.Client().CallContext(
-		ctx, result, NewPayloadMethodV3, payload, versionedHashes,
+		ctx, result, method, payload, versionedHashes,
 		(*common.ExecutionHash)(parentBlockRoot),
 	); err != nil {
 		return nil, err
@@ -123,6 +149,26 @@ func (s *Eth1Client[ExecutionPayloadT]) forkchoiceUpdateCall(
 // GetPayloadV3 calls the engine_getPayloadV3 method via JSON-RPC.
 func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.getPayloadCall(ctx, GetPayloadMethodV3, payloadID, version.Deneb)
+}
+
+// GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV4(
+	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.getPayloadCall(
+		ctx, GetPayloadMethodV4, payloadID, version.Electra,
+	)
+}
+
+// getPayloadCall is a helper function to call to any version of the
+// getPayload method, decoding the payload as the given fork version.
+func (s *Eth1Client[ExecutionPayloadT]) getPayloadCall(
+	ctx context.Context,
+	method string,
+	payloadID engineprimitives.PayloadID,
+	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	var t ExecutionPayloadT
 	result := &engineprimitives.ExecutionPayloadEnvelope[
@@ -131,11 +177,11 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
 		],
 	]{
-		ExecutionPayload: t.Empty(version.Deneb),
+		ExecutionPayload: t.Empty(forkVersion),
 	}
 
 	if err := s.Client.Client().CallContext(
-		ctx, result, GetPayloadMethodV3, payloadID,
+		ctx, result, method, payloadID,
 	); err != nil {
 		return nil, err
 	}
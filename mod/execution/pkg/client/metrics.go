@@ -94,6 +94,67 @@ func (cm *clientMetrics) incrementGetPayloadTimeout() {
 		"beacon_kit.execution.client.get_payload_duration")
 }
 
+// measureEndpointCallDuration measures the duration of a call to a single
+// execution client endpoint.
+func (cm *clientMetrics) measureEndpointCallDuration(
+	endpoint, method string,
+	startTime time.Time,
+) {
+	cm.sink.MeasureSince(
+		"beacon_kit.execution.client.endpoint_call_duration",
+		startTime,
+		"endpoint", endpoint,
+		"method", method,
+	)
+}
+
+// setEndpointHealthScore sets the health score gauge of an execution client
+// endpoint.
+func (cm *clientMetrics) setEndpointHealthScore(endpoint string, score int) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.endpoint_health_score",
+		int64(score),
+		"endpoint", endpoint,
+	)
+}
+
+// incrementEndpointFailure increments the failure counter of an execution
+// client endpoint.
+func (cm *clientMetrics) incrementEndpointFailure(endpoint string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.endpoint_failure",
+		"endpoint", endpoint,
+	)
+}
+
+// incrementEndpointInconsistency increments the counter of responses from an
+// execution client endpoint that disagree with the primary endpoint.
+func (cm *clientMetrics) incrementEndpointInconsistency(
+	endpoint, method string,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.endpoint_inconsistency",
+		"endpoint", endpoint,
+		"method", method,
+	)
+}
+
+// markFailover logs and counts a failover of the primary execution client
+// endpoint.
+func (cm *clientMetrics) markFailover(from, to string, err error) {
+	cm.logger.Warn(
+		"failing over primary execution client 🔀",
+		"from", from,
+		"to", to,
+		"err", err,
+	)
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.failover",
+		"from", from,
+		"to", to,
+	)
+}
+
 // incrementHTTPTimeout increments the timeout counter for HTTP.
 func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
@@ -0,0 +1,382 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// endpointResult is the result of a call to a single execution client
+// endpoint.
+type endpointResult[
+	T any,
+	ExecutionPayloadT interface {
+		json.Marshaler
+		json.Unmarshaler
+		Empty(uint32) ExecutionPayloadT
+	},
+] struct {
+	endpoint *endpoint[ExecutionPayloadT]
+	result   T
+	err      error
+}
+
+// callEndpoints calls fn on the primary and every other healthy endpoint
+// concurrently and returns the result of the primary along with the primary.
+// If the primary cannot be reached, the first endpoint that responds is
+// promoted to primary and its result is returned instead. The responses of
+// the remaining endpoints are checked for consistency with the returned one
+// in the background.
+// fn is called with the capabilities negotiated with the endpoint, so that
+// every endpoint is routed to the method versions it supports.
+func callEndpoints[
+	T any,
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+](
+	ctx context.Context,
+	s *EngineClient[ExecutionPayloadT],
+	method string,
+	fn func(
+		context.Context,
+		*ethclient.Eth1Client[ExecutionPayloadT],
+		*capabilitySet,
+	) (T, error),
+	equal func(T, T) bool,
+) (T, *endpoint[ExecutionPayloadT], error) {
+	primary, others := s.endpointsForCall()
+	results := make(
+		chan endpointResult[T, ExecutionPayloadT], 1+len(others),
+	)
+	call := func(ctx context.Context, e *endpoint[ExecutionPayloadT]) {
+		var (
+			result    T
+			err       = ErrNotStarted
+			startTime = time.Now()
+		)
+		if c := e.Client(); c != nil && c.Client != nil {
+			result, err = fn(ctx, c, e.capabilities)
+		}
+		s.metrics.measureEndpointCallDuration(e.String(), method, startTime)
+		s.recordResult(e, err)
+		results <- endpointResult[T, ExecutionPayloadT]{e, result, err}
+	}
+
+	// The other endpoints are only awaited if the primary fails, hence they
+	// get a deadline that outlives the call.
+	fallbackCtx, cancel := context.WithTimeout(
+		context.WithoutCancel(ctx), s.cfg.RPCTimeout,
+	)
+	go call(ctx, primary)
+	for _, e := range others {
+		go call(fallbackCtx, e)
+	}
+
+	var (
+		pending       = 1 + len(others)
+		received      = make([]endpointResult[T, ExecutionPayloadT], 0, pending)
+		primaryResult *endpointResult[T, ExecutionPayloadT]
+		selected      *endpointResult[T, ExecutionPayloadT]
+	)
+	for selected == nil {
+		r := <-results
+		pending--
+		received = append(received, r)
+		if r.endpoint == primary {
+			primaryResult = &r
+		}
+		if primaryResult == nil {
+			continue
+		}
+		selected = selectResult(primaryResult, received)
+		if selected == nil && pending == 0 {
+			selected = primaryResult
+		}
+	}
+
+	if selected.endpoint != primary {
+		s.failover(primary, selected.endpoint, primaryResult.err)
+	}
+
+	go func() {
+		defer cancel()
+		for _, r := range received {
+			checkConsistency(s, method, selected, r, equal)
+		}
+		for ; pending > 0; pending-- {
+			checkConsistency(s, method, selected, <-results, equal)
+		}
+	}()
+
+	return selected.result, selected.endpoint, selected.err
+}
+
+// selectResult returns the result of the primary if it was reached, or
+// otherwise the first received result of an endpoint that was reached.
+func selectResult[
+	T any,
+	ExecutionPayloadT interface {
+		json.Marshaler
+		json.Unmarshaler
+		Empty(uint32) ExecutionPayloadT
+	},
+](
+	primaryResult *endpointResult[T, ExecutionPayloadT],
+	received []endpointResult[T, ExecutionPayloadT],
+) *endpointResult[T, ExecutionPayloadT] {
+	if !isEndpointFailure(primaryResult.err) {
+		return primaryResult
+	}
+	for i := range received {
+		if received[i].endpoint != primaryResult.endpoint &&
+			!isEndpointFailure(received[i].err) {
+			return &received[i]
+		}
+	}
+	return nil
+}
+
+// checkConsistency compares the result of an endpoint against the selected
+// result and reports endpoints that disagree.
+func checkConsistency[
+	T any,
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+](
+	s *EngineClient[ExecutionPayloadT],
+	method string,
+	selected *endpointResult[T, ExecutionPayloadT],
+	r endpointResult[T, ExecutionPayloadT],
+	equal func(T, T) bool,
+) {
+	// Unreachable endpoints are already accounted for by their health
+	// score.
+	if r.endpoint == selected.endpoint || isEndpointFailure(r.err) {
+		return
+	}
+
+	if (r.err == nil) == (selected.err == nil) &&
+		(r.err != nil || equal(selected.result, r.result)) {
+		return
+	}
+
+	s.logger.Warn(
+		"execution client endpoints disagree ⚠️",
+		"method", method,
+		"endpoint", r.endpoint.String(),
+		"primary", selected.endpoint.String(),
+		"err", r.err,
+	)
+	s.metrics.incrementEndpointInconsistency(r.endpoint.String(), method)
+}
+
+// isEndpointFailure returns true if the error indicates that the endpoint
+// could not be reached, as opposed to a JSON-RPC error returned by a
+// responsive execution client.
+func isEndpointFailure(err error) bool {
+	var rpcErr ethrpc.Error
+	return err != nil && !errors.As(err, &rpcErr)
+}
+
+// pinPayload records the endpoint that was asked to build the payload with
+// the given ID, as only that endpoint can serve it.
+func (s *EngineClient[ExecutionPayloadT]) pinPayload(
+	payloadID engineprimitives.PayloadID,
+	e *endpoint[ExecutionPayloadT],
+) {
+	s.payloadEndpoints.Add(payloadID, e)
+}
+
+// payloadEndpoint returns the endpoint that was asked to build the payload
+// with the given ID, or the primary if the payload ID is not known.
+func (s *EngineClient[ExecutionPayloadT]) payloadEndpoint(
+	payloadID engineprimitives.PayloadID,
+) *endpoint[ExecutionPayloadT] {
+	if e, ok := s.payloadEndpoints.Get(payloadID); ok {
+		return e
+	}
+	return s.primaryEndpoint()
+}
+
+// endpointsForCall returns the primary endpoint and the other healthy
+// endpoints.
+func (s *EngineClient[ExecutionPayloadT]) endpointsForCall() (
+	*endpoint[ExecutionPayloadT], []*endpoint[ExecutionPayloadT],
+) {
+	s.endpointsMu.RLock()
+	defer s.endpointsMu.RUnlock()
+	others := make([]*endpoint[ExecutionPayloadT], 0, len(s.endpoints)-1)
+	for _, e := range s.endpoints {
+		if e != s.primary && e.IsHealthy() {
+			others = append(others, e)
+		}
+	}
+	return s.primary, others
+}
+
+// primaryEndpoint returns the primary endpoint.
+//
+//nolint:lll
+func (s *EngineClient[ExecutionPayloadT]) primaryEndpoint() *endpoint[ExecutionPayloadT] {
+	s.endpointsMu.RLock()
+	defer s.endpointsMu.RUnlock()
+	return s.primary
+}
+
+// primaryClient returns the connected client of the primary endpoint. The
+// client is looked up on every call, so that calls always reach the current
+// primary.
+//
+//nolint:lll
+func (s *EngineClient[ExecutionPayloadT]) primaryClient() (*ethclient.Eth1Client[ExecutionPayloadT], error) {
+	c := s.primaryEndpoint().Client()
+	if c == nil || c.Client == nil {
+		return nil, ErrNotStarted
+	}
+	return c, nil
+}
+
+// recordResult updates the health score of the endpoint with the outcome of
+// a call. If the primary becomes unhealthy, a new primary is selected.
+func (s *EngineClient[ExecutionPayloadT]) recordResult(
+	e *endpoint[ExecutionPayloadT],
+	err error,
+) {
+	// A call cancelled by the caller says nothing about the endpoint.
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	var score int
+	if isEndpointFailure(err) {
+		score = e.recordFailure(err)
+		s.metrics.incrementEndpointFailure(e.String())
+	} else {
+		score = e.recordSuccess()
+	}
+	s.metrics.setEndpointHealthScore(e.String(), score)
+
+	if score == 0 && s.isPrimary(e) {
+		if err = s.selectPrimary(); err != nil {
+			s.logger.Error("failed to fail over primary", "err", err)
+		}
+	}
+}
+
+// isPrimary returns true if the endpoint is the primary endpoint.
+func (s *EngineClient[ExecutionPayloadT]) isPrimary(
+	e *endpoint[ExecutionPayloadT],
+) bool {
+	return s.primaryEndpoint() == e
+}
+
+// failover promotes the given endpoint to primary, unless the primary has
+// already changed since the failing call was made.
+func (s *EngineClient[ExecutionPayloadT]) failover(
+	from, to *endpoint[ExecutionPayloadT],
+	err error,
+) {
+	s.endpointsMu.Lock()
+	defer s.endpointsMu.Unlock()
+	if s.primary != from {
+		return
+	}
+	s.metrics.markFailover(from.String(), to.String(), err)
+	s.primary = to
+}
+
+// selectPrimary promotes the healthiest endpoint to primary. The current
+// primary is kept unless another endpoint has a strictly higher score.
+func (s *EngineClient[ExecutionPayloadT]) selectPrimary() error {
+	s.endpointsMu.Lock()
+	defer s.endpointsMu.Unlock()
+
+	best := s.primary
+	for _, e := range s.endpoints {
+		if e.IsHealthy() && (!best.IsHealthy() || e.Score() > best.Score()) {
+			best = e
+		}
+	}
+
+	if !best.IsHealthy() {
+		return ErrNoHealthyEndpoint
+	}
+
+	if best != s.primary {
+		s.metrics.markFailover(s.primary.String(), best.String(), s.primary.Err())
+	}
+	s.primary = best
+	return nil
+}
+
+// checkEndpoints health checks every endpoint by verifying its chain ID,
+// connecting the endpoints that are not connected yet, and selects the
+// primary endpoint.
+func (s *EngineClient[ExecutionPayloadT]) checkEndpoints(
+	ctx context.Context,
+) error {
+	for _, e := range s.endpoints {
+		var err error
+		if c := e.Client(); c == nil || c.Client == nil {
+			err = s.setupEndpoint(ctx, e)
+		} else {
+			err = s.verifyChainID(ctx, c)
+		}
+		s.recordResult(e, err)
+	}
+	return s.selectPrimary()
+}
+
+// healthCheckLoop periodically health checks the endpoints.
+func (s *EngineClient[ExecutionPayloadT]) healthCheckLoop(
+	ctx context.Context,
+) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.statusErrMu.Lock()
+			if err := s.status(ctx); err != nil {
+				s.logger.Error("execution client is unhealthy", "err", err)
+			}
+			s.statusErrMu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink is a telemetry sink that counts the counters it receives,
+// keyed by metric name and labels.
+type recordingSink struct {
+	testSink
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *recordingSink) IncrementCounter(key string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.counters == nil {
+		s.counters = make(map[string]int)
+	}
+	s.counters[key+"|"+strings.Join(args, ",")]++
+}
+
+func (s *recordingSink) count(key string, args ...string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key+"|"+strings.Join(args, ",")]
+}
+
+// newTestMultiEngineClient returns an engine client with one endpoint per
+// mock engine, the first one being the primary. The returned RPC clients
+// can be closed to take an endpoint down.
+func newTestMultiEngineClient(
+	t *testing.T,
+	sink TelemetrySink,
+	engines ...*mockEngine,
+) (*EngineClient[*testPayload], []*rpc.Client) {
+	t.Helper()
+	cfg := DefaultConfig()
+	for i := 1; i < len(engines); i++ {
+		u, err := url.NewFromRaw(fmt.Sprintf("http://fallback-%d:8551", i))
+		require.NoError(t, err)
+		cfg.RPCFallbackDialURLs = append(cfg.RPCFallbackDialURLs, u)
+	}
+	c := New[*testPayload](
+		&cfg, noop.NewLogger(), nil, sink, nil, nil,
+	)
+
+	rpcClients := make([]*rpc.Client, len(engines))
+	for i, engine := range engines {
+		server := rpc.NewServer()
+		require.NoError(t, server.RegisterName("engine", engine))
+		t.Cleanup(server.Stop)
+
+		rpcClients[i] = rpc.DialInProc(server)
+		t.Cleanup(rpcClients[i].Close)
+
+		eth1Client, err := ethclient.NewFromRPCClient[*testPayload](
+			rpcClients[i],
+		)
+		require.NoError(t, err)
+		c.endpoints[i].setClient(eth1Client)
+	}
+	require.NoError(t, c.selectPrimary())
+	return c, rpcClients
+}
+
+func newTestPayloadCall(c *EngineClient[*testPayload]) error {
+	parentRoot := primitives.Root{0x06}
+	_, err := c.NewPayload(
+		context.Background(),
+		&testPayload{version: version.Deneb},
+		nil,
+		&parentRoot,
+	)
+	return err
+}
+
+func TestEngineClient_FanOut(t *testing.T) {
+	primary, fallback := &mockEngine{}, &mockEngine{}
+	c, _ := newTestMultiEngineClient(t, testSink{}, primary, fallback)
+
+	require.NoError(t, newTestPayloadCall(c))
+	_, _, err := c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{},
+		nil,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+
+	// Every endpoint is sent both calls.
+	expected := []string{
+		ethclient.NewPayloadMethodV3, ethclient.ForkchoiceUpdatedMethodV3,
+	}
+	require.Eventually(t, func() bool {
+		fallback.mu.Lock()
+		defer fallback.mu.Unlock()
+		return len(fallback.calls) == len(expected)
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, expected, primary.calls)
+	require.Equal(t, expected, fallback.calls)
+	require.Same(t, c.endpoints[0], c.primaryEndpoint())
+}
+
+func TestEngineClient_FailoverOnPrimaryOutage(t *testing.T) {
+	sink := &recordingSink{}
+	primary, fallback := &mockEngine{}, &mockEngine{}
+	c, rpcClients := newTestMultiEngineClient(t, sink, primary, fallback)
+
+	// Take the primary down, the call is served by the fallback.
+	rpcClients[0].Close()
+	require.NoError(t, newTestPayloadCall(c))
+	require.Same(t, c.endpoints[1], c.primaryEndpoint())
+	client, err := c.primaryClient()
+	require.NoError(t, err)
+	require.Same(t, c.endpoints[1].Client(), client)
+	require.Equal(t, []string{ethclient.NewPayloadMethodV3}, fallback.calls)
+	require.Equal(t, 1, sink.count(
+		"beacon_kit.execution.client.failover",
+		"from", c.endpoints[0].String(),
+		"to", c.endpoints[1].String(),
+	))
+
+	// The unhealthy endpoint is no longer called.
+	require.NoError(t, newTestPayloadCall(c))
+	require.False(t, c.endpoints[0].IsHealthy())
+	require.Empty(t, primary.calls)
+}
+
+func TestEngineClient_ConcurrentFailover(t *testing.T) {
+	c, _ := newTestMultiEngineClient(
+		t, testSink{}, &mockEngine{}, &mockEngine{},
+	)
+	ctx := context.Background()
+
+	// Calls served by the primary must not race with failovers.
+	calls := []func(){
+		func() { _ = newTestPayloadCall(c) },
+		func() {
+			_, _ = c.GetPayload(
+				ctx, engineprimitives.PayloadID{0x02}, version.Deneb,
+			)
+		},
+		func() { _, _ = c.exchangeCapabilities(ctx) },
+		func() { _ = c.VerifyChainID(ctx) },
+		func() { _, _ = c.BlockNumber(ctx) },
+	}
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1 + len(calls))
+		go func() {
+			defer wg.Done()
+			c.failover(c.primaryEndpoint(), c.endpoints[(i+1)%2], nil)
+		}()
+		for _, call := range calls {
+			go func() {
+				defer wg.Done()
+				call()
+			}()
+		}
+	}
+	wg.Wait()
+
+	client, err := c.primaryClient()
+	require.NoError(t, err)
+	require.Same(t, c.primaryEndpoint().Client(), client)
+}
+
+func TestEngineClient_RoutePerEndpoint(t *testing.T) {
+	primary := &mockEngine{capabilities: denebCapabilities}
+	fallback := &mockEngine{}
+	c, _ := newTestMultiEngineClient(t, testSink{}, primary, fallback)
+
+	_, err := c.ExchangeCapabilities(context.Background())
+	require.NoError(t, err)
+
+	// The primary cannot serve an Electra payload, the call is routed to
+	// the fallback that can.
+	parentRoot := primitives.Root{0x06}
+	_, err = c.NewPayload(
+		context.Background(), newTestElectraPayload(), nil, &parentRoot,
+	)
+	require.NoError(t, err)
+	require.Same(t, c.endpoints[1], c.primaryEndpoint())
+	require.Equal(t, []string{ethclient.ExchangeCapabilities}, primary.calls)
+	require.Equal(t, []string{
+		ethclient.ExchangeCapabilities, ethclient.NewPayloadMethodV4,
+	}, fallback.calls)
+}
+
+func TestEngineClient_GetPayloadAfterFailover(t *testing.T) {
+	payloadJSON, err := (&testPayload{}).MarshalJSON()
+	require.NoError(t, err)
+	primary := &mockEngine{
+		built: json.RawMessage(`{"executionPayload":` +
+			string(payloadJSON) + `,"blockValue":"0x0",` +
+			`"blobsBundle":{"commitments":[],"proofs":[],"blobs":[]},` +
+			`"shouldOverrideBuilder":false}`),
+	}
+	fallback := &mockEngine{}
+	c, _ := newTestMultiEngineClient(t, testSink{}, primary, fallback)
+
+	payloadID, _, err := c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{},
+		nil,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, payloadID)
+
+	// The payload is retrieved from the endpoint that returned its ID,
+	// even though the primary failed over in between.
+	c.failover(c.endpoints[0], c.endpoints[1], nil)
+	_, err = c.GetPayload(context.Background(), *payloadID, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		ethclient.ForkchoiceUpdatedMethodV3, ethclient.GetPayloadMethodV3,
+	}, primary.calls)
+	require.NotContains(t, fallback.calls, ethclient.GetPayloadMethodV3)
+
+	// Unknown payload IDs are retrieved from the primary.
+	_, _ = c.GetPayload(
+		context.Background(), engineprimitives.PayloadID{0x09}, version.Deneb,
+	)
+	require.Eventually(t, func() bool {
+		fallback.mu.Lock()
+		defer fallback.mu.Unlock()
+		return len(fallback.calls) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Contains(t, fallback.calls, ethclient.GetPayloadMethodV3)
+}
+
+func TestEngineClient_AllEndpointsDown(t *testing.T) {
+	c, rpcClients := newTestMultiEngineClient(
+		t, testSink{}, &mockEngine{}, &mockEngine{},
+	)
+	for _, rpcClient := range rpcClients {
+		rpcClient.Close()
+	}
+
+	// Both endpoints fail until their health score is exhausted.
+	for range maxHealthScore / failurePenalty {
+		require.Error(t, newTestPayloadCall(c))
+	}
+	require.ErrorIs(t, c.selectPrimary(), ErrNoHealthyEndpoint)
+}
+
+func TestEngineClient_InconsistentEndpoints(t *testing.T) {
+	sink := &recordingSink{}
+	fallback := &mockEngine{status: engineprimitives.PayloadStatusSyncing}
+	c, _ := newTestMultiEngineClient(t, sink, &mockEngine{}, fallback)
+
+	// The primary result is returned and the disagreement is reported.
+	require.NoError(t, newTestPayloadCall(c))
+	require.Eventually(t, func() bool {
+		return sink.count(
+			"beacon_kit.execution.client.endpoint_inconsistency",
+			"endpoint", c.endpoints[1].String(),
+			"method", "new_payload",
+		) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Same(t, c.endpoints[0], c.primaryEndpoint())
+}
+
+func TestEngineClient_SelectPrimary(t *testing.T) {
+	c, _ := newTestMultiEngineClient(
+		t, testSink{}, &mockEngine{}, &mockEngine{},
+	)
+	primary, fallback := c.endpoints[0], c.endpoints[1]
+
+	// A single failure is tolerated.
+	c.recordResult(primary, ErrNotStarted)
+	require.True(t, primary.IsHealthy())
+	require.Same(t, primary, c.primaryEndpoint())
+
+	// Repeated failures fail over to the healthiest endpoint.
+	c.recordResult(primary, ErrNotStarted)
+	require.False(t, primary.IsHealthy())
+	require.Same(t, fallback, c.primaryEndpoint())
+
+	// A recovered endpoint does not take over until the primary degrades.
+	c.recordResult(primary, nil)
+	require.True(t, primary.IsHealthy())
+	require.NoError(t, c.selectPrimary())
+	require.Same(t, fallback, c.primaryEndpoint())
+
+	c.recordResult(fallback, ErrNotStarted)
+	require.Equal(t, maxHealthScore-failurePenalty, fallback.Score())
+	for range maxHealthScore {
+		c.recordResult(primary, nil)
+	}
+	require.NoError(t, c.selectPrimary())
+	require.Same(t, primary, c.primaryEndpoint())
+}
@@ -23,6 +23,7 @@ package deposit
 import (
 	"context"
 	"errors"
+	"math/big"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -70,24 +71,26 @@ func NewWrappedBeaconDepositContract[
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads the deposits made to the deposit contract in the
+// execution blocks [from, to].
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
+	from, to math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   uint64(blkNum),
-			End:     (*uint64)(&blkNum),
+			Start:   uint64(from),
+			End:     (*uint64)(&to),
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
+	defer logs.Close()
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
@@ -102,5 +105,25 @@ func (dc *WrappedBeaconDepositContract[
 		))
 	}
 
-	return deposits, nil
+	return deposits, logs.Error()
+}
+
+// ReadDepositCount reads the number of deposits made to the deposit
+// contract as of the given block. No deposits have been made before the
+// contract is deployed.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) ReadDepositCount(
+	ctx context.Context,
+	blkNum math.U64,
+) (uint64, error) {
+	count, err := dc.DepositCount(&bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: new(big.Int).SetUint64(uint64(blkNum)),
+	})
+	if errors.Is(err, bind.ErrNoCode) {
+		return 0, nil
+	}
+	return count, err
 }
@@ -22,8 +22,10 @@ package deposit
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -43,6 +45,8 @@ type Service[
 ] struct {
 	// logger is used for logging information and errors.
 	logger log.Logger[any]
+	// cs is the chain spec.
+	cs primitives.ChainSpec
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
 	eth1FollowDistance math.U64
 	// ethclient is the Ethereum 1.0 client.
@@ -62,10 +66,17 @@ type Service[
 	]
 	// metrics is the metrics for the deposit service.
 	metrics *depositMetrics
-	// newBlock is the channel for new blocks.
-	newBlock chan BeaconBlockT
-	// failedBlocks
-	failedBlocks map[math.U64]struct{}
+	// target is the execution block up to which deposit logs are synced.
+	target atomic.Uint64
+	// notify wakes up the syncer when the target moves.
+	notify chan struct{}
+	// batchSize is the number of execution blocks whose deposit logs are
+	// queried at once. It is only accessed by the syncer.
+	batchSize math.U64
+	// logsCutoff is the number of the first execution block from which
+	// deposits are processed from the deposit requests of the payload. Logs
+	// are no longer fetched from this block onwards.
+	logsCutoff atomic.Pointer[math.U64]
 }
 
 // NewService creates a new instance of the Service struct.
@@ -85,7 +96,7 @@ func NewService[
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 ](
 	logger log.Logger[any],
-	eth1FollowDistance math.U64,
+	cs primitives.ChainSpec,
 	ethclient EthClient,
 	telemetrySink TelemetrySink,
 	ds Store[DepositT],
@@ -106,13 +117,14 @@ func NewService[
 	]{
 		feed:               feed,
 		logger:             logger,
+		cs:                 cs,
 		ethclient:          ethclient,
-		eth1FollowDistance: eth1FollowDistance,
+		eth1FollowDistance: math.U64(cs.Eth1FollowDistance()),
 		metrics:            newDepositMetrics(telemetrySink),
 		dc:                 dc,
 		ds:                 ds,
-		newBlock:           make(chan BeaconBlockT),
-		failedBlocks:       make(map[math.U64]struct{}),
+		notify:             make(chan struct{}, 1),
+		batchSize:          maxBatchSize,
 	}
 }
 
@@ -125,8 +137,7 @@ func (s *Service[
 	ctx context.Context,
 ) error {
 	go s.blockFeedListener(ctx)
-	go s.depositFetcher(ctx)
-	go s.depositCatchupFetcher(ctx)
+	go s.depositSyncer(ctx)
 	return nil
 }
 
@@ -144,7 +155,7 @@ func (s *Service[
 			return
 		case event := <-ch:
 			if event.Is(events.BeaconBlockFinalized) {
-				s.newBlock <- event.Data()
+				s.updateTarget(event.Data())
 			}
 		}
 	}
@@ -22,85 +22,228 @@ package deposit
 
 import (
 	"context"
+	"math/big"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval is the interval at which the syncer retries to
+	// catch up with its target after a failure.
+	defaultRetryInterval = 20 * time.Second
+	// minBatchSize is the smallest number of blocks queried at once.
+	minBatchSize math.U64 = 1
+	// maxBatchSize is the largest number of blocks queried at once.
+	maxBatchSize math.U64 = 1024
+)
 
-// depositFetcher processes a deposit event.
+// updateTarget moves the target of the syncer to the execution block that
+// is eth1FollowDistance behind the payload of the finalized block. Once
+// deposits are processed from the deposit requests of the payload, the
+// target never moves past the last block before the switch.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
-]) depositFetcher(ctx context.Context) {
-	for {
-		select {
-		case <-ctx.Done():
+]) updateTarget(blk BeaconBlockT) {
+	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
+	if s.logsCutoff.Load() == nil && s.cs.SlotToEpoch(blk.GetSlot()) >=
+		s.cs.DepositRequestsForkEpoch() {
+		s.logger.Info(
+			"deposits are processed from deposit requests",
+			"block", blockNum,
+		)
+		s.logsCutoff.Store(&blockNum)
+	}
+
+	if blockNum <= s.eth1FollowDistance {
+		return
+	}
+	target := blockNum - s.eth1FollowDistance
+	if cutoff := s.logsCutoff.Load(); cutoff != nil && target >= *cutoff {
+		if *cutoff == 0 {
 			return
-		case blk := <-s.newBlock:
-			querierBlockNum := blk.
-				GetBody().GetExecutionPayload().GetNumber() - s.eth1FollowDistance
-			s.fetchAndStoreDeposits(ctx, querierBlockNum)
 		}
+		target = *cutoff - 1
+	}
+	if uint64(target) <= s.target.Load() {
+		return
+	}
+	s.target.Store(uint64(target))
+
+	// Wake up the syncer without waiting for it to finish its current sync.
+	select {
+	case s.notify <- struct{}{}:
+	default:
 	}
 }
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
+// depositSyncer syncs the deposit logs up to the target whenever it moves,
+// and retries periodically if the syncer fell behind after a failure. It is
+// the only goroutine that reads the deposit contract and writes the
+// deposit store.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
-]) depositCatchupFetcher(ctx context.Context) {
+]) depositSyncer(ctx context.Context) {
 	ticker := time.NewTicker(defaultRetryInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-s.notify:
 		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
-				continue
-			}
+		}
+
+		if err := s.sync(ctx); err != nil {
 			s.logger.Warn(
-				"failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				s.failedBlocks,
+				"failed to sync deposits, retrying...", "error", err,
 			)
+		}
+	}
+}
 
-			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
+// sync processes the deposit logs from the persisted checkpoint up to the
+// target in ranges of batchSize blocks. The batch size is halved whenever a
+// range cannot be read and grows back as ranges are read successfully. The
+// checkpoint only moves once the deposits of a range are stored without
+// gaps, so a restart resumes from the last block fully processed.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) sync(ctx context.Context) error {
+	target := math.U64(s.target.Load())
+	if target == 0 {
+		return nil
+	}
+
+	checkpoint, ok, err := s.ds.GetCheckpoint()
+	if err != nil {
+		return err
+	}
+	// Without a checkpoint, the syncer starts at its target. Any deposit
+	// made before is backfilled once the gap is detected.
+	from := target
+	if ok {
+		from = math.U64(checkpoint) + 1
+	}
+
+	for from <= target {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		to := min(from+s.batchSize-1, target)
+		deposits, err := s.dc.ReadDeposits(ctx, from, to)
+		if err != nil {
+			s.metrics.markFailedToGetBlockLogs(from)
+			s.batchSize = max(s.batchSize/2, minBatchSize)
+			return err
+		}
+
+		if len(deposits) > 0 {
+			s.logger.Info(
+				"found deposits on execution layer",
+				"from", from, "to", to, "deposits", len(deposits),
+			)
+		}
+		if err = s.ds.EnqueueDeposits(deposits); err != nil {
+			return err
+		}
+
+		gap, found, err := s.findGap(ctx, to)
+		if err != nil {
+			return err
+		}
+		if found {
+			// The logs of the range that was just read are missing
+			// deposits, retrying it right away would not help.
+			if gap >= from {
+				return errors.Wrapf(
+					ErrMissingDepositLogs, "blocks %d to %d", from, to,
+				)
 			}
+			s.logger.Warn("backfilling missing deposits", "from", gap)
+			from = gap
+			continue
 		}
+
+		if err = s.ds.SetCheckpoint(uint64(to)); err != nil {
+			return err
+		}
+		if err = s.storeEth1Block(ctx, to); err != nil {
+			s.logger.Error(
+				"Failed to store eth1 block", "block", to, "error", err,
+			)
+		}
+
+		s.batchSize = min(s.batchSize*2, maxBatchSize)
+		from = to + 1
 	}
+	return nil
 }
+
+// findGap compares the deposits stored without gaps with the deposit count
+// of the deposit contract at the given block. If deposits are missing, it
+// returns the first block at which the deposit contract holds more deposits
+// than stored.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+]) findGap(
+	ctx context.Context,
+	blockNum math.U64,
+) (math.U64, bool, error) {
+	stored, err := s.ds.GetDepositCount()
 	if err != nil {
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+		return 0, false, err
+	}
+	count, err := s.dc.ReadDepositCount(ctx, blockNum)
+	if err != nil || count <= stored {
+		return 0, false, err
 	}
 
-	if len(deposits) > 0 {
-		s.logger.Info(
-			"found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
-		)
+	// The deposit contract holds more deposits than stored at blockNum but
+	// not at genesis, so the first such block is found by a binary search.
+	lo, hi := math.U64(0), blockNum
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if count, err = s.dc.ReadDepositCount(ctx, mid); err != nil {
+			return 0, false, err
+		}
+		if count > stored {
+			hi = mid
+		} else {
+			lo = mid
+		}
 	}
+	return hi, true, nil
+}
 
-	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+// storeEth1Block records the state of the deposit contract at the given
+// block, from which proposers assemble the eth1 data of their blocks.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) storeEth1Block(ctx context.Context, blockNum math.U64) error {
+	depositCount, err := s.dc.ReadDepositCount(ctx, blockNum)
+	if err != nil {
+		return err
+	}
+
+	blk, err := s.ethclient.BlockByNumber(
+		ctx, new(big.Int).SetUint64(uint64(blockNum)),
+	)
+	if err != nil {
+		return err
 	}
 
-	delete(s.failedBlocks, blockNum)
+	return s.ds.SetEth1Block(uint64(blockNum), blk.Hash(), depositCount)
 }
@@ -0,0 +1,344 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnavailable = errors.New("execution client unavailable")
+
+type (
+	testDeposit struct{ index uint64 }
+	testPayload struct{ number math.U64 }
+	testBody    struct{ payload testPayload }
+	testBlock   struct {
+		slot math.U64
+		body testBody
+	}
+	testEvent        struct{ blk testBlock }
+	testSubscription struct{}
+
+	testService = Service[
+		testBlock, testBody, testEvent, *testDeposit,
+		testPayload, testSubscription, [32]byte,
+	]
+)
+
+func (*testDeposit) New(
+	_ crypto.BLSPubkey, _ [32]byte, _ math.U64, _ crypto.BLSSignature,
+	index uint64,
+) *testDeposit {
+	return &testDeposit{index: index}
+}
+
+func (d *testDeposit) GetIndex() uint64 { return d.index }
+
+func (p testPayload) GetNumber() math.U64 { return p.number }
+
+func (testBody) GetDeposits() []*testDeposit { return nil }
+
+func (b testBody) GetExecutionPayload() testPayload { return b.payload }
+
+func (b testBlock) GetSlot() math.U64 { return b.slot }
+
+func (b testBlock) GetBody() testBody { return b.body }
+
+func (testEvent) Name() string { return "" }
+
+func (testEvent) Is(string) bool { return true }
+
+func (testEvent) Context() context.Context { return context.Background() }
+
+func (e testEvent) Data() testBlock { return e.blk }
+
+func (testSubscription) Unsubscribe() {}
+
+// testContract is a deposit contract with deposits made at the given
+// execution blocks.
+type testContract struct {
+	// deposits are the deposits made at each execution block.
+	deposits map[math.U64][]*testDeposit
+	// failReads is the number of reads that fail before reads succeed.
+	failReads int
+	// dropLogs drops the deposit logs, as a faulty client would.
+	dropLogs bool
+	// reads are the ranges that were read successfully.
+	reads [][2]math.U64
+}
+
+func (c *testContract) ReadDeposits(
+	_ context.Context,
+	from, to math.U64,
+) ([]*testDeposit, error) {
+	if c.failReads > 0 {
+		c.failReads--
+		return nil, errUnavailable
+	}
+	c.reads = append(c.reads, [2]math.U64{from, to})
+	if c.dropLogs {
+		return nil, nil
+	}
+
+	var deposits []*testDeposit
+	for blockNum := from; blockNum <= to; blockNum++ {
+		deposits = append(deposits, c.deposits[blockNum]...)
+	}
+	return deposits, nil
+}
+
+func (c *testContract) ReadDepositCount(
+	_ context.Context,
+	blockNum math.U64,
+) (uint64, error) {
+	var count uint64
+	for num, deposits := range c.deposits {
+		if num <= blockNum {
+			count += uint64(len(deposits))
+		}
+	}
+	return count, nil
+}
+
+// testStore is an in-memory deposit store.
+type testStore struct {
+	deposits   map[uint64]*testDeposit
+	checkpoint *uint64
+	eth1Block  uint64
+}
+
+func newTestStore() *testStore {
+	return &testStore{deposits: make(map[uint64]*testDeposit)}
+}
+
+func (s *testStore) Prune(uint64, uint64) error { return nil }
+
+func (s *testStore) EnqueueDeposits(deposits []*testDeposit) error {
+	for _, deposit := range deposits {
+		s.deposits[deposit.GetIndex()] = deposit
+	}
+	return nil
+}
+
+func (s *testStore) GetDepositCount() (uint64, error) {
+	var count uint64
+	for s.deposits[count] != nil {
+		count++
+	}
+	return count, nil
+}
+
+func (s *testStore) GetCheckpoint() (uint64, bool, error) {
+	if s.checkpoint == nil {
+		return 0, false, nil
+	}
+	return *s.checkpoint, true, nil
+}
+
+func (s *testStore) SetCheckpoint(blockNumber uint64) error {
+	s.checkpoint = &blockNumber
+	return nil
+}
+
+func (s *testStore) SetEth1Block(
+	blockNumber uint64, _ common.ExecutionHash, _ uint64,
+) error {
+	s.eth1Block = blockNumber
+	return nil
+}
+
+// testEthClient returns empty execution blocks.
+type testEthClient struct{}
+
+func (testEthClient) BlockByNumber(
+	_ context.Context,
+	number *big.Int,
+) (*engineprimitives.Block, error) {
+	return coretypes.NewBlockWithHeader(
+		&coretypes.Header{Number: number},
+	), nil
+}
+
+// testSink discards all metrics.
+type testSink struct{}
+
+func (testSink) IncrementCounter(string, ...string) {}
+
+func newTestService(
+	dc *testContract,
+	ds *testStore,
+	target math.U64,
+) *testService {
+	//nolint:mnd // test values.
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:      1,
+		Eth1FollowDistance: 10,
+		ForkSchedule: []chain.ScheduledFork[math.Epoch]{
+			{Version: version.Deneb, Epoch: 0},
+			{Version: version.Electra, Epoch: 5},
+		},
+		DepositRequestsForkEpoch: 5,
+	})
+	s := NewService[
+		testBody, testBlock, testEvent, *testStore, testPayload,
+		testSubscription, [32]byte, *testDeposit,
+	](noop.NewLogger(), cs, testEthClient{}, testSink{}, ds, dc, nil)
+	s.target.Store(uint64(target))
+	return s
+}
+
+func pointer[T any](v T) *T { return &v }
+
+func TestSync_Ranges(t *testing.T) {
+	var (
+		dc = &testContract{deposits: map[math.U64][]*testDeposit{
+			3:    {{index: 0}},
+			1500: {{index: 1}, {index: 2}},
+		}}
+		ds = newTestStore()
+		s  = newTestService(dc, ds, 2500)
+	)
+	ds.checkpoint = pointer[uint64](0)
+
+	require.NoError(t, s.sync(context.Background()))
+	require.Equal(t, [][2]math.U64{
+		{1, 1024}, {1025, 2048}, {2049, 2500},
+	}, dc.reads)
+	require.Len(t, ds.deposits, 3)
+	require.Equal(t, pointer[uint64](2500), ds.checkpoint)
+	require.Equal(t, uint64(2500), ds.eth1Block)
+}
+
+func TestSync_AdaptiveBatchSize(t *testing.T) {
+	var (
+		dc = &testContract{failReads: 2}
+		ds = newTestStore()
+		s  = newTestService(dc, ds, 2000)
+	)
+	ds.checkpoint = pointer[uint64](0)
+
+	// Every failed read halves the batch size and leaves the checkpoint
+	// where it was.
+	for range 2 {
+		require.ErrorIs(t, s.sync(context.Background()), errUnavailable)
+		require.Equal(t, pointer[uint64](0), ds.checkpoint)
+	}
+	require.Equal(t, math.U64(256), s.batchSize)
+
+	// The batch size grows back as reads succeed.
+	require.NoError(t, s.sync(context.Background()))
+	require.Equal(t, [][2]math.U64{
+		{1, 256}, {257, 768}, {769, 1792}, {1793, 2000},
+	}, dc.reads)
+	require.Equal(t, pointer[uint64](2000), ds.checkpoint)
+}
+
+func TestSync_ResumesFromCheckpoint(t *testing.T) {
+	var (
+		dc = &testContract{deposits: map[math.U64][]*testDeposit{
+			1200: {{index: 0}},
+		}}
+		ds = newTestStore()
+	)
+	ds.checkpoint = pointer[uint64](1000)
+
+	// A restarted service picks up where the previous one left off.
+	require.NoError(t, newTestService(dc, ds, 1500).sync(
+		context.Background(),
+	))
+	require.Equal(t, [][2]math.U64{{1001, 1500}}, dc.reads)
+	require.Len(t, ds.deposits, 1)
+	require.Equal(t, pointer[uint64](1500), ds.checkpoint)
+}
+
+func TestSync_BackfillsGap(t *testing.T) {
+	var (
+		dc = &testContract{deposits: map[math.U64][]*testDeposit{
+			10: {{index: 0}},
+			20: {{index: 1}},
+			95: {{index: 2}},
+		}}
+		ds = newTestStore()
+		s  = newTestService(dc, ds, 100)
+	)
+
+	// Without a checkpoint, the syncer starts at its target and backfills
+	// the deposits it finds missing from the block of the first one.
+	require.NoError(t, s.sync(context.Background()))
+	require.Equal(t, [][2]math.U64{{100, 100}, {10, 100}}, dc.reads)
+	require.Len(t, ds.deposits, 3)
+	require.Equal(t, pointer[uint64](100), ds.checkpoint)
+}
+
+func TestSync_MissingLogs(t *testing.T) {
+	var (
+		dc = &testContract{
+			deposits: map[math.U64][]*testDeposit{5: {{index: 0}}},
+			dropLogs: true,
+		}
+		ds = newTestStore()
+		s  = newTestService(dc, ds, 10)
+	)
+	ds.checkpoint = pointer[uint64](0)
+
+	require.ErrorIs(t, s.sync(context.Background()), ErrMissingDepositLogs)
+	require.Equal(t, pointer[uint64](0), ds.checkpoint)
+	require.Zero(t, ds.eth1Block)
+}
+
+func TestUpdateTarget(t *testing.T) {
+	s := newTestService(&testContract{}, newTestStore(), 0)
+	newBlock := func(slot, number math.U64) testBlock {
+		return testBlock{slot: slot, body: testBody{
+			payload: testPayload{number: number},
+		}}
+	}
+
+	// Blocks within the follow distance of the execution genesis are not
+	// synced.
+	s.updateTarget(newBlock(1, 10))
+	require.Zero(t, s.target.Load())
+
+	s.updateTarget(newBlock(2, 50))
+	require.Equal(t, uint64(40), s.target.Load())
+
+	// From the deposit requests fork, logs are only synced up to the block
+	// before the first block of the fork.
+	s.updateTarget(newBlock(5, 60))
+	require.Equal(t, uint64(50), s.target.Load())
+	s.updateTarget(newBlock(6, 100))
+	require.Equal(t, uint64(59), s.target.Load())
+}
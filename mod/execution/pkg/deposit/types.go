@@ -25,6 +25,7 @@ import (
 	"math/big"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -77,11 +78,18 @@ type BlockFeed[
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads the deposits made to the deposit contract in the
+	// execution blocks [from, to].
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
+		from, to math.U64,
 	) ([]DepositT, error)
+	// ReadDepositCount reads the number of deposits made to the deposit
+	// contract as of the given block.
+	ReadDepositCount(
+		ctx context.Context,
+		blockNumber math.U64,
+	) (uint64, error)
 }
 
 // Deposit is an interface for deposits.
@@ -112,6 +120,21 @@ type Store[DepositT any] interface {
 	Prune(index uint64, numPrune uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetDepositCount returns the number of deposits stored without gaps.
+	GetDepositCount() (uint64, error)
+	// GetCheckpoint returns the last execution block whose deposit logs
+	// have been processed, or false if no block has been processed yet.
+	GetCheckpoint() (uint64, bool, error)
+	// SetCheckpoint records the last execution block whose deposit logs
+	// have been processed.
+	SetCheckpoint(blockNumber uint64) error
+	// SetEth1Block records the deposit count of the deposit contract at the
+	// given execution block.
+	SetEth1Block(
+		blockNumber uint64,
+		blockHash common.ExecutionHash,
+		depositCount uint64,
+	) error
 }
 
 type StorageBackend[
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
 )
 
@@ -46,6 +47,9 @@ type Engine[
 	logger log.Logger[any]
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// optimistic tracks the payloads that are not verified by the execution
+	// client yet.
+	optimistic *OptimisticTracker
 }
 
 // New creates a new Engine.
@@ -59,9 +63,10 @@ func New[
 	ts TelemetrySink,
 ) *Engine[ExecutionPayloadT] {
 	return &Engine[ExecutionPayloadT]{
-		ec:      ec,
-		logger:  logger,
-		metrics: newEngineMetrics(ts, logger),
+		ec:         ec,
+		logger:     logger,
+		metrics:    newEngineMetrics(ts, logger),
+		optimistic: NewOptimisticTracker(),
 	}
 }
 
@@ -69,20 +74,35 @@ func New[
 func (ee *Engine[ExecutionPayloadT]) Start(
 	ctx context.Context,
 ) error {
-	go func() {
-		// TODO: handle better
-		if err := ee.ec.Start(ctx); err != nil {
-			panic(err)
-		}
-	}()
+	go ee.startClient(ctx)
 	return nil
 }
 
+// startClient starts the engine client. The engine client keeps health
+// checking and failing over its endpoints, so a failed connection is not
+// fatal. An execution client that cannot serve the engine API calls of the
+// scheduled forks would however fail every block, so the node is stopped.
+func (ee *Engine[ExecutionPayloadT]) startClient(ctx context.Context) {
+	err := ee.ec.Start(ctx)
+	switch {
+	case errors.Is(err, client.ErrUnsupportedEngineMethod):
+		panic(err)
+	case err != nil:
+		ee.logger.Error("failed to start engine client", "err", err)
+	}
+}
+
 // Status returns error if the service is not considered healthy.
 func (ee *Engine[ExecutionPayloadT]) Status() error {
 	return ee.ec.Status()
 }
 
+// OptimisticTracker returns the tracker of the payloads that are not
+// verified by the execution client yet.
+func (ee *Engine[ExecutionPayloadT]) OptimisticTracker() *OptimisticTracker {
+	return ee.optimistic
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
 func (ee *Engine[ExecutionPayloadT]) GetPayload(
 	ctx context.Context,
@@ -122,6 +142,7 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 		engineerrors.ErrAcceptedPayloadStatus,
 		engineerrors.ErrSyncingPayloadStatus,
 	):
+		// The head stays optimistic until it is verified.
 		ee.metrics.markForkchoiceUpdateAcceptedSyncing(req.State, err)
 		return payloadID, nil, nil
 
@@ -136,7 +157,18 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 		engineerrors.ErrInvalidBlockHashPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateInvalid(req.State, err)
-		return payloadID, latestValidHash, ErrBadBlockProduced
+		// The block number of the head is taken from the tracker, if the
+		// head was imported optimistically.
+		ee.optimistic.MarkInvalid(
+			req.State.HeadBlockHash, 0, latestValidHash,
+		)
+		ee.metrics.setOptimisticPayloads(ee.optimistic.Len())
+
+		// The payload status is kept alongside the bad block error so that
+		// callers can recover from the latest valid hash.
+		return payloadID, latestValidHash, errors.Join(
+			ErrBadBlockProduced, err,
+		)
 
 	// JSON-RPC errors are predefined and should be handled as such.
 	case jsonrpc.IsPreDefinedError(err):
@@ -149,6 +181,12 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 		return nil, nil, err
 	}
 
+	// A VALID head verifies all of its optimistic ancestors, while the
+	// payloads that are now finalized over are dropped.
+	ee.optimistic.MarkValid(req.State.HeadBlockHash)
+	ee.pruneOptimistic(ctx, req.State.FinalizedBlockHash)
+	ee.metrics.setOptimisticPayloads(ee.optimistic.Len())
+
 	// If we reached here, and we have a nil payload ID, we should log a
 	// warning.
 	if payloadID == nil && hasPayloadAttributes {
@@ -164,6 +202,29 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 	return payloadID, latestValidHash, nil
 }
 
+// pruneOptimistic prunes the tracked payloads at or below the finalized
+// execution block. The finalized block is only looked up if there are
+// tracked payloads.
+func (ee *Engine[ExecutionPayloadT]) pruneOptimistic(
+	ctx context.Context,
+	finalizedHash common.ExecutionHash,
+) {
+	if ee.optimistic.IsEmpty() || finalizedHash == (common.ExecutionHash{}) {
+		return
+	}
+
+	header, err := ee.ec.HeaderByHash(ctx, finalizedHash)
+	if err != nil {
+		ee.logger.Warn(
+			"failed to get finalized execution block, skipping pruning",
+			"finalized_eth1_hash", finalizedHash,
+			"err", err,
+		)
+		return
+	}
+	ee.optimistic.Prune(math.U64(header.Number.Uint64()))
+}
+
 // VerifyAndNotifyNewPayload verifies the new payload and notifies the
 // execution client.
 func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
@@ -196,7 +257,12 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 
 	// We abstract away some of the complexity and categorize status codes
 	// to make it easier to reason about.
+	blockHash := req.ExecutionPayload.GetBlockHash()
 	switch {
+	case err == nil:
+		ee.optimistic.MarkValid(blockHash)
+		ee.metrics.setOptimisticPayloads(ee.optimistic.Len())
+
 	// If we get accepted or syncing, we are going to optimistically
 	// say that the block is valid, this is utilized during syncing
 	// to allow the beacon-chain to continue processing blocks, while
@@ -207,11 +273,20 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 		engineerrors.ErrSyncingPayloadStatus,
 	):
 		ee.metrics.markNewPayloadAcceptedSyncingPayloadStatus(
-			req.ExecutionPayload.GetBlockHash(),
+			blockHash,
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
 
+		// The payload is tracked until a later new payload or forkchoice
+		// update verifies it.
+		ee.optimistic.MarkOptimistic(
+			blockHash,
+			req.ExecutionPayload.GetParentHash(),
+			req.ExecutionPayload.GetNumber(),
+		)
+		ee.metrics.setOptimisticPayloads(ee.optimistic.Len())
+
 	// These two cases are semantically the same:
 	// https://github.com/ethereum/execution-apis/issues/270
 	case errors.IsAny(
@@ -220,15 +295,19 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 		engineerrors.ErrInvalidBlockHashPayloadStatus,
 	):
 		ee.metrics.markNewPayloadInvalidPayloadStatus(
-			req.ExecutionPayload.GetBlockHash(),
+			blockHash,
 			req.Optimistic,
 		)
+		ee.optimistic.MarkInvalid(
+			blockHash, req.ExecutionPayload.GetNumber(), lastValidHash,
+		)
+		ee.metrics.setOptimisticPayloads(ee.optimistic.Len())
 
 		// We want to return bad block irrespective of
 		// if we are running in optimistic mode or not.
 		//
 		// TODO: should we still nillify the error in optimistic mode?
-		return ErrBadBlockProduced
+		return errors.Join(ErrBadBlockProduced, err)
 
 	case jsonrpc.IsPreDefinedError(err):
 		// Protect against possible nil value.
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/enginetest"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayload is an execution payload that is never sent to the execution
+// client.
+type testPayload struct {
+	ExecutionPayload[*testPayload, *engineprimitives.Withdrawal]
+}
+
+// testSink is a telemetry sink that drops all metrics.
+type testSink struct{}
+
+func (testSink) IncrementCounter(string, ...string)        {}
+func (testSink) SetGauge(string, int64, ...string)         {}
+func (testSink) MeasureSince(string, time.Time, ...string) {}
+
+// newTestEngine returns an engine for an execution client at the given URL
+// that has to serve the given forks.
+func newTestEngine(
+	t *testing.T,
+	rawURL string,
+	secret *jwt.Secret,
+	chainID *big.Int,
+	forkVersions []uint32,
+) *Engine[*testPayload] {
+	t.Helper()
+	dialURL, err := url.NewFromRaw(rawURL)
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+
+	ec := client.New[*testPayload](
+		&cfg, noop.NewLogger(), secret, testSink{}, chainID, forkVersions,
+	)
+	return New(ec, noop.NewLogger(), testSink{})
+}
+
+func TestEngine_StartUnsupportedFork(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	cfg := enginetest.DefaultConfig()
+	cfg.JWTSecret = secret
+	s, err := enginetest.New(cfg, 1_700_000_000)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	// The execution client only serves Deneb, while Electra is scheduled.
+	ee := newTestEngine(
+		t,
+		s.URL(),
+		secret,
+		s.ChainID(),
+		[]uint32{version.Deneb, version.Electra},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var recovered any
+	func() {
+		defer func() { recovered = recover() }()
+		ee.startClient(ctx)
+	}()
+	err, ok := recovered.(error)
+	require.True(t, ok)
+	require.ErrorIs(t, err, client.ErrUnsupportedEngineMethod)
+}
+
+func TestEngine_StartUnreachable(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	ee := newTestEngine(
+		t, "http://127.0.0.1:1", secret, big.NewInt(1), []uint32{version.Deneb},
+	)
+
+	// Failing to connect is retried until the context is done.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 100*time.Millisecond,
+	)
+	t.Cleanup(cancel)
+	require.NotPanics(t, func() { ee.startClient(ctx) })
+}
@@ -246,6 +246,14 @@ func (em *engineMetrics) markForkchoiceUpdateUndefinedError(err error) {
 	)
 }
 
+// setOptimisticPayloads sets the gauge of the payloads that are not verified
+// by the execution client yet.
+func (em *engineMetrics) setOptimisticPayloads(count int) {
+	em.sink.SetGauge(
+		"beacon_kit.execution.engine.optimistic_payloads", int64(count),
+	)
+}
+
 // errorLoggerFn returns a logger fn based on the optimistic flag.
 func (em *engineMetrics) errorLoggerFn(
 	isOptimistic bool,
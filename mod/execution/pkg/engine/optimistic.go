@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// OptimisticTracker tracks the execution payloads that were imported
+// optimistically, i.e. that the execution client accepted with a SYNCING
+// or ACCEPTED status without verifying them yet. A payload stays optimistic
+// until a later new payload or forkchoice update reports it, or one of its
+// descendants, as VALID or INVALID. Payloads at or below the finalized
+// execution block are pruned, since they can no longer be verified or
+// become canonical.
+type OptimisticTracker struct {
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// optimistic maps the hashes of the unverified payloads to their
+	// parent hashes and block numbers.
+	optimistic map[common.ExecutionHash]optimisticPayload
+	// invalid maps the hashes of the payloads reported as INVALID to their
+	// block numbers.
+	invalid map[common.ExecutionHash]math.U64
+}
+
+// optimisticPayload is an unverified payload.
+type optimisticPayload struct {
+	parentHash common.ExecutionHash
+	number     math.U64
+}
+
+// NewOptimisticTracker creates a new OptimisticTracker.
+func NewOptimisticTracker() *OptimisticTracker {
+	return &OptimisticTracker{
+		optimistic: make(map[common.ExecutionHash]optimisticPayload),
+		invalid:    make(map[common.ExecutionHash]math.U64),
+	}
+}
+
+// IsOptimistic returns true if the payload with the given hash was imported
+// optimistically and has not been verified yet.
+func (t *OptimisticTracker) IsOptimistic(hash common.ExecutionHash) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.optimistic[hash]
+	return ok
+}
+
+// IsInvalid returns true if the payload with the given hash was reported as
+// INVALID.
+func (t *OptimisticTracker) IsInvalid(hash common.ExecutionHash) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.invalid[hash]
+	return ok
+}
+
+// Len returns the number of unverified payloads.
+func (t *OptimisticTracker) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.optimistic)
+}
+
+// IsEmpty returns true if no unverified or invalid payload is tracked.
+func (t *OptimisticTracker) IsEmpty() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.optimistic) == 0 && len(t.invalid) == 0
+}
+
+// MarkOptimistic records the payload with the given hash, parent hash and
+// block number as unverified.
+func (t *OptimisticTracker) MarkOptimistic(
+	hash, parentHash common.ExecutionHash,
+	number math.U64,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.invalid[hash]; ok {
+		return
+	}
+	t.optimistic[hash] = optimisticPayload{parentHash, number}
+}
+
+// MarkValid records the payload with the given hash as verified. A VALID
+// payload implies that all of its ancestors are valid too, hence they are
+// no longer optimistic either.
+func (t *OptimisticTracker) MarkValid(hash common.ExecutionHash) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.markValid(hash)
+}
+
+// MarkInvalid records the payload with the given hash as invalid, along
+// with every unverified descendant. If the execution client reported a
+// latest valid hash, the unverified ancestors down to it are invalid too,
+// while the latest valid payload and its ancestors are verified. A zero
+// latest valid hash means that no ancestor is known to be valid. The block
+// number of a tracked payload takes precedence over the given one.
+func (t *OptimisticTracker) MarkInvalid(
+	hash common.ExecutionHash,
+	number math.U64,
+	latestValidHash *common.ExecutionHash,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.optimistic[hash]
+	if ok {
+		number = p.number
+	}
+	delete(t.optimistic, hash)
+	t.invalid[hash] = number
+	for ok && latestValidHash != nil && p.parentHash != *latestValidHash {
+		h := p.parentHash
+		if p, ok = t.optimistic[h]; ok {
+			t.markInvalid(h)
+		}
+	}
+
+	// Descendants of invalid payloads are invalid too.
+	for h := range t.optimistic {
+		if t.hasInvalidAncestor(h) {
+			t.markInvalid(h)
+		}
+	}
+
+	if latestValidHash != nil {
+		t.markValid(*latestValidHash)
+	}
+}
+
+// Prune removes the unverified and invalid payloads at or below the given
+// finalized execution block number.
+func (t *OptimisticTracker) Prune(finalized math.U64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for h, p := range t.optimistic {
+		if p.number <= finalized {
+			delete(t.optimistic, h)
+		}
+	}
+	for h, number := range t.invalid {
+		if number <= finalized {
+			delete(t.invalid, h)
+		}
+	}
+}
+
+// markValid removes the payload with the given hash and its ancestors from
+// the unverified payloads.
+func (t *OptimisticTracker) markValid(hash common.ExecutionHash) {
+	for {
+		p, ok := t.optimistic[hash]
+		if !ok {
+			return
+		}
+		delete(t.optimistic, hash)
+		hash = p.parentHash
+	}
+}
+
+// markInvalid moves the unverified payload with the given hash to the
+// invalid payloads.
+func (t *OptimisticTracker) markInvalid(hash common.ExecutionHash) {
+	t.invalid[hash] = t.optimistic[hash].number
+	delete(t.optimistic, hash)
+}
+
+// hasInvalidAncestor returns true if an ancestor of the unverified payload
+// with the given hash is invalid.
+func (t *OptimisticTracker) hasInvalidAncestor(
+	hash common.ExecutionHash,
+) bool {
+	for {
+		p, ok := t.optimistic[hash]
+		if !ok {
+			return false
+		}
+		if _, ok = t.invalid[p.parentHash]; ok {
+			return true
+		}
+		hash = p.parentHash
+	}
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTracker returns a tracker with an optimistic chain of payloads
+// 1 <- 2 <- 3 and a fork 2 <- 4 on top of the verified payload 0xa at
+// block 0. Payloads 3 and 4 are both at block 3.
+func newTestTracker() *engine.OptimisticTracker {
+	t := engine.NewOptimisticTracker()
+	t.MarkOptimistic(common.ExecutionHash{1}, common.ExecutionHash{0xa}, 1)
+	t.MarkOptimistic(common.ExecutionHash{2}, common.ExecutionHash{1}, 2)
+	t.MarkOptimistic(common.ExecutionHash{3}, common.ExecutionHash{2}, 3)
+	t.MarkOptimistic(common.ExecutionHash{4}, common.ExecutionHash{2}, 3)
+	return t
+}
+
+func TestOptimisticTracker_MarkValid(t *testing.T) {
+	tracker := newTestTracker()
+	require.Equal(t, 4, tracker.Len())
+	require.False(t, tracker.IsOptimistic(common.ExecutionHash{0xa}))
+
+	// A valid payload verifies its ancestors, but not its descendants or
+	// the other forks.
+	tracker.MarkValid(common.ExecutionHash{2})
+	require.False(t, tracker.IsOptimistic(common.ExecutionHash{1}))
+	require.False(t, tracker.IsOptimistic(common.ExecutionHash{2}))
+	require.True(t, tracker.IsOptimistic(common.ExecutionHash{3}))
+	require.True(t, tracker.IsOptimistic(common.ExecutionHash{4}))
+
+	tracker.MarkValid(common.ExecutionHash{3})
+	require.Equal(t, 1, tracker.Len())
+}
+
+func TestOptimisticTracker_MarkInvalid(t *testing.T) {
+	tracker := newTestTracker()
+
+	// The invalid payload, its ancestors down to the latest valid hash and
+	// its descendants are invalid.
+	latestValidHash := common.ExecutionHash{1}
+	tracker.MarkInvalid(common.ExecutionHash{3}, 0, &latestValidHash)
+	require.Zero(t, tracker.Len())
+	require.False(t, tracker.IsInvalid(common.ExecutionHash{1}))
+	require.True(t, tracker.IsInvalid(common.ExecutionHash{2}))
+	require.True(t, tracker.IsInvalid(common.ExecutionHash{3}))
+	require.True(t, tracker.IsInvalid(common.ExecutionHash{4}))
+
+	// Invalid payloads are never optimistic again.
+	tracker.MarkOptimistic(common.ExecutionHash{4}, common.ExecutionHash{2}, 3)
+	require.False(t, tracker.IsOptimistic(common.ExecutionHash{4}))
+}
+
+func TestOptimisticTracker_MarkInvalidZeroLatestValidHash(t *testing.T) {
+	tracker := newTestTracker()
+
+	// A zero latest valid hash invalidates every optimistic ancestor.
+	tracker.MarkInvalid(common.ExecutionHash{4}, 0, &common.ExecutionHash{})
+	require.Zero(t, tracker.Len())
+	for i := byte(1); i <= 4; i++ {
+		require.True(t, tracker.IsInvalid(common.ExecutionHash{i}))
+	}
+	require.False(t, tracker.IsInvalid(common.ExecutionHash{0xa}))
+}
+
+func TestOptimisticTracker_MarkInvalidBlockHash(t *testing.T) {
+	tracker := newTestTracker()
+
+	// Without any latest valid hash, only the payload and its descendants
+	// are invalid.
+	tracker.MarkInvalid(common.ExecutionHash{2}, 0, nil)
+	require.Equal(t, 1, tracker.Len())
+	require.True(t, tracker.IsOptimistic(common.ExecutionHash{1}))
+	require.True(t, tracker.IsInvalid(common.ExecutionHash{3}))
+	require.True(t, tracker.IsInvalid(common.ExecutionHash{4}))
+}
+
+func TestOptimisticTracker_Prune(t *testing.T) {
+	tracker := newTestTracker()
+	tracker.MarkInvalid(common.ExecutionHash{4}, 0, nil)
+	tracker.MarkInvalid(common.ExecutionHash{5}, 4, nil)
+
+	// Payloads at or below the finalized block are dropped, whether they
+	// are unverified or invalid.
+	tracker.Prune(2)
+	require.Equal(t, 1, tracker.Len())
+	require.False(t, tracker.IsOptimistic(common.ExecutionHash{1}))
+	require.False(t, tracker.IsOptimistic(common.ExecutionHash{2}))
+	require.True(t, tracker.IsOptimistic(common.ExecutionHash{3}))
+	require.True(t, tracker.IsInvalid(common.ExecutionHash{4}))
+
+	tracker.Prune(3)
+	require.Zero(t, tracker.Len())
+	require.False(t, tracker.IsInvalid(common.ExecutionHash{4}))
+	require.True(t, tracker.IsInvalid(common.ExecutionHash{5}))
+	require.False(t, tracker.IsEmpty())
+
+	tracker.Prune(4)
+	require.True(t, tracker.IsEmpty())
+}
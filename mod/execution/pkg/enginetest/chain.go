@@ -0,0 +1,488 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginetest
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// gasLimit is the gas limit of every block.
+	gasLimit = 30_000_000
+	// baseFee is the base fee of every block.
+	baseFee = params.GWei
+)
+
+// pendingDeposit is a deposit to be emitted as a log of the deposit contract.
+type pendingDeposit struct {
+	pubkey      crypto.BLSPubkey
+	credentials bytes.B32
+	amount      math.Gwei
+	signature   crypto.BLSSignature
+}
+
+// block is a block known to the server.
+type block struct {
+	*ethtypes.Block
+	// logs are the deposit logs emitted in the block.
+	logs []ethtypes.Log
+	// depositCount is the number of deposits up to and including the block.
+	depositCount uint64
+}
+
+// builtPayload is a payload built by the server.
+type builtPayload struct {
+	envelope *engine.ExecutionPayloadEnvelope
+	// deposits are the pending deposits included in the payload.
+	deposits []pendingDeposit
+}
+
+// chain is the chain of blocks known to the server.
+type chain struct {
+	cfg Config
+	// depositABI is the ABI of the deposit contract.
+	depositABI *abi.ABI
+	// sidecar carries the blobs included in every built payload.
+	sidecar *ethtypes.BlobTxSidecar
+	// key signs the blob transactions of built payloads.
+	key *ecdsa.PrivateKey
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// blocks are the known blocks keyed by hash.
+	blocks map[common.ExecutionHash]*block
+	// canonical are the hashes of the canonical blocks keyed by number.
+	canonical map[uint64]common.ExecutionHash
+	// head, safe and finalized are the forkchoice of the chain.
+	head, safe, finalized common.ExecutionHash
+	// payloads are the built payloads keyed by payload ID.
+	payloads map[engine.PayloadID]*builtPayload
+	// built are the deposits included in built payloads keyed by block
+	// hash.
+	built map[common.ExecutionHash][]pendingDeposit
+	// pending are the deposits not included in a block yet.
+	pending []pendingDeposit
+}
+
+// newChain creates a new chain with a genesis block at the given timestamp.
+func newChain(cfg Config, genesisTime uint64) (*chain, error) {
+	contractABI, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	zero := uint64(0)
+	genesis := ethtypes.NewBlockWithHeader(&ethtypes.Header{
+		UncleHash:        ethtypes.EmptyUncleHash,
+		Root:             ethtypes.EmptyRootHash,
+		TxHash:           ethtypes.EmptyTxsHash,
+		ReceiptHash:      ethtypes.EmptyReceiptsHash,
+		Difficulty:       new(big.Int),
+		Number:           new(big.Int),
+		GasLimit:         gasLimit,
+		Time:             genesisTime,
+		BaseFee:          big.NewInt(baseFee),
+		WithdrawalsHash:  &ethtypes.EmptyWithdrawalsHash,
+		BlobGasUsed:      &zero,
+		ExcessBlobGas:    &zero,
+		ParentBeaconRoot: &common.ExecutionHash{},
+	}).WithBody(ethtypes.Body{Withdrawals: ethtypes.Withdrawals{}})
+
+	sidecar, err := newSidecar(cfg.BlobsPerPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	//#nosec:G703 // a fixed, valid private key.
+	key, _ := ethcrypto.ToECDSA(ethcrypto.Keccak256([]byte("enginetest")))
+	hash := genesis.Hash()
+	return &chain{
+		cfg:        cfg,
+		depositABI: contractABI,
+		sidecar:    sidecar,
+		key:        key,
+		blocks:     map[common.ExecutionHash]*block{hash: {Block: genesis}},
+		canonical:  map[uint64]common.ExecutionHash{0: hash},
+		head:       hash,
+		safe:       hash,
+		finalized:  hash,
+		payloads:   make(map[engine.PayloadID]*builtPayload),
+		built:      make(map[common.ExecutionHash][]pendingDeposit),
+	}, nil
+}
+
+// Genesis returns the genesis block of the server.
+func (s *Server) Genesis() *ethtypes.Block {
+	return s.chain.blockByNumber(0).Block
+}
+
+// Head returns the head block of the server.
+func (s *Server) Head() *ethtypes.Block {
+	s.chain.mu.RLock()
+	defer s.chain.mu.RUnlock()
+	return s.chain.blocks[s.chain.head].Block
+}
+
+// AddDeposit adds a deposit that is emitted by the deposit contract in the
+// next built payload.
+func (s *Server) AddDeposit(
+	pubkey crypto.BLSPubkey,
+	credentials bytes.B32,
+	amount math.Gwei,
+	signature crypto.BLSSignature,
+) {
+	s.chain.mu.Lock()
+	defer s.chain.mu.Unlock()
+	s.chain.pending = append(s.chain.pending, pendingDeposit{
+		pubkey:      pubkey,
+		credentials: credentials,
+		amount:      amount,
+		signature:   signature,
+	})
+}
+
+// blockByHash returns the block with the given hash, or nil if unknown.
+func (c *chain) blockByHash(hash common.ExecutionHash) *block {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.blocks[hash]
+}
+
+// blockByNumber returns the canonical block with the given number, or nil
+// if unknown.
+func (c *chain) blockByNumber(number uint64) *block {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	hash, ok := c.canonical[number]
+	if !ok {
+		return nil
+	}
+	return c.blocks[hash]
+}
+
+// resolve returns the canonical block with the given number or tag, or nil
+// if unknown.
+func (c *chain) resolve(number ethrpc.BlockNumber) *block {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var hash common.ExecutionHash
+	switch number {
+	case ethrpc.LatestBlockNumber, ethrpc.PendingBlockNumber:
+		hash = c.head
+	case ethrpc.SafeBlockNumber:
+		hash = c.safe
+	case ethrpc.FinalizedBlockNumber:
+		hash = c.finalized
+	case ethrpc.EarliestBlockNumber:
+		hash = c.canonical[0]
+	default:
+		if number < 0 {
+			return nil
+		}
+		var ok bool
+		if hash, ok = c.canonical[uint64(number)]; !ok {
+			return nil
+		}
+	}
+	return c.blocks[hash]
+}
+
+// blockRange returns the canonical blocks between the given numbers or
+// tags, inclusive. Both bounds default to the head block.
+func (c *chain) blockRange(from, to *ethrpc.BlockNumber) []*block {
+	latest := ethrpc.LatestBlockNumber
+	if from == nil {
+		from = &latest
+	}
+	if to == nil {
+		to = &latest
+	}
+	first, last := c.resolve(*from), c.resolve(*to)
+	if first == nil || last == nil {
+		return nil
+	}
+
+	blocks := make([]*block, 0)
+	for n := first.NumberU64(); n <= last.NumberU64(); n++ {
+		if blk := c.blockByNumber(n); blk != nil {
+			blocks = append(blocks, blk)
+		}
+	}
+	return blocks
+}
+
+// importBlock adds a valid block to the chain. It returns false if the
+// parent of the block is unknown.
+func (c *chain) importBlock(blk *ethtypes.Block) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.blocks[blk.Hash()]; ok {
+		return true
+	}
+	parent, ok := c.blocks[blk.ParentHash()]
+	if !ok {
+		return false
+	}
+
+	// Emit the logs of the deposits included when the payload was built.
+	imported := &block{Block: blk, depositCount: parent.depositCount}
+	deposits := c.built[blk.Hash()]
+	for i, d := range deposits {
+		log := c.depositLog(blk, d, imported.depositCount)
+		log.Index = uint(i)
+		imported.logs = append(imported.logs, log)
+		imported.depositCount++
+	}
+	c.pending = c.pending[min(len(deposits), len(c.pending)):]
+	delete(c.built, blk.Hash())
+
+	c.blocks[blk.Hash()] = imported
+	return true
+}
+
+// setForkchoice updates the head, safe and finalized blocks. It returns
+// false if the head is unknown.
+func (c *chain) setForkchoice(state *engine.ForkchoiceStateV1) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	head, ok := c.blocks[state.HeadBlockHash]
+	if !ok {
+		return false
+	}
+
+	// Reorg the canonical chain onto the new head.
+	for number := head.NumberU64() + 1; ; number++ {
+		if _, ok = c.canonical[number]; !ok {
+			break
+		}
+		delete(c.canonical, number)
+	}
+	for b := head; b != nil; b = c.blocks[b.ParentHash()] {
+		if c.canonical[b.NumberU64()] == b.Hash() {
+			break
+		}
+		c.canonical[b.NumberU64()] = b.Hash()
+	}
+
+	c.head = state.HeadBlockHash
+	if _, ok = c.blocks[state.SafeBlockHash]; ok {
+		c.safe = state.SafeBlockHash
+	}
+	if _, ok = c.blocks[state.FinalizedBlockHash]; ok {
+		c.finalized = state.FinalizedBlockHash
+	}
+	return true
+}
+
+// buildPayload builds a deterministic payload on top of the given parent.
+func (c *chain) buildPayload(
+	parentHash common.ExecutionHash,
+	attrs *engine.PayloadAttributes,
+) (engine.PayloadID, error) {
+	parent := c.blockByHash(parentHash)
+	if parent == nil {
+		return engine.PayloadID{}, ErrUnknownParent
+	}
+
+	number := parent.NumberU64() + 1
+	txs, sidecars, err := c.blobTransactions(number)
+	if err != nil {
+		return engine.PayloadID{}, err
+	}
+
+	withdrawals := ethtypes.Withdrawals(attrs.Withdrawals)
+	if withdrawals == nil {
+		withdrawals = ethtypes.Withdrawals{}
+	}
+	blobGasUsed := uint64(c.cfg.BlobsPerPayload) * params.BlobTxBlobGasPerBlob
+	excessBlobGas := uint64(0)
+	blk := ethtypes.NewBlock(
+		&ethtypes.Header{
+			ParentHash:       parentHash,
+			Coinbase:         attrs.SuggestedFeeRecipient,
+			Root:             parent.Root(),
+			Difficulty:       new(big.Int),
+			Number:           new(big.Int).SetUint64(number),
+			GasLimit:         gasLimit,
+			Time:             attrs.Timestamp,
+			BaseFee:          big.NewInt(baseFee),
+			MixDigest:        attrs.Random,
+			BlobGasUsed:      &blobGasUsed,
+			ExcessBlobGas:    &excessBlobGas,
+			ParentBeaconRoot: attrs.BeaconRoot,
+		},
+		&ethtypes.Body{Transactions: txs, Withdrawals: withdrawals},
+		nil,
+		trie.NewStackTrie(nil),
+	)
+
+	id, err := payloadID(parentHash, attrs)
+	if err != nil {
+		return engine.PayloadID{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	deposits := append([]pendingDeposit(nil), c.pending...)
+	c.payloads[id] = &builtPayload{
+		envelope: engine.BlockToExecutableData(blk, new(big.Int), sidecars),
+		deposits: deposits,
+	}
+	c.built[blk.Hash()] = deposits
+	return id, nil
+}
+
+// payload returns the payload built with the given ID, or nil if unknown.
+func (c *chain) payload(id engine.PayloadID) *builtPayload {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.payloads[id]
+}
+
+// blobTransactions returns a blob transaction carrying the blobs of the
+// chain for the block with the given number, along with its sidecar.
+func (c *chain) blobTransactions(number uint64) (
+	ethtypes.Transactions, []*ethtypes.BlobTxSidecar, error,
+) {
+	if c.sidecar == nil {
+		return ethtypes.Transactions{}, nil, nil
+	}
+
+	chainID := new(big.Int).SetUint64(c.cfg.ChainID)
+	tx, err := ethtypes.SignNewTx(
+		c.key, ethtypes.NewCancunSigner(chainID), &ethtypes.BlobTx{
+			ChainID:    uint256.MustFromBig(chainID),
+			Nonce:      number,
+			GasTipCap:  uint256.NewInt(params.GWei),
+			GasFeeCap:  uint256.NewInt(2 * baseFee),
+			Gas:        params.TxGas,
+			BlobFeeCap: uint256.NewInt(params.GWei),
+			BlobHashes: c.sidecar.BlobHashes(),
+			Sidecar:    c.sidecar,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ethtypes.Transactions{tx.WithoutBlobTxSidecar()},
+		[]*ethtypes.BlobTxSidecar{c.sidecar}, nil
+}
+
+// newSidecar returns a sidecar of the given number of deterministic blobs,
+// or nil if the number is zero. Computing the KZG proofs is slow, hence
+// the same blobs are included in every payload.
+func newSidecar(blobs int) (*ethtypes.BlobTxSidecar, error) {
+	if blobs == 0 {
+		return nil, nil
+	}
+
+	sidecar := &ethtypes.BlobTxSidecar{}
+	for i := range blobs {
+		blob := newBlob(i)
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+		if err != nil {
+			return nil, err
+		}
+		sidecar.Blobs = append(sidecar.Blobs, *blob)
+		sidecar.Commitments = append(sidecar.Commitments, commitment)
+		sidecar.Proofs = append(sidecar.Proofs, proof)
+	}
+	return sidecar, nil
+}
+
+// newBlob returns a deterministic blob for the given index. The first byte
+// of every field element is left zero to keep it below the BLS modulus.
+func newBlob(index int) *kzg4844.Blob {
+	var blob kzg4844.Blob
+	seed := ethcrypto.Keccak256([]byte{byte(index)})
+	for i := 0; i < len(blob); i += 32 {
+		copy(blob[i+1:i+32], seed)
+		seed = ethcrypto.Keccak256(seed)
+	}
+	return &blob
+}
+
+// payloadID derives a deterministic payload ID from the parent and the
+// payload attributes.
+func payloadID(
+	parentHash common.ExecutionHash,
+	attrs *engine.PayloadAttributes,
+) (engine.PayloadID, error) {
+	encoded, err := rlp.EncodeToBytes([]any{
+		parentHash,
+		attrs.Timestamp,
+		attrs.Random,
+		attrs.SuggestedFeeRecipient,
+		attrs.Withdrawals,
+		attrs.BeaconRoot,
+	})
+	if err != nil {
+		return engine.PayloadID{}, err
+	}
+
+	var id engine.PayloadID
+	copy(id[:], ethcrypto.Keccak256(encoded))
+	id[0] = byte(engine.PayloadV3)
+	return id, nil
+}
+
+// depositLog returns the log emitted by the deposit contract for the given
+// deposit in the given block.
+func (c *chain) depositLog(
+	blk *ethtypes.Block,
+	d pendingDeposit,
+	index uint64,
+) ethtypes.Log {
+	event := c.depositABI.Events["Deposit"]
+	//#nosec:G703 // the arguments always match the event.
+	data, _ := event.Inputs.Pack(
+		d.pubkey[:], d.credentials[:], d.amount.Unwrap(), d.signature[:], index,
+	)
+	return ethtypes.Log{
+		Address:     c.cfg.DepositContractAddress,
+		Topics:      []common.ExecutionHash{event.ID},
+		Data:        data,
+		BlockNumber: blk.NumberU64(),
+		BlockHash:   blk.Hash(),
+	}
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginetest
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+)
+
+// SupportedCapabilities returns the Engine API methods implemented by the
+// server.
+func SupportedCapabilities() []string {
+	return []string{
+		ethclient.NewPayloadMethodV3,
+		ethclient.ForkchoiceUpdatedMethodV3,
+		ethclient.GetPayloadMethodV3,
+	}
+}
+
+// engineAPI implements the "engine" namespace.
+type engineAPI struct {
+	s *Server
+}
+
+// NewPayloadV3 validates the payload and imports it into the chain. A
+// SYNCING or ACCEPTED fault imports the payload but reports the injected
+// status, an INVALID fault rejects it.
+func (api *engineAPI) NewPayloadV3(
+	ctx context.Context,
+	params engine.ExecutableData,
+	versionedHashes []common.ExecutionHash,
+	beaconRoot *common.ExecutionHash,
+) (*engine.PayloadStatusV1, error) {
+	fault, err := api.s.record(ctx, ethclient.NewPayloadMethodV3)
+	if err != nil {
+		return nil, err
+	}
+
+	// The latest valid hash of an invalid payload is its parent, if known.
+	var parentHash *common.ExecutionHash
+	if api.s.chain.blockByHash(params.ParentHash) != nil {
+		parentHash = &params.ParentHash
+	}
+
+	blk, err := engine.ExecutableDataToBlock(
+		params, versionedHashes, beaconRoot,
+	)
+	if err != nil {
+		return invalidStatus(parentHash, err), nil
+	}
+
+	if fault != nil {
+		if fault.Status == engineprimitives.PayloadStatusInvalid {
+			return faultStatus(fault, parentHash), nil
+		}
+		api.s.chain.importBlock(blk)
+		return faultStatus(fault, nil), nil
+	}
+
+	if !api.s.chain.importBlock(blk) {
+		return &engine.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusSyncing,
+		}, nil
+	}
+
+	hash := blk.Hash()
+	return &engine.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusValid,
+		LatestValidHash: &hash,
+	}, nil
+}
+
+// ForkchoiceUpdatedV3 updates the forkchoice of the chain and starts
+// building a payload on top of the head if attributes are given.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	ctx context.Context,
+	state engine.ForkchoiceStateV1,
+	attrs *engine.PayloadAttributes,
+) (*engine.ForkChoiceResponse, error) {
+	fault, err := api.s.record(ctx, ethclient.ForkchoiceUpdatedMethodV3)
+	if err != nil {
+		return nil, err
+	}
+
+	head := api.s.chain.blockByHash(state.HeadBlockHash)
+	if fault != nil {
+		var parentHash *common.ExecutionHash
+		if head != nil {
+			parent := head.ParentHash()
+			parentHash = &parent
+		}
+		return &engine.ForkChoiceResponse{
+			PayloadStatus: *faultStatus(fault, parentHash),
+		}, nil
+	}
+
+	if !api.s.chain.setForkchoice(&state) {
+		return &engine.ForkChoiceResponse{
+			PayloadStatus: engine.PayloadStatusV1{
+				Status: engineprimitives.PayloadStatusSyncing,
+			},
+		}, nil
+	}
+
+	response := &engine.ForkChoiceResponse{
+		PayloadStatus: engine.PayloadStatusV1{
+			Status:          engineprimitives.PayloadStatusValid,
+			LatestValidHash: &state.HeadBlockHash,
+		},
+	}
+	if attrs == nil {
+		return response, nil
+	}
+
+	if attrs.Timestamp <= head.Time() || attrs.Withdrawals == nil {
+		return nil, ErrInvalidPayloadAttributes
+	}
+
+	id, err := api.s.chain.buildPayload(state.HeadBlockHash, attrs)
+	if err != nil {
+		return nil, err
+	}
+	response.PayloadID = &id
+	return response, nil
+}
+
+// GetPayloadV3 returns a payload built by a previous forkchoice update.
+func (api *engineAPI) GetPayloadV3(
+	ctx context.Context,
+	id engine.PayloadID,
+) (*engine.ExecutionPayloadEnvelope, error) {
+	if _, err := api.s.record(ctx, ethclient.GetPayloadMethodV3); err != nil {
+		return nil, err
+	}
+
+	payload := api.s.chain.payload(id)
+	if payload == nil {
+		return nil, ErrUnknownPayload
+	}
+	return payload.envelope, nil
+}
+
+// ExchangeCapabilities returns the configured capabilities.
+func (api *engineAPI) ExchangeCapabilities(
+	ctx context.Context,
+	_ []string,
+) ([]string, error) {
+	if _, err := api.s.record(
+		ctx, ethclient.ExchangeCapabilities,
+	); err != nil {
+		return nil, err
+	}
+	return api.s.cfg.Capabilities, nil
+}
+
+// invalidStatus returns an INVALID payload status for a payload that
+// failed validation.
+func invalidStatus(
+	latestValidHash *common.ExecutionHash,
+	err error,
+) *engine.PayloadStatusV1 {
+	validationErr := err.Error()
+	return &engine.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusInvalid,
+		LatestValidHash: latestValidHash,
+		ValidationError: &validationErr,
+	}
+}
+
+// faultStatus returns the payload status injected by the fault.
+func faultStatus(
+	fault *Fault,
+	latestValidHash *common.ExecutionHash,
+) *engine.PayloadStatusV1 {
+	if fault.Status != engineprimitives.PayloadStatusInvalid {
+		return &engine.PayloadStatusV1{Status: fault.Status}
+	}
+	if fault.LatestValidHash != nil {
+		latestValidHash = fault.LatestValidHash
+	}
+	return invalidStatus(latestValidHash, ErrInjectedFault)
+}
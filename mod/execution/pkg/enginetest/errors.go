@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginetest
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrAlreadyStarted is returned when the server is started twice.
+	ErrAlreadyStarted = errors.New("server already started")
+
+	// ErrMissingJWT is returned when a request has no bearer token.
+	ErrMissingJWT = errors.New("missing jwt token")
+
+	// ErrInvalidJWT is returned when the bearer token of a request is not
+	// signed with the configured secret.
+	ErrInvalidJWT = errors.New("invalid jwt token")
+
+	// ErrStaleJWT is returned when the bearer token of a request was not
+	// issued recently.
+	ErrStaleJWT = errors.New("stale jwt token")
+
+	// ErrUnknownParent is returned when a payload is built on top of an
+	// unknown block.
+	ErrUnknownParent = errors.New("unknown parent block")
+
+	// ErrUnknownBlock is returned when a requested block is not known.
+	ErrUnknownBlock = errors.New("unknown block")
+
+	// ErrUnknownPayload is returned when a payload ID was not returned by a
+	// previous forkchoice update.
+	ErrUnknownPayload = &RPCError{Code: -38001, Message: "Unknown payload"}
+
+	// ErrInvalidPayloadAttributes is returned when the payload attributes of
+	// a forkchoice update cannot be built upon.
+	ErrInvalidPayloadAttributes = &RPCError{
+		Code: -38003, Message: "Invalid payload attributes",
+	}
+
+	// ErrInjectedFault is the validation error of an injected INVALID
+	// payload status.
+	ErrInjectedFault = errors.New("injected fault")
+
+	// ErrUnsupportedCall is returned by eth_call for any call other than
+	// depositCount on the deposit contract.
+	ErrUnsupportedCall = errors.New("unsupported call")
+)
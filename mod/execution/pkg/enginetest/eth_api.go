@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginetest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// filterQuery is the filter of an eth_getLogs request.
+type filterQuery struct {
+	BlockHash *common.ExecutionHash     `json:"blockHash"`
+	FromBlock *ethrpc.BlockNumber       `json:"fromBlock"`
+	ToBlock   *ethrpc.BlockNumber       `json:"toBlock"`
+	Addresses []common.ExecutionAddress `json:"address"`
+	Topics    [][]common.ExecutionHash  `json:"topics"`
+}
+
+// callArgs are the arguments of an eth_call request.
+type callArgs struct {
+	To    *common.ExecutionAddress `json:"to"`
+	Input hexutil.Bytes            `json:"input"`
+	Data  hexutil.Bytes            `json:"data"`
+}
+
+// ethAPI implements the subset of the "eth" namespace used by the node.
+type ethAPI struct {
+	s *Server
+}
+
+// ChainId returns the configured chain ID.
+//
+//nolint:revive,stylecheck // the method name is mandated by the API.
+func (api *ethAPI) ChainId(ctx context.Context) (*hexutil.Big, error) {
+	if _, err := api.s.record(ctx, "eth_chainId"); err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(api.s.ChainID()), nil
+}
+
+// BlockNumber returns the number of the head block.
+func (api *ethAPI) BlockNumber(ctx context.Context) (hexutil.Uint64, error) {
+	if _, err := api.s.record(ctx, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(api.s.Head().NumberU64()), nil
+}
+
+// GetBlockByNumber returns the canonical block with the given number, or
+// nil if unknown.
+func (api *ethAPI) GetBlockByNumber(
+	ctx context.Context,
+	number ethrpc.BlockNumber,
+	fullTx bool,
+) (map[string]any, error) {
+	if _, err := api.s.record(ctx, "eth_getBlockByNumber"); err != nil {
+		return nil, err
+	}
+	blk := api.s.chain.resolve(number)
+	if blk == nil {
+		return nil, nil
+	}
+	return marshalBlock(blk.Block, fullTx)
+}
+
+// GetBlockByHash returns the block with the given hash, or nil if unknown.
+func (api *ethAPI) GetBlockByHash(
+	ctx context.Context,
+	hash common.ExecutionHash,
+	fullTx bool,
+) (map[string]any, error) {
+	if _, err := api.s.record(ctx, "eth_getBlockByHash"); err != nil {
+		return nil, err
+	}
+	blk := api.s.chain.blockByHash(hash)
+	if blk == nil {
+		return nil, nil
+	}
+	return marshalBlock(blk.Block, fullTx)
+}
+
+// GetLogs returns the deposit logs of the canonical blocks matching the
+// filter.
+func (api *ethAPI) GetLogs(
+	ctx context.Context,
+	query filterQuery,
+) ([]ethtypes.Log, error) {
+	if _, err := api.s.record(ctx, "eth_getLogs"); err != nil {
+		return nil, err
+	}
+
+	var blocks []*block
+	if query.BlockHash != nil {
+		if blk := api.s.chain.blockByHash(*query.BlockHash); blk != nil {
+			blocks = append(blocks, blk)
+		}
+	} else {
+		blocks = api.s.chain.blockRange(query.FromBlock, query.ToBlock)
+	}
+
+	logs := make([]ethtypes.Log, 0)
+	for _, blk := range blocks {
+		for _, log := range blk.logs {
+			if query.matches(&log) {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// Call supports calling depositCount on the deposit contract, which returns
+// the number of deposits up to and including the given block.
+func (api *ethAPI) Call(
+	ctx context.Context,
+	args callArgs,
+	number ethrpc.BlockNumberOrHash,
+) (hexutil.Bytes, error) {
+	if _, err := api.s.record(ctx, "eth_call"); err != nil {
+		return nil, err
+	}
+
+	input := args.Input
+	if input == nil {
+		input = args.Data
+	}
+	method := api.s.chain.depositABI.Methods["depositCount"]
+	if args.To == nil || *args.To != api.s.cfg.DepositContractAddress ||
+		!bytes.Equal(input, method.ID) {
+		return nil, ErrUnsupportedCall
+	}
+
+	var blk *block
+	if hash, ok := number.Hash(); ok {
+		blk = api.s.chain.blockByHash(hash)
+	} else if num, ok := number.Number(); ok {
+		blk = api.s.chain.resolve(num)
+	}
+	if blk == nil {
+		return nil, ErrUnknownBlock
+	}
+	return method.Outputs.Pack(blk.depositCount)
+}
+
+// matches returns whether the log matches the addresses and topics of the
+// filter.
+func (q *filterQuery) matches(log *ethtypes.Log) bool {
+	if len(q.Addresses) > 0 && !slices.Contains(q.Addresses, log.Address) {
+		return false
+	}
+	if len(q.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, topics := range q.Topics {
+		if len(topics) > 0 && !slices.Contains(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// marshalBlock returns the JSON-RPC representation of the block.
+func marshalBlock(blk *ethtypes.Block, fullTx bool) (map[string]any, error) {
+	header, err := json.Marshal(blk.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]any)
+	if err = json.Unmarshal(header, &fields); err != nil {
+		return nil, err
+	}
+
+	txs := make([]any, 0, blk.Transactions().Len())
+	for _, tx := range blk.Transactions() {
+		if fullTx {
+			txs = append(txs, tx)
+		} else {
+			txs = append(txs, tx.Hash())
+		}
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []common.ExecutionHash{}
+	fields["withdrawals"] = blk.Withdrawals()
+	fields["size"] = hexutil.Uint64(blk.Size())
+	fields["totalDifficulty"] = (*hexutil.Big)(new(big.Int))
+	return fields, nil
+}
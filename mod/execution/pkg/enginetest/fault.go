@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginetest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// Fault is a failure injected into the responses of a method.
+type Fault struct {
+	// Status is the payload status returned by engine_newPayloadV3 and
+	// engine_forkchoiceUpdatedV3 instead of the actual one, e.g. SYNCING or
+	// INVALID.
+	Status string
+	// LatestValidHash overrides the latest valid hash returned with an
+	// injected INVALID status. The parent of the block is used if unset.
+	LatestValidHash *common.ExecutionHash
+	// Err is returned as a JSON-RPC error instead of a result.
+	Err *RPCError
+	// Delay delays the response, e.g. to trigger client timeouts.
+	Delay time.Duration
+	// Times is the number of calls the fault applies to. The fault applies
+	// until cleared if it is zero.
+	Times int
+}
+
+// RPCError is a JSON-RPC error returned by the server.
+type RPCError struct {
+	// Code is the JSON-RPC error code.
+	Code int
+	// Message is the JSON-RPC error message.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *RPCError) Error() string {
+	return e.Message
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *RPCError) ErrorCode() int {
+	return e.Code
+}
+
+// faults are the faults injected into the server, keyed by method name.
+type faults struct {
+	mu     sync.Mutex
+	faults map[string]*Fault
+}
+
+// newFaults creates a new, empty set of faults.
+func newFaults() *faults {
+	return &faults{faults: make(map[string]*Fault)}
+}
+
+// InjectFault injects a fault into the responses of the given method, e.g.
+// "engine_newPayloadV3". It replaces any fault previously injected into the
+// method.
+func (s *Server) InjectFault(method string, fault Fault) {
+	s.faults.mu.Lock()
+	defer s.faults.mu.Unlock()
+	s.faults.faults[method] = &fault
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.faults.mu.Lock()
+	defer s.faults.mu.Unlock()
+	clear(s.faults.faults)
+}
+
+// apply applies the fault injected into the method, if any. It waits for
+// the fault delay and returns the fault error, or the fault itself if it
+// overrides the payload status.
+func (f *faults) apply(ctx context.Context, method string) (*Fault, error) {
+	f.mu.Lock()
+	fault, ok := f.faults[method]
+	if ok && fault.Times > 0 {
+		if fault.Times--; fault.Times == 0 {
+			delete(f.faults, method)
+		}
+	}
+	f.mu.Unlock()
+
+	if !ok {
+		return nil, nil
+	}
+
+	if fault.Delay > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(fault.Delay):
+		}
+	}
+
+	if fault.Err != nil {
+		return nil, fault.Err
+	}
+
+	if fault.Status == "" {
+		return nil, nil
+	}
+	return fault, nil
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package enginetest provides an in-process execution client that serves
+// the Engine API and the subset of the Ethereum JSON-RPC API used by
+// BeaconKit, for deterministic tests without a real execution client.
+package enginetest
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	gjwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// defaultChainID is the default chain ID of the server.
+	defaultChainID = 80087
+	// jwtIssuedAtLeeway is the maximum difference between the issued at
+	// claim of a JWT token and the current time, as per the Engine API
+	// specification.
+	jwtIssuedAtLeeway = 60 * time.Second
+	// readHeaderTimeout is the timeout for reading request headers.
+	readHeaderTimeout = 5 * time.Second
+)
+
+// Config is the configuration of the server.
+type Config struct {
+	// ChainID is the chain ID returned by eth_chainId.
+	ChainID uint64
+	// JWTSecret is the secret used to authenticate requests. Requests are
+	// not authenticated if it is nil.
+	JWTSecret *jwt.Secret
+	// DepositContractAddress is the address the deposit logs are emitted
+	// from.
+	DepositContractAddress common.ExecutionAddress
+	// BlobsPerPayload is the number of blobs included in every built
+	// payload.
+	BlobsPerPayload int
+	// Capabilities are the Engine API methods returned by
+	// engine_exchangeCapabilities. The implemented methods are returned if
+	// it is empty.
+	Capabilities []string
+}
+
+// DefaultConfig returns the default configuration of the server.
+func DefaultConfig() Config {
+	return Config{
+		ChainID: defaultChainID,
+	}
+}
+
+// Server is an in-process execution client. It builds deterministic
+// payloads on top of a genesis block and allows faults to be injected into
+// its responses.
+type Server struct {
+	// cfg is the configuration of the server.
+	cfg Config
+	// chain is the chain of blocks known to the server.
+	chain *chain
+	// faults are the injected faults keyed by method name.
+	faults *faults
+	// rpc is the JSON-RPC server serving the APIs.
+	rpc *ethrpc.Server
+	// mu protects the fields below.
+	mu sync.Mutex
+	// calls are the methods called on the server, in order.
+	calls []string
+	// httpServer is the HTTP server, nil until the server is started.
+	httpServer *http.Server
+	// listener is the listener of the HTTP server.
+	listener net.Listener
+}
+
+// New creates a new server with a genesis block at the given timestamp.
+func New(cfg Config, genesisTime uint64) (*Server, error) {
+	if cfg.ChainID == 0 {
+		cfg.ChainID = defaultChainID
+	}
+	if len(cfg.Capabilities) == 0 {
+		cfg.Capabilities = SupportedCapabilities()
+	}
+
+	chain, err := newChain(cfg, genesisTime)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		cfg:    cfg,
+		chain:  chain,
+		faults: newFaults(),
+		rpc:    ethrpc.NewServer(),
+	}
+	if err = s.rpc.RegisterName("engine", &engineAPI{s}); err != nil {
+		return nil, err
+	}
+	if err = s.rpc.RegisterName("eth", &ethAPI{s}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Start starts serving HTTP requests on a random local port.
+func (s *Server) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.httpServer != nil {
+		return ErrAlreadyStarted
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	s.httpServer = &http.Server{
+		Handler:           s.authenticate(s.rpc),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		//#nosec:G104 // always returns an error once closed.
+		_ = s.httpServer.Serve(listener)
+	}()
+	return nil
+}
+
+// URL returns the HTTP URL of the server.
+func (s *Server) URL() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return ""
+	}
+	return "http://" + s.listener.Addr().String()
+}
+
+// DialInProc returns an RPC client connected to the server without going
+// through HTTP or JWT authentication.
+func (s *Server) DialInProc() *ethrpc.Client {
+	return ethrpc.DialInProc(s.rpc)
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rpc.Stop()
+	if s.httpServer == nil {
+		return nil
+	}
+	return s.httpServer.Shutdown(context.Background())
+}
+
+// ChainID returns the chain ID of the server.
+func (s *Server) ChainID() *big.Int {
+	return new(big.Int).SetUint64(s.cfg.ChainID)
+}
+
+// Calls returns the methods called on the server, in order.
+func (s *Server) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.calls...)
+}
+
+// record records a call to the given method and applies any fault that was
+// injected for it.
+func (s *Server) record(ctx context.Context, method string) (*Fault, error) {
+	s.mu.Lock()
+	s.calls = append(s.calls, method)
+	s.mu.Unlock()
+	return s.faults.apply(ctx, method)
+}
+
+// authenticate wraps the handler with the JWT authentication of the Engine
+// API.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	if s.cfg.JWTSecret == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := s.verifyJWT(r.Header.Get("Authorization")); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyJWT verifies the bearer token of a request.
+func (s *Server) verifyJWT(header string) error {
+	tokenStr, found := strings.CutPrefix(header, "Bearer ")
+	if !found {
+		return ErrMissingJWT
+	}
+
+	token, err := gjwt.Parse(
+		tokenStr,
+		func(*gjwt.Token) (interface{}, error) {
+			return s.cfg.JWTSecret.Bytes(), nil
+		},
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+		gjwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return errors.Join(ErrInvalidJWT, err)
+	}
+
+	issuedAt, err := token.Claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return ErrInvalidJWT
+	}
+	if time.Since(issuedAt.Time).Abs() > jwtIssuedAtLeeway {
+		return ErrStaleJWT
+	}
+	return nil
+}
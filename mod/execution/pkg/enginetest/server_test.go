@@ -0,0 +1,343 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package enginetest_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/enginetest"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	geth "github.com/ethereum/go-ethereum/ethclient"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const genesisTime = 1_700_000_000
+
+// payload is a Deneb execution payload in the Engine API encoding.
+type payload struct {
+	engine.ExecutableData
+}
+
+func (p *payload) Empty(uint32) *payload {
+	return &payload{}
+}
+
+func (p *payload) Version() uint32 {
+	return version.Deneb
+}
+
+// sink is a telemetry sink that drops all metrics.
+type sink struct{}
+
+func (sink) IncrementCounter(string, ...string)        {}
+func (sink) SetGauge(string, int64, ...string)         {}
+func (sink) MeasureSince(string, time.Time, ...string) {}
+
+// newTestServer starts a server authenticating requests with a random JWT
+// secret and returns it along with the secret.
+func newTestServer(
+	t *testing.T,
+	cfg enginetest.Config,
+) (*enginetest.Server, *jwt.Secret) {
+	t.Helper()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	cfg.JWTSecret = secret
+
+	s, err := enginetest.New(cfg, genesisTime)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	return s, secret
+}
+
+// newTestClient starts an engine client connected to the server over HTTP.
+func newTestClient(
+	t *testing.T,
+	s *enginetest.Server,
+	secret *jwt.Secret,
+) *client.EngineClient[*payload] {
+	t.Helper()
+	dialURL, err := url.NewFromRaw(s.URL())
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+	cfg.RPCTimeout = 500 * time.Millisecond
+
+	c := client.New[*payload](
+		&cfg, noop.NewLogger(), secret, sink{}, s.ChainID(), nil,
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, c.Start(ctx))
+	return c
+}
+
+// buildPayload builds a payload on top of the head of the server.
+func buildPayload(
+	t *testing.T,
+	s *enginetest.Server,
+	c *client.EngineClient[*payload],
+) engineprimitives.BuiltExecutionPayloadEnv[*payload] {
+	t.Helper()
+	head := s.Head()
+	attrs, err := engineprimitives.NewPayloadAttributes(
+		version.Deneb,
+		head.Time()+1,
+		primitives.Bytes32{0x01},
+		common.ExecutionAddress{0x02},
+		[]*engineprimitives.Withdrawal{},
+		primitives.Root{0x03},
+	)
+	require.NoError(t, err)
+
+	headHash := head.Hash()
+	id, _, err := c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      headHash,
+			SafeBlockHash:      headHash,
+			FinalizedBlockHash: headHash,
+		},
+		attrs,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, id)
+
+	env, err := c.GetPayload(context.Background(), *id, version.Deneb)
+	require.NoError(t, err)
+	return env
+}
+
+// importPayload imports the payload and makes it the head of the server.
+func importPayload(
+	t *testing.T,
+	c *client.EngineClient[*payload],
+	env engineprimitives.BuiltExecutionPayloadEnv[*payload],
+) error {
+	t.Helper()
+	commitments := env.GetBlobsBundle().GetCommitments()
+	hashes := make([]common.ExecutionHash, len(commitments))
+	for i, commitment := range commitments {
+		hashes[i] = commitment.ToVersionedHash()
+	}
+
+	parentRoot := primitives.Root{0x03}
+	if _, err := c.NewPayload(
+		context.Background(), env.GetExecutionPayload(), hashes, &parentRoot,
+	); err != nil {
+		return err
+	}
+
+	hash := env.GetExecutionPayload().BlockHash
+	_, _, err := c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      hash,
+			SafeBlockHash:      hash,
+			FinalizedBlockHash: hash,
+		},
+		nil,
+		version.Deneb,
+	)
+	return err
+}
+
+func TestServer_BuildAndImportPayload(t *testing.T) {
+	cfg := enginetest.DefaultConfig()
+	cfg.BlobsPerPayload = 2
+	s, secret := newTestServer(t, cfg)
+	c := newTestClient(t, s, secret)
+
+	env := buildPayload(t, s, c)
+	require.Len(t, env.GetBlobsBundle().GetBlobs(), 2)
+	require.Len(t, env.GetBlobsBundle().GetCommitments(), 2)
+	require.Len(t, env.GetBlobsBundle().GetProofs(), 2)
+	require.Equal(t, s.Genesis().Hash(), env.GetExecutionPayload().ParentHash)
+
+	require.NoError(t, importPayload(t, c, env))
+	require.Equal(t, env.GetExecutionPayload().BlockHash, s.Head().Hash())
+
+	header, err := c.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, s.Head().Hash(), header.Hash())
+
+	// A second server builds the exact same payload.
+	other, otherSecret := newTestServer(t, cfg)
+	otherEnv := buildPayload(t, other, newTestClient(t, other, otherSecret))
+	require.Equal(
+		t,
+		env.GetExecutionPayload().BlockHash,
+		otherEnv.GetExecutionPayload().BlockHash,
+	)
+	require.Equal(
+		t,
+		env.GetBlobsBundle().GetCommitments(),
+		otherEnv.GetBlobsBundle().GetCommitments(),
+	)
+}
+
+func TestServer_InjectedFaults(t *testing.T) {
+	s, secret := newTestServer(t, enginetest.DefaultConfig())
+	c := newTestClient(t, s, secret)
+	env := buildPayload(t, s, c)
+
+	s.InjectFault(
+		ethclient.NewPayloadMethodV3,
+		enginetest.Fault{
+			Status: engineprimitives.PayloadStatusSyncing, Times: 1,
+		},
+	)
+	require.ErrorIs(
+		t, importPayload(t, c, env), engineerrors.ErrSyncingPayloadStatus,
+	)
+
+	latestValidHash := common.ExecutionHash{0x04}
+	s.InjectFault(
+		ethclient.ForkchoiceUpdatedMethodV3,
+		enginetest.Fault{
+			Status:          engineprimitives.PayloadStatusInvalid,
+			LatestValidHash: &latestValidHash,
+			Times:           1,
+		},
+	)
+	_, lvh, err := c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash: env.GetExecutionPayload().BlockHash,
+		},
+		nil,
+		version.Deneb,
+	)
+	require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+	require.Equal(t, &latestValidHash, lvh)
+
+	// The faults are exhausted, the payload is imported.
+	require.NoError(t, importPayload(t, c, env))
+
+	s.InjectFault(
+		ethclient.GetPayloadMethodV3,
+		enginetest.Fault{Err: enginetest.ErrUnknownPayload},
+	)
+	_, err = c.GetPayload(
+		context.Background(), engineprimitives.PayloadID{}, version.Deneb,
+	)
+	require.ErrorIs(t, err, engineerrors.ErrUnknownPayload)
+
+	// The caller's deadline expires before the client's RPC timeout, so
+	// the call fails with the deadline of the caller.
+	s.ClearFaults()
+	s.InjectFault(
+		ethclient.NewPayloadMethodV3,
+		enginetest.Fault{Delay: time.Second},
+	)
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 100*time.Millisecond,
+	)
+	defer cancel()
+	parentRoot := primitives.Root{0x03}
+	_, err = c.NewPayload(ctx, env.GetExecutionPayload(), nil, &parentRoot)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestServer_DepositLogs(t *testing.T) {
+	cfg := enginetest.DefaultConfig()
+	cfg.DepositContractAddress = common.ExecutionAddress{0x05}
+	s, secret := newTestServer(t, cfg)
+	c := newTestClient(t, s, secret)
+
+	s.AddDeposit(
+		crypto.BLSPubkey{0x06},
+		primitives.Bytes32{0x07},
+		math.Gwei(32e9),
+		crypto.BLSSignature{0x08},
+	)
+	require.NoError(t, importPayload(t, c, buildPayload(t, s, c)))
+
+	contract, err := deposit.NewBeaconDepositContract(
+		cfg.DepositContractAddress, geth.NewClient(s.DialInProc()),
+	)
+	require.NoError(t, err)
+
+	head := new(big.Int).Set(s.Head().Number())
+	iter, err := contract.FilterDeposit(&bind.FilterOpts{
+		Start: head.Uint64(),
+		End:   &[]uint64{head.Uint64()}[0],
+	})
+	require.NoError(t, err)
+	require.True(t, iter.Next())
+	require.Equal(t, crypto.BLSPubkey{0x06}, crypto.BLSPubkey(
+		iter.Event.Pubkey,
+	))
+	require.Equal(t, uint64(32e9), iter.Event.Amount)
+	require.Equal(t, uint64(0), iter.Event.Index)
+	require.False(t, iter.Next())
+
+	count, err := contract.DepositCount(&bind.CallOpts{BlockNumber: head})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	count, err = contract.DepositCount(
+		&bind.CallOpts{BlockNumber: big.NewInt(0)},
+	)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestServer_RejectsInvalidJWT(t *testing.T) {
+	s, _ := newTestServer(t, enginetest.DefaultConfig())
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+	token, err := jwt.BuildSignedJWT(other)
+	require.NoError(t, err)
+
+	for _, header := range []http.Header{
+		{},
+		{"Authorization": []string{"Bearer " + token}},
+	} {
+		rpcClient, dialErr := ethrpc.DialOptions(
+			context.Background(), s.URL(), ethrpc.WithHeaders(header),
+		)
+		require.NoError(t, dialErr)
+		_, err = geth.NewClient(rpcClient).ChainID(context.Background())
+		require.ErrorContains(t, err, "401 Unauthorized")
+		rpcClient.Close()
+	}
+}
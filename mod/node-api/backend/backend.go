@@ -24,75 +24,82 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/events"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 )
 
 type Backend struct {
-	getNewStateDB func(context.Context, string) StateDB
+	cs                       primitives.ChainSpec
+	sp                       StateProvider
+	bs                       BlockStore
+	ot                       OptimisticTracker
+	blsToExecutionChangePool *pool.Pool[*types.SignedBLSToExecutionChange]
+	signer                   crypto.BLSSigner
+	broker                   *events.Broker
 }
 
-// TODO: need to add state_id resolver; possible values are: "head" (canonical
-// head in node's view), "genesis", "finalized", "justified", <slot>, <hex
-// encoded stateRoot with 0x prefix>.
 func New(
-	getNewStateDB func(ctx context.Context, stateId string) StateDB,
+	cs primitives.ChainSpec,
+	sp StateProvider,
+	bs BlockStore,
+	ot OptimisticTracker,
+	blsToExecutionChangePool *pool.Pool[*types.SignedBLSToExecutionChange],
+	signer crypto.BLSSigner,
 ) *Backend {
 	return &Backend{
-		getNewStateDB: getNewStateDB,
+		cs:                       cs,
+		sp:                       sp,
+		bs:                       bs,
+		ot:                       ot,
+		blsToExecutionChangePool: blsToExecutionChangePool,
+		signer:                   signer,
+		broker:                   events.NewBroker(events.DefaultBufferSize),
 	}
 }
 
+// StateProvider provides the beacon states that state and block IDs are
+// resolved against.
+type StateProvider interface {
+	// HeadState returns the beacon state at the head of the chain.
+	HeadState(ctx context.Context) (StateDB, error)
+	// StateAtSlot returns the beacon state after the block at the given slot
+	// has been processed. It returns an error wrapping
+	// types.ErrStateNotFound if the state is no longer retained by the node.
+	StateAtSlot(ctx context.Context, slot math.Slot) (StateDB, error)
+}
+
+// BlockStore provides the beacon blocks that are served by the backend.
+type BlockStore interface {
+	// GetBySlot returns the block at the given slot. It returns an error if
+	// the block is not stored by the node.
+	GetBySlot(slot math.Slot) (*types.BeaconBlock, error)
+}
+
+// OptimisticTracker tracks the execution payloads that are not verified by
+// the execution client yet. The responses of the backend are never
+// optimistic if it is nil.
+type OptimisticTracker interface {
+	// IsOptimistic returns true if the payload with the given hash was
+	// imported optimistically and has not been verified yet.
+	IsOptimistic(hash common.ExecutionHash) bool
+}
+
+// StateDB is the read-only view of a beacon state that is served by the
+// backend.
 type StateDB interface {
+	HashTreeRoot() ([32]byte, error)
 	GetGenesisValidatorsRoot() (primitives.Root, error)
 	GetSlot() (math.Slot, error)
-	GetLatestExecutionPayloadHeader() (
-		*types.ExecutionPayloadHeader, error,
-	)
-	SetLatestExecutionPayloadHeader(
-		payloadHeader *types.ExecutionPayloadHeader,
-	) error
-	GetEth1DepositIndex() (uint64, error)
-	SetEth1DepositIndex(
-		index uint64,
-	) error
-	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
-	SetBalance(idx math.ValidatorIndex, balance math.Gwei) error
-	SetSlot(slot math.Slot) error
 	GetFork() (*types.Fork, error)
-	SetFork(fork *types.Fork) error
 	GetLatestBlockHeader() (*types.BeaconBlockHeader, error)
-	SetLatestBlockHeader(header *types.BeaconBlockHeader) error
+	GetLatestExecutionPayloadHeader() (*types.ExecutionPayloadHeader, error)
 	GetBlockRootAtIndex(index uint64) (primitives.Root, error)
 	StateRootAtIndex(index uint64) (primitives.Root, error)
-	GetEth1Data() (*types.Eth1Data, error)
-	SetEth1Data(data *types.Eth1Data) error
-	GetValidators() ([]*types.Validator, error)
-	GetBalances() ([]uint64, error)
-	GetNextWithdrawalIndex() (uint64, error)
-	SetNextWithdrawalIndex(index uint64) error
-	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
-	SetNextWithdrawalValidatorIndex(index math.ValidatorIndex) error
-	GetTotalSlashing() (math.Gwei, error)
-	SetTotalSlashing(total math.Gwei) error
-	GetRandaoMixAtIndex(index uint64) (primitives.Bytes32, error)
-	GetSlashings() ([]uint64, error)
-	SetSlashingAtIndex(index uint64, amount math.Gwei) error
-	GetSlashingAtIndex(index uint64) (math.Gwei, error)
-	GetTotalValidators() (uint64, error)
-	GetTotalActiveBalances(uint64) (math.Gwei, error)
+	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	ValidatorByIndex(index math.ValidatorIndex) (*types.Validator, error)
-	UpdateBlockRootAtIndex(index uint64, root primitives.Root) error
-	UpdateStateRootAtIndex(index uint64, root primitives.Root) error
-	UpdateRandaoMixAtIndex(index uint64, mix primitives.Bytes32) error
-	UpdateValidatorAtIndex(
-		index math.ValidatorIndex,
-		validator *types.Validator,
-	) error
 	ValidatorIndexByPubkey(pubkey crypto.BLSPubkey) (math.ValidatorIndex, error)
-	AddValidator(
-		val *types.Validator,
-	) error
-	GetValidatorsByEffectiveBalance() ([]*types.Validator, error)
 }
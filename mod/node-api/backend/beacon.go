@@ -33,34 +33,33 @@ import (
 
 func (h Backend) GetGenesis(ctx context.Context) (primitives.Root, error) {
 	// needs genesis_time and gensis_fork_version
-	return h.getNewStateDB(ctx, "stateID").GetGenesisValidatorsRoot()
+	st, err := h.sp.HeadState(ctx)
+	if err != nil {
+		return primitives.Root{}, err
+	}
+	return st.GetGenesisValidatorsRoot()
 }
 
 func (h Backend) GetStateRoot(
 	ctx context.Context,
 	stateID string,
 ) (primitives.Bytes32, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
-	slot, err := stateDB.GetSlot()
-	if err != nil {
-		return primitives.Bytes32{}, err
-	}
-	block, err := stateDB.StateRootAtIndex(slot.Unwrap())
+	stateDB, err := h.stateFromID(ctx, stateID)
 	if err != nil {
 		return primitives.Bytes32{}, err
 	}
-	root, err := block.HashTreeRoot()
-	if err != nil {
-		return primitives.Bytes32{}, err
-	}
-	return root, nil
+	return stateRoot(stateDB)
 }
 
 func (h Backend) GetStateFork(
 	ctx context.Context,
 	stateID string,
 ) (*types.Fork, error) {
-	return h.getNewStateDB(ctx, stateID).GetFork()
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	return stateDB.GetFork()
 }
 
 func (h Backend) GetStateValidators(
@@ -69,7 +68,10 @@ func (h Backend) GetStateValidators(
 	id []string,
 	_ []string,
 ) ([]*serverType.ValidatorData, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
 	validators := make([]*serverType.ValidatorData, 0)
 	for _, indexOrKey := range id {
 		index, indexErr := getValidatorIndex(stateDB, indexOrKey)
@@ -115,7 +117,10 @@ func (h Backend) GetStateValidator(
 	stateID string,
 	validatorID string,
 ) (*serverType.ValidatorData, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
 	index, indexErr := getValidatorIndex(stateDB, validatorID)
 	if indexErr != nil {
 		return nil, indexErr
@@ -141,7 +146,10 @@ func (h Backend) GetStateValidatorBalances(
 	stateID string,
 	id []string,
 ) ([]*serverType.ValidatorBalanceData, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
 	balances := make([]*serverType.ValidatorBalanceData, 0)
 	for _, indexOrKey := range id {
 		index, indexErr := getValidatorIndex(stateDB, indexOrKey)
@@ -162,20 +170,11 @@ func (h Backend) GetStateValidatorBalances(
 
 func (h Backend) GetBlockRoot(
 	ctx context.Context,
-	_ string,
+	blockID string,
 ) (primitives.Bytes32, error) {
-	stateDB := h.getNewStateDB(ctx, "stateID")
-	slot, err := stateDB.GetSlot()
-	if err != nil {
-		return primitives.Bytes32{}, err
-	}
-	block, err := stateDB.GetBlockRootAtIndex(slot.Unwrap())
-	if err != nil {
-		return primitives.Bytes32{}, err
-	}
-	root, err := block.HashTreeRoot()
+	stateDB, err := h.blockStateFromID(ctx, blockID)
 	if err != nil {
 		return primitives.Bytes32{}, err
 	}
-	return root, nil
+	return blockRoot(stateDB)
 }
@@ -24,17 +24,24 @@ import (
 	"context"
 	"testing"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGetGenesisValidatorsRoot(t *testing.T) {
 	sdb := &mocks.StateDB{}
-	b := backend.New(func(context.Context, string) backend.StateDB {
-		return sdb
-	})
+	b := backend.New(
+		newTestChainSpec(8),
+		&testStateProvider{states: []backend.StateDB{sdb}},
+		testBlockStore{},
+		nil,
+		pool.New[*types.SignedBLSToExecutionChange](),
+		nil,
+	)
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(primitives.Root{0x01}, nil)
 	root, err := b.GetGenesis(context.Background())
 	require.NoError(t, err)
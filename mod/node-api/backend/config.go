@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetSpec returns the values of the chain spec, keyed by their upper snake
+// case names.
+func (h Backend) GetSpec(context.Context) (map[string]string, error) {
+	return h.cs.Values(), nil
+}
+
+// GetForkSchedule returns the forks of the chain in the order they are
+// scheduled. The genesis fork has no prior fork, so its previous version is
+// its own version.
+func (h Backend) GetForkSchedule(
+	context.Context,
+) ([]*serverType.ForkData, error) {
+	var (
+		schedule = h.cs.ForkSchedule()
+		forks    = make([]*serverType.ForkData, len(schedule))
+		previous = schedule[0]
+	)
+	for i, fork := range schedule {
+		forks[i] = &serverType.ForkData{
+			PreviousVersion: version.FromUint32[primitives.Version](
+				previous.Version,
+			),
+			CurrentVersion: version.FromUint32[primitives.Version](
+				fork.Version,
+			),
+			Epoch: fork.Epoch.Unwrap(),
+		}
+		previous = fork
+	}
+	return forks, nil
+}
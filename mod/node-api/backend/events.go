@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/events"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// SubscribeEvents subscribes to the events of the given topics.
+func (h Backend) SubscribeEvents(
+	topics []string,
+) (*events.Subscription, error) {
+	return h.broker.Subscribe(topics...)
+}
+
+// PublishBlock publishes the events of a finalized block. CometBFT provides
+// single slot finality, so the block is both the new head and the new
+// finalized checkpoint of the chain.
+func (h Backend) PublishBlock(blk *types.BeaconBlock) error {
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	var (
+		slot       = blk.GetSlot()
+		stateRoot  = blk.GetStateRoot()
+		optimistic bool
+	)
+	if payload := blk.GetBody().GetExecutionPayload(); !payload.IsNil() {
+		optimistic = h.payloadOptimistic(payload.GetBlockHash())
+	}
+
+	h.broker.Publish(events.Event{
+		Topic: events.TopicBlock,
+		Data: &serverType.BlockEventData{
+			Slot:                slot.Unwrap(),
+			Block:               root,
+			ExecutionOptimistic: optimistic,
+		},
+	})
+	for i, commitment := range blk.GetBody().GetBlobKzgCommitments() {
+		h.broker.Publish(events.Event{
+			Topic: events.TopicBlobSidecar,
+			Data: &serverType.BlobSidecarEventData{
+				BlockRoot:     root,
+				Index:         uint64(i),
+				Slot:          slot.Unwrap(),
+				KZGCommitment: commitment,
+				VersionedHash: commitment.ToVersionedHash(),
+			},
+		})
+	}
+	h.broker.Publish(events.Event{
+		Topic: events.TopicHead,
+		Data: &serverType.HeadEventData{
+			Slot:                slot.Unwrap(),
+			Block:               root,
+			State:               stateRoot,
+			EpochTransition:     slot.Unwrap()%h.cs.SlotsPerEpoch() == 0,
+			ExecutionOptimistic: optimistic,
+		},
+	})
+	h.broker.Publish(events.Event{
+		Topic: events.TopicFinalizedCheckpoint,
+		Data: &serverType.FinalizedCheckpointEventData{
+			Block:               root,
+			State:               stateRoot,
+			Epoch:               h.cs.SlotToEpoch(slot).Unwrap(),
+			ExecutionOptimistic: optimistic,
+		},
+	})
+	return nil
+}
+
+// PublishPayloadAttributes publishes the payload attributes that were sent to
+// the execution client. CometBFT selects the proposer of a slot outside of
+// the beacon state, so the attributes do not carry a proposer index.
+func (h Backend) PublishPayloadAttributes(
+	event *engineprimitives.PayloadAttributesEvent,
+) {
+	attrs := event.Attributes
+	withdrawals := make([]*serverType.WithdrawalData, 0, len(attrs.Withdrawals))
+	for _, w := range attrs.Withdrawals {
+		withdrawals = append(withdrawals, &serverType.WithdrawalData{
+			Index:          w.GetIndex().Unwrap(),
+			ValidatorIndex: w.GetValidatorIndex().Unwrap(),
+			Address:        w.GetAddress(),
+			Amount:         w.GetAmount().Unwrap(),
+		})
+	}
+
+	h.broker.Publish(events.Event{
+		Topic: events.TopicPayloadAttributes,
+		Data: &serverType.PayloadAttributesEventData{
+			Version: forkName(attrs.Version()),
+			Data: &serverType.PayloadAttributesData{
+				ProposalSlot:      event.ProposalSlot.Unwrap(),
+				ParentBlockNumber: event.ParentBlockNumber.Unwrap(),
+				ParentBlockRoot:   event.ParentBlockRoot,
+				ParentBlockHash:   event.ParentBlockHash,
+				PayloadAttributes: &serverType.PayloadAttributesV3{
+					Timestamp:             attrs.Timestamp.Unwrap(),
+					PrevRandao:            attrs.PrevRandao,
+					SuggestedFeeRecipient: attrs.SuggestedFeeRecipient,
+					Withdrawals:           withdrawals,
+					ParentBeaconBlockRoot: attrs.ParentBeaconBlockRoot,
+				},
+			},
+		},
+	})
+}
+
+// forkName returns the name of the fork with the given version, as used by
+// the Beacon Node API.
+func forkName(v uint32) string {
+	switch v {
+	case version.Phase0:
+		return "phase0"
+	case version.Altair:
+		return "altair"
+	case version.Bellatrix:
+		return "bellatrix"
+	case version.Capella:
+		return "capella"
+	case version.Deneb:
+		return "deneb"
+	case version.Electra:
+		return "electra"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+var errBlockNotStored = errors.New("block not stored")
+
+// testStateProvider serves the states of a chain by slot, with the state at
+// the highest slot as the head.
+type testStateProvider struct {
+	states []backend.StateDB
+}
+
+func (p *testStateProvider) HeadState(
+	context.Context,
+) (backend.StateDB, error) {
+	return p.states[len(p.states)-1], nil
+}
+
+func (p *testStateProvider) StateAtSlot(
+	_ context.Context,
+	slot math.Slot,
+) (backend.StateDB, error) {
+	if slot.Unwrap() >= uint64(len(p.states)) {
+		return nil, serverType.ErrStateNotFound
+	}
+	return p.states[slot], nil
+}
+
+// testBlockStore serves the blocks of a chain by slot.
+type testBlockStore map[math.Slot]*types.BeaconBlock
+
+func (s testBlockStore) GetBySlot(
+	slot math.Slot,
+) (*types.BeaconBlock, error) {
+	blk, ok := s[slot]
+	if !ok {
+		return nil, errBlockNotStored
+	}
+	return blk, nil
+}
+
+// newTestChainSpec returns a chain spec that retains the roots of the given
+// number of historical slots.
+func newTestChainSpec(slotsPerHistoricalRoot uint64) primitives.ChainSpec {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerHistoricalRoot:      slotsPerHistoricalRoot,
+		WhistleblowerRewardQuotient: 512,
+	})
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// StateExecutionOptimistic returns true if the latest execution payload of
+// the state with the given ID is not verified by the execution client yet.
+func (h Backend) StateExecutionOptimistic(
+	ctx context.Context,
+	stateID string,
+) (bool, error) {
+	st, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return false, err
+	}
+	return h.stateOptimistic(st)
+}
+
+// BlockExecutionOptimistic returns true if the execution payload of the
+// block with the given ID is not verified by the execution client yet.
+func (h Backend) BlockExecutionOptimistic(
+	ctx context.Context,
+	blockID string,
+) (bool, error) {
+	st, err := h.blockStateFromID(ctx, blockID)
+	if err != nil {
+		return false, err
+	}
+	return h.stateOptimistic(st)
+}
+
+// stateOptimistic returns true if the latest execution payload of the given
+// state is not verified by the execution client yet.
+func (h Backend) stateOptimistic(st StateDB) (bool, error) {
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return false, err
+	}
+	if header == nil || header.InnerExecutionPayloadHeader == nil ||
+		header.IsNil() {
+		return false, nil
+	}
+	return h.payloadOptimistic(header.GetBlockHash()), nil
+}
+
+// payloadOptimistic returns true if the execution payload with the given
+// hash is not verified by the execution client yet.
+func (h Backend) payloadOptimistic(hash common.ExecutionHash) bool {
+	return h.ot != nil && h.ot.IsOptimistic(hash)
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
+	"github.com/stretchr/testify/require"
+)
+
+// testOptimisticTracker tracks a fixed set of optimistic payloads.
+type testOptimisticTracker map[common.ExecutionHash]struct{}
+
+func (t testOptimisticTracker) IsOptimistic(hash common.ExecutionHash) bool {
+	_, ok := t[hash]
+	return ok
+}
+
+func TestExecutionOptimistic(t *testing.T) {
+	// The payload of slot 1 is verified, the payload of the head is not.
+	states := make([]backend.StateDB, 3)
+	for slot := range states {
+		sdb := &mocks.StateDB{}
+		sdb.EXPECT().GetSlot().Return(math.Slot(slot), nil)
+		sdb.EXPECT().GetLatestExecutionPayloadHeader().Return(
+			&types.ExecutionPayloadHeader{
+				InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+					BlockHash: common.ExecutionHash{byte(slot)},
+				},
+			}, nil,
+		)
+		states[slot] = sdb
+	}
+	b := backend.New(
+		newTestChainSpec(8),
+		&testStateProvider{states: states},
+		testBlockStore{},
+		testOptimisticTracker{common.ExecutionHash{2}: {}},
+		pool.New[*types.SignedBLSToExecutionChange](),
+		nil,
+	)
+
+	optimistic, err := b.StateExecutionOptimistic(context.Background(), "head")
+	require.NoError(t, err)
+	require.True(t, optimistic)
+	optimistic, err = b.BlockExecutionOptimistic(context.Background(), "2")
+	require.NoError(t, err)
+	require.True(t, optimistic)
+	optimistic, err = b.StateExecutionOptimistic(context.Background(), "1")
+	require.NoError(t, err)
+	require.False(t, optimistic)
+	optimistic, err = b.BlockExecutionOptimistic(context.Background(), "1")
+	require.NoError(t, err)
+	require.False(t, optimistic)
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+var (
+	// errWithdrawalCredentialsMismatch is returned when a BLS to execution
+	// change does not match the withdrawal credentials of its validator.
+	errWithdrawalCredentialsMismatch = errors.New(
+		"withdrawal credentials do not match the bls to execution change",
+	)
+	// errBLSToExecutionChangePending is returned when a BLS to execution
+	// change is already pending for the validator.
+	errBLSToExecutionChangePending = errors.New(
+		"bls to execution change already pending for the validator",
+	)
+)
+
+func (h Backend) GetBLSToExecutionChanges(
+	_ context.Context,
+) ([]*types.SignedBLSToExecutionChange, error) {
+	return h.blsToExecutionChangePool.Pending(), nil
+}
+
+// SubmitBLSToExecutionChanges checks the changes against the head state and
+// adds them to the pool. None of the changes are added if any of them does
+// not apply to the head state, is not signed by the BLS key of its
+// validator or if a change is already pending for its validator.
+func (h Backend) SubmitBLSToExecutionChanges(
+	ctx context.Context,
+	changes []*types.SignedBLSToExecutionChange,
+) error {
+	stateDB, err := h.sp.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	genesisValidatorsRoot, err := stateDB.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	// The changes are signed over the genesis fork version.
+	forkData := types.NewForkData(
+		version.FromUint32[primitives.Version](
+			h.cs.ActiveForkVersionForEpoch(
+				math.Epoch(constants.GenesisEpoch),
+			),
+		), genesisValidatorsRoot,
+	)
+	for i, change := range changes {
+		var validator *types.Validator
+		validator, err = stateDB.ValidatorByIndex(change.GetValidatorIndex())
+		if err != nil {
+			return fmt.Errorf("change %d: %w", i, err)
+		}
+		if validator.GetWithdrawalCredentials() !=
+			change.GetFromWithdrawalCredentials() {
+			return fmt.Errorf(
+				"change %d: %w", i, errWithdrawalCredentialsMismatch,
+			)
+		}
+		if err = change.VerifySignature(
+			forkData,
+			h.cs.DomainTypeBLSToExecutionChange(),
+			h.signer.VerifySignature,
+		); err != nil {
+			return fmt.Errorf("change %d: %w", i, err)
+		}
+	}
+
+	for i, change := range changes {
+		if h.blsToExecutionChangePool.Insert(change) {
+			continue
+		}
+
+		// The changes of the request that were already added are removed,
+		// as the pool held no change for their validators before.
+		added := make([]math.ValidatorIndex, 0, i)
+		for _, c := range changes[:i] {
+			added = append(added, c.GetValidatorIndex())
+		}
+		h.blsToExecutionChangePool.Remove(added...)
+		return fmt.Errorf("change %d: %w", i, errBLSToExecutionChangePending)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBLSToExecutionChanges(t *testing.T) {
+	var (
+		sdb    = &mocks.StateDB{}
+		signer = &cryptomocks.BLSSigner{}
+		p      = pool.New[*types.SignedBLSToExecutionChange]()
+		b      = backend.New(
+			newTestChainSpec(8),
+			&testStateProvider{states: []backend.StateDB{sdb}},
+			testBlockStore{},
+			nil,
+			p,
+			signer,
+		)
+		pubkey     = crypto.BLSPubkey{0x01}
+		invalidSig = crypto.BLSSignature{0xff}
+		change     = func(
+			idx math.ValidatorIndex, sig crypto.BLSSignature,
+		) *types.SignedBLSToExecutionChange {
+			return &types.SignedBLSToExecutionChange{
+				Message: &types.BLSToExecutionChange{
+					ValidatorIndex:     idx,
+					FromBLSPubkey:      pubkey,
+					ToExecutionAddress: common.ExecutionAddress{0x02},
+				},
+				Signature: sig,
+			}
+		}
+		blsValidator = &types.Validator{
+			WithdrawalCredentials: types.NewCredentialsFromBLSPubkey(pubkey),
+		}
+	)
+	sdb.EXPECT().GetGenesisValidatorsRoot().Return(common.Root{0x01}, nil)
+	sdb.EXPECT().ValidatorByIndex(math.ValidatorIndex(0)).Return(
+		blsValidator, nil,
+	)
+	sdb.EXPECT().ValidatorByIndex(math.ValidatorIndex(1)).Return(
+		&types.Validator{
+			WithdrawalCredentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x02},
+			),
+		}, nil,
+	)
+	sdb.EXPECT().ValidatorByIndex(math.ValidatorIndex(2)).Return(
+		blsValidator, nil,
+	)
+	signer.EXPECT().VerifySignature(
+		pubkey, mock.Anything, invalidSig,
+	).Return(errors.New("invalid signature"))
+	signer.EXPECT().VerifySignature(
+		pubkey, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	// None of the changes are added if one of them does not apply.
+	require.Error(t, b.SubmitBLSToExecutionChanges(
+		context.Background(),
+		[]*types.SignedBLSToExecutionChange{
+			change(0, crypto.BLSSignature{}), change(1, crypto.BLSSignature{}),
+		},
+	))
+	require.Zero(t, p.Len())
+
+	// Changes that are not signed by the BLS key of the validator are not
+	// added.
+	require.Error(t, b.SubmitBLSToExecutionChanges(
+		context.Background(),
+		[]*types.SignedBLSToExecutionChange{change(0, invalidSig)},
+	))
+	require.Zero(t, p.Len())
+
+	require.NoError(t, b.SubmitBLSToExecutionChanges(
+		context.Background(),
+		[]*types.SignedBLSToExecutionChange{change(0, crypto.BLSSignature{})},
+	))
+	changes, err := b.GetBLSToExecutionChanges(context.Background())
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*types.SignedBLSToExecutionChange{change(0, crypto.BLSSignature{})},
+		changes,
+	)
+
+	// A change for a validator that already has a pending change is
+	// rejected, along with the other changes of the request.
+	require.Error(t, b.SubmitBLSToExecutionChanges(
+		context.Background(),
+		[]*types.SignedBLSToExecutionChange{
+			change(2, crypto.BLSSignature{}), change(0, crypto.BLSSignature{1}),
+		},
+	))
+	changes, err = b.GetBLSToExecutionChanges(context.Background())
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*types.SignedBLSToExecutionChange{change(0, crypto.BLSSignature{})},
+		changes,
+	)
+}
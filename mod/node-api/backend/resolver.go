@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// The named state and block IDs of the Beacon Node API. CometBFT provides
+// single slot finality, so the finalized and justified checkpoints are always
+// the head of the chain.
+const (
+	idHead      = "head"
+	idGenesis   = "genesis"
+	idFinalized = "finalized"
+	idJustified = "justified"
+)
+
+// stateFromID resolves the given state ID to a beacon state. The state ID is
+// one of "head", "genesis", "finalized", "justified", a decimal slot or a hex
+// encoded state root with a 0x prefix.
+func (h Backend) stateFromID(
+	ctx context.Context,
+	stateID string,
+) (StateDB, error) {
+	switch stateID {
+	case idHead, idFinalized, idJustified:
+		return h.sp.HeadState(ctx)
+	case idGenesis:
+		return h.stateAtSlot(ctx, 0)
+	}
+
+	if strings.HasPrefix(stateID, "0x") {
+		root, err := parseRoot(stateID)
+		if err != nil {
+			return nil, serverType.ErrInvalidStateID
+		}
+		return h.stateByRoot(ctx, root, StateDB.StateRootAtIndex, stateRoot)
+	}
+
+	slot, err := strconv.ParseUint(stateID, 10, 64)
+	if err != nil {
+		return nil, serverType.ErrInvalidStateID
+	}
+	return h.stateAtSlot(ctx, math.Slot(slot))
+}
+
+// blockStateFromID resolves the given block ID to the beacon state after the
+// block has been processed. The block ID is one of "head", "genesis",
+// "finalized", a decimal slot or a hex encoded block root with a 0x prefix.
+func (h Backend) blockStateFromID(
+	ctx context.Context,
+	blockID string,
+) (StateDB, error) {
+	switch blockID {
+	case idHead, idFinalized:
+		return h.sp.HeadState(ctx)
+	case idGenesis:
+		return h.blockStateAtSlot(ctx, 0)
+	}
+
+	if strings.HasPrefix(blockID, "0x") {
+		root, err := parseRoot(blockID)
+		if err != nil {
+			return nil, serverType.ErrInvalidBlockID
+		}
+		st, err := h.stateByRoot(
+			ctx, root, StateDB.GetBlockRootAtIndex, blockRoot,
+		)
+		if err != nil {
+			return nil, toBlockNotFound(err)
+		}
+		return st, nil
+	}
+
+	slot, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		return nil, serverType.ErrInvalidBlockID
+	}
+	return h.blockStateAtSlot(ctx, math.Slot(slot))
+}
+
+// blockStateAtSlot returns the beacon state after the block at the given slot
+// has been processed.
+func (h Backend) blockStateAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (StateDB, error) {
+	st, err := h.stateAtSlot(ctx, slot)
+	if err != nil {
+		return nil, toBlockNotFound(err)
+	}
+	return st, nil
+}
+
+// stateAtSlot returns the beacon state at the given slot. Slots beyond the
+// head of the chain are not found.
+func (h Backend) stateAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (StateDB, error) {
+	head, err := h.sp.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headSlot, err := head.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case slot > headSlot:
+		return nil, serverType.ErrStateNotFound
+	case slot == headSlot:
+		return head, nil
+	default:
+		return h.sp.StateAtSlot(ctx, slot)
+	}
+}
+
+// stateByRoot returns the beacon state whose root, as computed by rootOf,
+// matches the given root. The roots of past slots are looked up in the
+// historical roots of the head state, so only states within the last
+// SlotsPerHistoricalRoot slots can be found.
+func (h Backend) stateByRoot(
+	ctx context.Context,
+	root primitives.Root,
+	rootAtIndex func(StateDB, uint64) (primitives.Root, error),
+	rootOf func(StateDB) (primitives.Root, error),
+) (StateDB, error) {
+	head, err := h.sp.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headRoot, err := rootOf(head)
+	if err != nil {
+		return nil, err
+	}
+	if headRoot == root {
+		return head, nil
+	}
+
+	headSlot, err := head.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	historyLen := h.cs.SlotsPerHistoricalRoot()
+	for i := uint64(1); i <= min(historyLen, headSlot.Unwrap()); i++ {
+		slot := headSlot.Unwrap() - i
+		var historicalRoot primitives.Root
+		historicalRoot, err = rootAtIndex(head, slot%historyLen)
+		if err != nil {
+			return nil, err
+		}
+		if historicalRoot == root {
+			return h.sp.StateAtSlot(ctx, math.Slot(slot))
+		}
+	}
+	return nil, serverType.ErrStateNotFound
+}
+
+// stateRoot returns the root of the given beacon state.
+func stateRoot(st StateDB) (primitives.Root, error) {
+	return st.HashTreeRoot()
+}
+
+// blockRoot returns the root of the latest block processed by the given
+// beacon state. The state root of the latest block header is only filled in
+// when the next slot is processed, so it is set to the root of the state.
+func blockRoot(st StateDB) (primitives.Root, error) {
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return primitives.Root{}, err
+	}
+	if header == nil {
+		return primitives.Root{}, serverType.ErrBlockNotFound
+	}
+	if (header.GetStateRoot() == primitives.Root{}) {
+		var root primitives.Root
+		if root, err = st.HashTreeRoot(); err != nil {
+			return primitives.Root{}, err
+		}
+		// Copy the header so that the state is left untouched.
+		headerCopy := *header
+		headerCopy.SetStateRoot(root)
+		header = &headerCopy
+	}
+	return header.HashTreeRoot()
+}
+
+// parseRoot parses a hex encoded root with a 0x prefix.
+func parseRoot(s string) (primitives.Root, error) {
+	var root primitives.Root
+	if err := root.UnmarshalText([]byte(s)); err != nil {
+		return primitives.Root{}, err
+	}
+	return root, nil
+}
+
+// toBlockNotFound maps a state that is not found to a block that is not
+// found, as a block is served from the state it resulted in.
+func toBlockNotFound(err error) error {
+	if errors.Is(err, serverType.ErrStateNotFound) {
+		return serverType.ErrBlockNotFound
+	}
+	return err
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestChain returns a backend over a chain with a state at each of the
+// given number of slots. The head state retains the roots of the last 4
+// slots, so the roots of slot 0 are no longer known.
+func newTestChain(
+	t *testing.T,
+	numSlots int,
+) (*backend.Backend, []primitives.Root, []primitives.Root) {
+	t.Helper()
+	const slotsPerHistoricalRoot = 4
+
+	var (
+		states     = make([]backend.StateDB, numSlots)
+		stateRoots = make([]primitives.Root, numSlots)
+		blockRoots = make([]primitives.Root, numSlots)
+		headers    = make([]*types.BeaconBlockHeader, numSlots)
+	)
+	for slot := range numSlots {
+		stateRoots[slot] = primitives.Root{byte(slot), 0x01}
+		headers[slot] = types.NewBeaconBlockHeader(
+			math.Slot(slot), 0, primitives.Root{}, primitives.Root{},
+			primitives.Root{byte(slot), 0x02},
+		)
+		// The state root of a block is filled in when the next slot is
+		// processed.
+		sealed := *headers[slot]
+		sealed.SetStateRoot(stateRoots[slot])
+		var err error
+		blockRoots[slot], err = sealed.HashTreeRoot()
+		require.NoError(t, err)
+		if slot < numSlots-1 {
+			headers[slot] = &sealed
+		}
+	}
+
+	for slot := range numSlots {
+		sdb := &mocks.StateDB{}
+		sdb.EXPECT().GetSlot().Return(math.Slot(slot), nil)
+		sdb.EXPECT().HashTreeRoot().Return(stateRoots[slot], nil)
+		sdb.EXPECT().GetLatestBlockHeader().Return(headers[slot], nil)
+		sdb.EXPECT().StateRootAtIndex(mock.Anything).RunAndReturn(
+			func(index uint64) (primitives.Root, error) {
+				return stateRoots[historicalSlot(
+					slot, index, slotsPerHistoricalRoot,
+				)], nil
+			},
+		)
+		sdb.EXPECT().GetBlockRootAtIndex(mock.Anything).RunAndReturn(
+			func(index uint64) (primitives.Root, error) {
+				return blockRoots[historicalSlot(
+					slot, index, slotsPerHistoricalRoot,
+				)], nil
+			},
+		)
+		states[slot] = sdb
+	}
+
+	return backend.New(
+		newTestChainSpec(slotsPerHistoricalRoot),
+		&testStateProvider{states: states},
+		testBlockStore{},
+		nil,
+		pool.New[*types.SignedBLSToExecutionChange](),
+		nil,
+	), stateRoots, blockRoots
+}
+
+// historicalSlot returns the slot whose root is stored at the given index of
+// the historical roots of the state at the given slot.
+func historicalSlot(slot int, index, length uint64) int {
+	for s := slot - 1; s >= 0; s-- {
+		if uint64(s)%length == index {
+			return s
+		}
+	}
+	return 0
+}
+
+func TestStateIDResolution(t *testing.T) {
+	b, stateRoots, _ := newTestChain(t, 6)
+
+	tests := []struct {
+		stateID     string
+		expected    primitives.Root
+		expectedErr error
+	}{
+		{stateID: "head", expected: stateRoots[5]},
+		{stateID: "finalized", expected: stateRoots[5]},
+		{stateID: "justified", expected: stateRoots[5]},
+		{stateID: "genesis", expected: stateRoots[0]},
+		{stateID: "0", expected: stateRoots[0]},
+		{stateID: "3", expected: stateRoots[3]},
+		{stateID: "5", expected: stateRoots[5]},
+		{stateID: "6", expectedErr: serverType.ErrStateNotFound},
+		{stateID: stateRoots[5].String(), expected: stateRoots[5]},
+		{stateID: stateRoots[1].String(), expected: stateRoots[1]},
+		// The root of slot 0 is no longer retained by the head state.
+		{
+			stateID:     stateRoots[0].String(),
+			expectedErr: serverType.ErrStateNotFound,
+		},
+		{
+			stateID:     primitives.Root{0xff}.String(),
+			expectedErr: serverType.ErrStateNotFound,
+		},
+		{stateID: "0x01", expectedErr: serverType.ErrInvalidStateID},
+		{stateID: "latest", expectedErr: serverType.ErrInvalidStateID},
+		{stateID: "-1", expectedErr: serverType.ErrInvalidStateID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.stateID, func(t *testing.T) {
+			root, err := b.GetStateRoot(context.Background(), tt.stateID)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, root)
+		})
+	}
+}
+
+func TestBlockIDResolution(t *testing.T) {
+	b, _, blockRoots := newTestChain(t, 6)
+
+	tests := []struct {
+		blockID     string
+		expected    primitives.Root
+		expectedErr error
+	}{
+		{blockID: "head", expected: blockRoots[5]},
+		{blockID: "finalized", expected: blockRoots[5]},
+		{blockID: "genesis", expected: blockRoots[0]},
+		{blockID: "2", expected: blockRoots[2]},
+		{blockID: "6", expectedErr: serverType.ErrBlockNotFound},
+		{blockID: blockRoots[5].String(), expected: blockRoots[5]},
+		{blockID: blockRoots[4].String(), expected: blockRoots[4]},
+		{
+			blockID:     blockRoots[0].String(),
+			expectedErr: serverType.ErrBlockNotFound,
+		},
+		{blockID: "justified", expectedErr: serverType.ErrInvalidBlockID},
+		{blockID: "0xzz", expectedErr: serverType.ErrInvalidBlockID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.blockID, func(t *testing.T) {
+			root, err := b.GetBlockRoot(context.Background(), tt.blockID)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, root)
+		})
+	}
+}
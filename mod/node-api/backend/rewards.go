@@ -22,20 +22,90 @@ package backend
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// GetBlockRewards returns the rewards the proposer of the given block
+// received for the operations included in the block. Participation in
+// CometBFT commits is rewarded during epoch processing rather than per
+// block, so the attestation and sync aggregate rewards are always zero.
 func (h Backend) GetBlockRewards(
-	_ context.Context,
-	_ string,
+	ctx context.Context,
+	blockID string,
 ) (*types.BlockRewardsData, error) {
-	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
-	}, nil
+	postState, err := h.blockStateFromID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := postState.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	// The genesis block is not proposed, so there are no rewards for it.
+	if slot == 0 {
+		return &types.BlockRewardsData{}, nil
+	}
+
+	blk, err := h.bs.GetBySlot(slot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", types.ErrBlockNotFound, err)
+	}
+	preState, err := h.sp.StateAtSlot(ctx, slot-1)
+	if err != nil {
+		return nil, toBlockNotFound(err)
+	}
+
+	var (
+		rewards = &types.BlockRewardsData{
+			ProposerIndex: blk.GetProposerIndex().Unwrap(),
+		}
+		body     = blk.GetBody()
+		quotient = math.Gwei(h.cs.WhistleblowerRewardQuotient())
+		seen     = make(map[math.ValidatorIndex]struct{})
+	)
+
+	// The proposer is the whistleblower of every slashing in the block and
+	// thus receives the whole whistleblower reward of each validator that
+	// was slashed by the block.
+	slashingReward := func(index math.ValidatorIndex) (uint64, error) {
+		if _, ok := seen[index]; ok {
+			return 0, nil
+		}
+		seen[index] = struct{}{}
+
+		pre, valErr := preState.ValidatorByIndex(index)
+		if valErr != nil {
+			return 0, valErr
+		}
+		post, valErr := postState.ValidatorByIndex(index)
+		if valErr != nil {
+			return 0, valErr
+		}
+		if pre.IsSlashed() || !post.IsSlashed() {
+			return 0, nil
+		}
+		return (post.GetEffectiveBalance() / quotient).Unwrap(), nil
+	}
+
+	var reward uint64
+	for _, ps := range body.GetProposerSlashings() {
+		if reward, err = slashingReward(ps.GetProposerIndex()); err != nil {
+			return nil, err
+		}
+		rewards.ProposerSlashings += reward
+	}
+	for _, as := range body.GetAttesterSlashings() {
+		for _, index := range as.GetSlashableIndices() {
+			if reward, err = slashingReward(index); err != nil {
+				return nil, err
+			}
+			rewards.AttesterSlashings += reward
+		}
+	}
+
+	rewards.Total = rewards.ProposerSlashings + rewards.AttesterSlashings
+	return rewards, nil
 }
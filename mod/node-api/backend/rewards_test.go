@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlockRewards(t *testing.T) {
+	const effectiveBalance = math.Gwei(32e9)
+
+	// Validator 2 was slashed before the block, validators 0 and 1 are
+	// slashed by the block.
+	newState := func(slot math.Slot, slashed ...bool) *mocks.StateDB {
+		sdb := &mocks.StateDB{}
+		sdb.EXPECT().GetSlot().Return(slot, nil)
+		for i, s := range slashed {
+			sdb.EXPECT().ValidatorByIndex(math.ValidatorIndex(i)).Return(
+				&types.Validator{
+					EffectiveBalance: effectiveBalance,
+					Slashed:          s,
+				}, nil,
+			)
+		}
+		return sdb
+	}
+	var (
+		preState  = newState(0, false, false, true)
+		postState = newState(1, true, true, true)
+		blk       = &types.BeaconBlock{
+			RawBeaconBlock: &types.BeaconBlockDeneb{
+				BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+					Slot:          1,
+					ProposerIndex: 3,
+				},
+				Body: &types.BeaconBlockBodyDeneb{},
+			},
+		}
+		b = backend.New(
+			newTestChainSpec(8),
+			&testStateProvider{
+				states: []backend.StateDB{preState, postState},
+			},
+			testBlockStore{1: blk},
+			nil,
+			pool.New[*types.SignedBLSToExecutionChange](),
+			nil,
+		)
+	)
+	blk.GetBody().SetProposerSlashings([]*types.ProposerSlashing{{
+		SignedHeader1: &types.SignedBeaconBlockHeader{
+			Header: &types.BeaconBlockHeader{},
+		},
+	}})
+	blk.GetBody().SetAttesterSlashings([]*types.AttesterSlashing{{
+		Attestation1: &types.IndexedAttestation{
+			AttestingIndices: []uint64{0, 1, 2},
+		},
+		Attestation2: &types.IndexedAttestation{
+			AttestingIndices: []uint64{0, 1, 2},
+		},
+	}})
+
+	rewards, err := b.GetBlockRewards(context.Background(), "head")
+	require.NoError(t, err)
+	// Validator 0 is only rewarded once and validator 2 is not rewarded.
+	reward := uint64(effectiveBalance / 512)
+	require.Equal(t, &serverType.BlockRewardsData{
+		ProposerIndex:     3,
+		Total:             2 * reward,
+		ProposerSlashings: reward,
+		AttesterSlashings: reward,
+	}, rewards)
+
+	// The genesis block is not proposed.
+	rewards, err = b.GetBlockRewards(context.Background(), "genesis")
+	require.NoError(t, err)
+	require.Equal(t, &serverType.BlockRewardsData{}, rewards)
+}
+
+func TestGetBlockRewards_BlockNotStored(t *testing.T) {
+	head := &mocks.StateDB{}
+	head.EXPECT().GetSlot().Return(1, nil)
+	b := backend.New(
+		newTestChainSpec(8),
+		&testStateProvider{states: []backend.StateDB{&mocks.StateDB{}, head}},
+		testBlockStore{},
+		nil,
+		pool.New[*types.SignedBLSToExecutionChange](),
+		nil,
+	)
+	_, err := b.GetBlockRewards(context.Background(), "head")
+	require.ErrorIs(t, err, serverType.ErrBlockNotFound)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// DefaultBufferSize is the default number of events that are buffered for a
+// subscription before it is considered to be too slow.
+const DefaultBufferSize = 64
+
+// Event is an event that is streamed to the subscribers of its topic.
+type Event struct {
+	// Topic is the topic of the event.
+	Topic string
+	// Data is the JSON serializable data of the event.
+	Data any
+}
+
+// Broker fans out the published events to the subscriptions of their topic.
+// Publishing never blocks: a subscription that does not keep up with the
+// events and fills up its buffer is dropped, which closes its channel.
+type Broker struct {
+	// mu protects subs.
+	mu sync.Mutex
+	// subs is the set of active subscriptions.
+	subs map[*Subscription]struct{}
+	// bufferSize is the number of events buffered for each subscription.
+	bufferSize int
+}
+
+// NewBroker creates a new broker that buffers up to bufferSize events for
+// each subscription.
+func NewBroker(bufferSize int) *Broker {
+	return &Broker{
+		subs:       make(map[*Subscription]struct{}),
+		bufferSize: bufferSize,
+	}
+}
+
+// Subscribe creates a subscription to the events of the given topics.
+func (b *Broker) Subscribe(topics ...string) (*Subscription, error) {
+	if len(topics) == 0 {
+		return nil, ErrNoTopics
+	}
+	sub := &Subscription{
+		broker: b,
+		topics: make(map[string]struct{}, len(topics)),
+		ch:     make(chan Event, b.bufferSize),
+	}
+	for _, topic := range topics {
+		if !IsSupportedTopic(topic) {
+			return nil, errors.Wrapf(ErrUnsupportedTopic, "%s", topic)
+		}
+		sub.topics[topic] = struct{}{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// Publish sends the event to every subscription of its topic. Subscriptions
+// whose buffer is full are dropped.
+func (b *Broker) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if _, ok := sub.topics[event.Topic]; !ok {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			b.remove(sub)
+		}
+	}
+}
+
+// NumSubscriptions returns the number of active subscriptions.
+func (b *Broker) NumSubscriptions() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// remove removes the subscription from the broker and closes its channel.
+// The caller must hold the lock.
+func (b *Broker) remove(sub *Subscription) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	close(sub.ch)
+}
+
+// Subscription is a subscription to the events of a set of topics.
+type Subscription struct {
+	// broker is the broker the subscription is registered with.
+	broker *Broker
+	// topics is the set of topics that are subscribed to.
+	topics map[string]struct{}
+	// ch buffers the events until they are consumed.
+	ch chan Event
+}
+
+// Events returns the channel the events are delivered on. The channel is
+// closed when the subscription is cancelled or dropped for being too slow.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Unsubscribe cancels the subscription. It is safe to call more than once.
+func (s *Subscription) Unsubscribe() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s)
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerTopicFiltering(t *testing.T) {
+	b := events.NewBroker(events.DefaultBufferSize)
+	headSub, err := b.Subscribe(events.TopicHead)
+	require.NoError(t, err)
+	allSub, err := b.Subscribe(events.TopicHead, events.TopicBlock)
+	require.NoError(t, err)
+
+	b.Publish(events.Event{Topic: events.TopicBlock, Data: 1})
+	b.Publish(events.Event{Topic: events.TopicHead, Data: 2})
+
+	require.Equal(t, events.Event{Topic: events.TopicHead, Data: 2},
+		<-headSub.Events())
+	require.Empty(t, headSub.Events())
+	require.Equal(t, events.Event{Topic: events.TopicBlock, Data: 1},
+		<-allSub.Events())
+	require.Equal(t, events.Event{Topic: events.TopicHead, Data: 2},
+		<-allSub.Events())
+}
+
+func TestBrokerSubscribeInvalidTopics(t *testing.T) {
+	b := events.NewBroker(events.DefaultBufferSize)
+
+	_, err := b.Subscribe()
+	require.ErrorIs(t, err, events.ErrNoTopics)
+	_, err = b.Subscribe(events.TopicHead, "proposer_slashing")
+	require.ErrorIs(t, err, events.ErrUnsupportedTopic)
+	require.Zero(t, b.NumSubscriptions())
+}
+
+func TestBrokerDropsSlowConsumer(t *testing.T) {
+	const bufferSize = 2
+	b := events.NewBroker(bufferSize)
+	slow, err := b.Subscribe(events.TopicHead)
+	require.NoError(t, err)
+	fast, err := b.Subscribe(events.TopicHead)
+	require.NoError(t, err)
+
+	// The fast consumer keeps up with every event, while the slow consumer
+	// never reads and is dropped once its buffer overflows. Publishing does
+	// not block on the slow consumer.
+	for i := range bufferSize + 1 {
+		b.Publish(events.Event{Topic: events.TopicHead, Data: i})
+		require.Equal(t, i, (<-fast.Events()).Data)
+	}
+	require.Equal(t, 1, b.NumSubscriptions())
+
+	// The buffered events are still delivered before the channel is closed.
+	var received []any
+	for event := range slow.Events() {
+		received = append(received, event.Data)
+	}
+	require.Equal(t, []any{0, 1}, received)
+
+	// Unsubscribing a dropped subscription is a no-op.
+	slow.Unsubscribe()
+	fast.Unsubscribe()
+	fast.Unsubscribe()
+	require.Zero(t, b.NumSubscriptions())
+	_, ok := <-fast.Events()
+	require.False(t, ok)
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/events"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStream(t *testing.T) {
+	b := backend.NewMockBackend()
+	srv := httptest.NewServer(server.NewEcho(b))
+	defer srv.Close()
+
+	// Subscribe to a subset of the topics.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, srv.URL+"/eth/v1/events?topics=head"+
+			"&topics=blob_sidecar&topics=payload_attributes", nil,
+	)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	blk := &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockDeneb{
+			BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+				Slot:      8,
+				StateRoot: common.Root{0x01},
+			},
+			Body: &types.BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					Eth1Data: &types.Eth1Data{},
+				},
+				ExecutionPayload: &types.ExecutableDataDeneb{
+					LogsBloom: make([]byte, 256),
+				},
+				BlobKzgCommitments: []eip4844.KZGCommitment{{0x02}},
+			},
+		},
+	}
+	var blkRoot primitives.Root
+	blkRoot, err = blk.HashTreeRoot()
+	require.NoError(t, err)
+	attrs, err := engineprimitives.NewPayloadAttributes(
+		version.Deneb, 100, primitives.Bytes32{0x03}, common.ExecutionAddress{},
+		[]*engineprimitives.Withdrawal{{Index: 1, Validator: 2, Amount: 3}},
+		blkRoot,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, b.PublishBlock(blk))
+	b.PublishPayloadAttributes(&engineprimitives.PayloadAttributesEvent{
+		ProposalSlot:      9,
+		ParentBlockRoot:   blkRoot,
+		ParentBlockHash:   common.ExecutionHash{0x04},
+		ParentBlockNumber: 7,
+		Attributes:        attrs,
+	})
+
+	// The events of the block and payload topics are not streamed.
+	r := bufio.NewReader(resp.Body)
+	blobSidecar := &serverType.BlobSidecarEventData{}
+	readEvent(t, r, events.TopicBlobSidecar, blobSidecar)
+	require.Equal(t, &serverType.BlobSidecarEventData{
+		BlockRoot:     blkRoot,
+		Index:         0,
+		Slot:          8,
+		KZGCommitment: eip4844.KZGCommitment{0x02},
+		VersionedHash: eip4844.KZGCommitment{0x02}.ToVersionedHash(),
+	}, blobSidecar)
+
+	head := &serverType.HeadEventData{}
+	readEvent(t, r, events.TopicHead, head)
+	require.Equal(t, &serverType.HeadEventData{
+		Slot:            8,
+		Block:           blkRoot,
+		State:           common.Root{0x01},
+		EpochTransition: true,
+	}, head)
+
+	payloadAttrs := &serverType.PayloadAttributesEventData{}
+	readEvent(t, r, events.TopicPayloadAttributes, payloadAttrs)
+	require.Equal(t, "deneb", payloadAttrs.Version)
+	require.Equal(t, uint64(9), payloadAttrs.Data.ProposalSlot)
+	require.Equal(t, uint64(7), payloadAttrs.Data.ParentBlockNumber)
+	require.Equal(t, blkRoot, payloadAttrs.Data.ParentBlockRoot)
+	require.Equal(t, &serverType.PayloadAttributesV3{
+		Timestamp:  100,
+		PrevRandao: primitives.Bytes32{0x03},
+		Withdrawals: []*serverType.WithdrawalData{{
+			Index: 1, ValidatorIndex: 2, Amount: 3,
+		}},
+		ParentBeaconBlockRoot: blkRoot,
+	}, payloadAttrs.Data.PayloadAttributes)
+}
+
+// readEvent reads the next event from the stream and decodes its data.
+func readEvent(t *testing.T, r *bufio.Reader, topic string, data any) {
+	t.Helper()
+	line, err := r.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: "+topic+"\n", line)
+	line, err = r.ReadString('\n')
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(
+		[]byte(strings.TrimPrefix(line, "data: ")), data,
+	))
+	line, err = r.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "\n", line)
+}
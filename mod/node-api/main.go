@@ -23,26 +23,17 @@ package main
 import (
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
-	"github.com/berachain/beacon-kit/mod/node-api/server/handlers"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
 func NewServer(corsConfig middleware.CORSConfig,
 	loggingConfig middleware.LoggerConfig) *echo.Echo {
-	e := echo.New()
-	e.HTTPErrorHandler = handlers.CustomHTTPErrorHandler
-	e.Validator = &handlers.CustomValidator{
-		Validator: server.ConstructValidator(),
-	}
-	server.UseMiddlewares(e,
+	return server.NewEcho(
+		backend.NewMockBackend(),
 		middleware.CORSWithConfig(corsConfig),
-		middleware.LoggerWithConfig(loggingConfig))
-	server.AssignRoutes(
-		e,
-		handlers.RouteHandlers{Backend: backend.NewMockBackend()},
+		middleware.LoggerWithConfig(loggingConfig),
 	)
-	return e
 }
 
 func run() {
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+// Config is the configuration for the node API server.
+type Config struct {
+	// Enabled determines if the node API server is enabled.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address the node API server listens on.
+	Address string `mapstructure:"address"`
+	// AllowOrigins is the list of origins that are allowed to make cross
+	// origin requests to the node API server.
+	AllowOrigins []string `mapstructure:"allow-origins"`
+}
+
+// DefaultConfig returns the default configuration for the node API server.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:      false,
+		Address:      "127.0.0.1:3500",
+		AllowOrigins: []string{"*"},
+	}
+}
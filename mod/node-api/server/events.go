@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Backend is the backend served by the server, which also publishes the
+// events of the node to the subscribers of the API.
+type Backend interface {
+	types.BackendHandlers
+	// PublishBlock publishes the events of a finalized block.
+	PublishBlock(blk *consensustypes.BeaconBlock) error
+	// PublishPayloadAttributes publishes the payload attributes that were
+	// sent to the execution client.
+	PublishPayloadAttributes(
+		event *engineprimitives.PayloadAttributesEvent,
+	)
+}
+
+// BlockFeed is the feed of the blocks processed by the node.
+type BlockFeed interface {
+	// Subscribe adds a channel to the feed.
+	Subscribe(
+		ch chan<- *feed.Event[*consensustypes.BeaconBlock],
+	) event.Subscription
+}
+
+// PayloadAttributesFeed is the feed of the payload attributes sent to the
+// execution client.
+type PayloadAttributesFeed interface {
+	// Subscribe adds a channel to the feed.
+	Subscribe(
+		ch chan<- *feed.Event[*engineprimitives.PayloadAttributesEvent],
+	) event.Subscription
+}
+
+// blockFeedListener publishes the finalized blocks of the block feed until
+// the given context is cancelled.
+func (s *Server) blockFeedListener(ctx context.Context) {
+	ch := make(chan *feed.Event[*consensustypes.BeaconBlock])
+	sub := s.blockFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-ch:
+			if !event.Is(events.BeaconBlockFinalized) {
+				continue
+			}
+			if err := s.backend.PublishBlock(event.Data()); err != nil {
+				s.logger.Error("Failed to publish block events", "error", err)
+			}
+		}
+	}
+}
+
+// payloadAttributesFeedListener publishes the payload attributes of the
+// payload attributes feed until the given context is cancelled.
+func (s *Server) payloadAttributesFeedListener(ctx context.Context) {
+	ch := make(chan *feed.Event[*engineprimitives.PayloadAttributesEvent])
+	sub := s.attributesFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-ch:
+			if event.Is(events.PayloadAttributesSent) {
+				s.backend.PublishPayloadAttributes(event.Data())
+			}
+		}
+	}
+}
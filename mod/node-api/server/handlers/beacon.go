@@ -24,6 +24,7 @@ import (
 	"context"
 	"net/http"
 
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	types "github.com/berachain/beacon-kit/mod/node-api/server/types"
 	echo "github.com/labstack/echo/v4"
 )
@@ -65,8 +66,14 @@ func (rh RouteHandlers) GetStateRoot(c echo.Context) error {
 	if len(stateRoot) == 0 {
 		return echo.NewHTTPError(http.StatusNotFound, "State not found")
 	}
+	optimistic, err := rh.Backend.StateExecutionOptimistic(
+		context.TODO(), params.StateID,
+	)
+	if err != nil {
+		return err
+	}
 	return c.JSON(http.StatusOK, types.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                WrapData(types.RootData{Root: stateRoot}),
 	})
@@ -95,8 +102,14 @@ func (rh RouteHandlers) GetStateValidators(c echo.Context) error {
 	if len(validators) == 0 {
 		return echo.NewHTTPError(http.StatusNotFound, "State not found")
 	}
+	optimistic, err := rh.Backend.StateExecutionOptimistic(
+		context.TODO(), params.StateID,
+	)
+	if err != nil {
+		return err
+	}
 	return c.JSON(http.StatusOK, types.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                validators})
 }
@@ -118,8 +131,14 @@ func (rh RouteHandlers) PostStateValidators(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	optimistic, err := rh.Backend.StateExecutionOptimistic(
+		context.TODO(), params.StateID,
+	)
+	if err != nil {
+		return err
+	}
 	return c.JSON(http.StatusOK, types.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                validators})
 }
@@ -140,8 +159,14 @@ func (rh RouteHandlers) GetStateValidatorBalances(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	optimistic, err := rh.Backend.StateExecutionOptimistic(
+		context.TODO(), params.StateID,
+	)
+	if err != nil {
+		return err
+	}
 	return c.JSON(http.StatusOK, types.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                balances,
 	})
@@ -169,13 +194,44 @@ func (rh RouteHandlers) PostStateValidatorBalances(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	optimistic, err := rh.Backend.StateExecutionOptimistic(
+		context.TODO(), params.StateID,
+	)
+	if err != nil {
+		return err
+	}
 	return c.JSON(http.StatusOK, types.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                balances,
 	})
 }
 
+func (rh RouteHandlers) GetBlockRoot(c echo.Context) error {
+	params, err := BindAndValidate[types.BlockIDRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	blockRoot, err := rh.Backend.GetBlockRoot(context.TODO(), params.BlockID)
+	if err != nil {
+		return err
+	}
+	optimistic, err := rh.Backend.BlockExecutionOptimistic(
+		context.TODO(), params.BlockID,
+	)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, types.ValidatorResponse{
+		ExecutionOptimistic: optimistic,
+		Finalized:           false, // stubbed
+		Data:                WrapData(types.RootData{Root: blockRoot}),
+	})
+}
+
 func (rh RouteHandlers) GetBlockRewards(c echo.Context) error {
 	params, err := BindAndValidate[types.BlockIDRequest](c)
 	if err != nil {
@@ -188,9 +244,45 @@ func (rh RouteHandlers) GetBlockRewards(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	optimistic, err := rh.Backend.BlockExecutionOptimistic(
+		context.TODO(), params.BlockID,
+	)
+	if err != nil {
+		return err
+	}
 	return c.JSON(http.StatusOK, types.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                rewards,
 	})
 }
+
+func (rh RouteHandlers) GetBLSToExecutionChanges(c echo.Context) error {
+	changes, err := rh.Backend.GetBLSToExecutionChanges(context.TODO())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(changes))
+}
+
+func (rh RouteHandlers) PostBLSToExecutionChanges(c echo.Context) error {
+	var changes []*consensustypes.SignedBLSToExecutionChange
+	if err := (&echo.DefaultBinder{}).BindBody(c, &changes); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	for _, change := range changes {
+		if change == nil || change.Message == nil {
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				"Invalid BLS to execution change",
+			)
+		}
+	}
+	if err := rh.Backend.SubmitBLSToExecutionChanges(
+		context.TODO(),
+		changes,
+	); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
@@ -51,12 +51,27 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 }
 
 func CustomHTTPErrorHandler(err error, c echo.Context) {
+	// The response of a stream has already been sent, so the error can only
+	// be logged.
+	if c.Response().Committed {
+		c.Logger().Error(err)
+		return
+	}
 	code := http.StatusInternalServerError
 	var message any = http.StatusText(code)
 	httpError := &echo.HTTPError{}
-	if errors.As(err, &httpError) {
+	switch {
+	case errors.As(err, &httpError):
 		code = httpError.Code
 		message = httpError.Message
+	case errors.Is(err, types.ErrInvalidStateID),
+		errors.Is(err, types.ErrInvalidBlockID):
+		code = http.StatusBadRequest
+		message = err.Error()
+	case errors.Is(err, types.ErrStateNotFound),
+		errors.Is(err, types.ErrBlockNotFound):
+		code = http.StatusNotFound
+		message = err.Error()
 	}
 	c.Logger().Error(err)
 	response := &types.ErrorResponse{
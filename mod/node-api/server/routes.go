@@ -32,7 +32,13 @@ type Handlers interface {
 	PostStateValidators(c echo.Context) error
 	GetStateValidatorBalances(c echo.Context) error
 	PostStateValidatorBalances(c echo.Context) error
+	GetBlockRoot(c echo.Context) error
 	GetBlockRewards(c echo.Context) error
+	GetBLSToExecutionChanges(c echo.Context) error
+	PostBLSToExecutionChanges(c echo.Context) error
+	GetEvents(c echo.Context) error
+	GetSpec(c echo.Context) error
+	GetForkSchedule(c echo.Context) error
 }
 
 func UseMiddlewares(e *echo.Echo, middlewares ...echo.MiddlewareFunc) {
@@ -92,7 +98,7 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v2/beacon/blocks/:block_id",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blocks/:block_id/root",
-		h.NotImplemented)
+		h.GetBlockRoot)
 	e.GET("/eth/v1/beacon/blocks/:block_id/attestations",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blob_sidecars/:block_id",
@@ -132,9 +138,9 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/beacon/pool/voluntary_exits",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/pool/bls_to_execution_changes",
-		h.NotImplemented)
+		h.GetBLSToExecutionChanges)
 	e.POST("/eth/v1/beacon/pool/bls_to_execution_changes",
-		h.NotImplemented)
+		h.PostBLSToExecutionChanges)
 }
 
 func assignBuilderRoutes(e *echo.Echo, h Handlers) {
@@ -144,9 +150,9 @@ func assignBuilderRoutes(e *echo.Echo, h Handlers) {
 
 func assignConfigRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/config/fork_schedule",
-		h.NotImplemented)
+		h.GetForkSchedule)
 	e.GET("/eth/v1/config/spec",
-		h.NotImplemented)
+		h.GetSpec)
 	e.GET("/eth/v1/config/deposit_contract",
 		h.NotImplemented)
 }
@@ -162,7 +168,7 @@ func assignDebugRoutes(e *echo.Echo, h Handlers) {
 
 func assignEventsRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/events",
-		h.NotImplemented)
+		h.GetEvents)
 }
 
 func aasignNodeRoutes(e *echo.Echo, h Handlers) {
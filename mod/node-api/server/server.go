@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/server/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/server/types"
+	echo "github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// shutdownTimeout is the time given to in-flight requests to complete when
+// the server is stopped.
+const shutdownTimeout = 5 * time.Second
+
+// Server is a service that serves the Beacon Node API.
+type Server struct {
+	// cfg is the configuration for the server.
+	cfg Config
+	// logger is used to log information about the server.
+	logger log.Logger[any]
+	// e is the echo instance that serves the API.
+	e *echo.Echo
+	// backend is the backend served by the API.
+	backend Backend
+	// blockFeed is the feed of the blocks processed by the node.
+	blockFeed BlockFeed
+	// attributesFeed is the feed of the payload attributes sent to the
+	// execution client.
+	attributesFeed PayloadAttributesFeed
+}
+
+// New creates a new node API server that serves the given backend. The
+// events of the given feeds are streamed to the subscribers of the API.
+func New(
+	cfg Config,
+	logger log.Logger[any],
+	backend Backend,
+	blockFeed BlockFeed,
+	attributesFeed PayloadAttributesFeed,
+) *Server {
+	e := NewEcho(
+		backend,
+		middleware.CORSWithConfig(middleware.CORSConfig{
+			AllowOrigins: cfg.AllowOrigins,
+		}),
+	)
+	e.HideBanner = true
+	e.HidePort = true
+	return &Server{
+		cfg:            cfg,
+		logger:         logger,
+		e:              e,
+		backend:        backend,
+		blockFeed:      blockFeed,
+		attributesFeed: attributesFeed,
+	}
+}
+
+// NewEcho creates an echo instance that serves the Beacon Node API from the
+// given backend, using the given middlewares.
+func NewEcho(
+	backend types.BackendHandlers,
+	middlewares ...echo.MiddlewareFunc,
+) *echo.Echo {
+	e := echo.New()
+	e.HTTPErrorHandler = handlers.CustomHTTPErrorHandler
+	e.Validator = &handlers.CustomValidator{
+		Validator: ConstructValidator(),
+	}
+	UseMiddlewares(e, middlewares...)
+	AssignRoutes(e, handlers.RouteHandlers{Backend: backend})
+	return e
+}
+
+// Name returns the name of the service.
+func (*Server) Name() string {
+	return "node-api"
+}
+
+// Start starts serving the API in the background, until the given context
+// is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	go s.blockFeedListener(ctx)
+	go s.payloadAttributesFeedListener(ctx)
+
+	go func() {
+		s.logger.Info("Starting node API server", "address", s.cfg.Address)
+		if err := s.e.Start(s.cfg.Address); err != nil &&
+			!errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Node API server stopped", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(
+			context.Background(), shutdownTimeout,
+		)
+		defer cancel()
+		if err := s.e.Shutdown(shutdownCtx); err != nil {
+			s.logger.Error("Failed to stop node API server", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Status returns nil if the service is healthy.
+func (*Server) Status() error {
+	return nil
+}
+
+// WaitForHealthy waits for the service to be healthy.
+func (*Server) WaitForHealthy(context.Context) {}
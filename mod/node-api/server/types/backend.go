@@ -23,6 +23,8 @@ package types
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/events"
 	"github.com/berachain/beacon-kit/mod/primitives"
 )
 
@@ -48,8 +50,30 @@ type BackendHandlers interface {
 		stateID string,
 		id []string,
 	) ([]*ValidatorBalanceData, error)
+	StateExecutionOptimistic(
+		ctx context.Context,
+		stateID string,
+	) (bool, error)
+	BlockExecutionOptimistic(
+		ctx context.Context,
+		blockID string,
+	) (bool, error)
+	GetBlockRoot(
+		ctx context.Context,
+		blockID string,
+	) (primitives.Bytes32, error)
 	GetBlockRewards(
 		ctx context.Context,
 		blockID string,
 	) (*BlockRewardsData, error)
+	GetBLSToExecutionChanges(
+		ctx context.Context,
+	) ([]*types.SignedBLSToExecutionChange, error)
+	SubmitBLSToExecutionChanges(
+		ctx context.Context,
+		changes []*types.SignedBLSToExecutionChange,
+	) error
+	SubscribeEvents(topics []string) (*events.Subscription, error)
+	GetSpec(ctx context.Context) (map[string]string, error)
+	GetForkSchedule(ctx context.Context) ([]*ForkData, error)
 }
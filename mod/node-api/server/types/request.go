@@ -92,3 +92,7 @@ type BlobSidecarRequest struct {
 	BlockIDRequest
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
+
+type EventsRequest struct {
+	Topics []string `query:"topics" validate:"required,dive,event_topic"`
+}
@@ -23,6 +23,8 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 type ErrorResponse struct {
@@ -40,6 +42,12 @@ type GenesisData struct {
 	GenesisForkVersion    string             `json:"genesis_fork_version"`
 }
 
+type ForkData struct {
+	PreviousVersion common.Version `json:"previous_version"`
+	CurrentVersion  common.Version `json:"current_version"`
+	Epoch           uint64         `json:"epoch,string"`
+}
+
 type RootData struct {
 	Root primitives.Root `json:"root"`
 }
@@ -76,3 +84,60 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+type HeadEventData struct {
+	Slot                uint64          `json:"slot,string"`
+	Block               primitives.Root `json:"block"`
+	State               primitives.Root `json:"state"`
+	EpochTransition     bool            `json:"epoch_transition"`
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+}
+
+type BlockEventData struct {
+	Slot                uint64          `json:"slot,string"`
+	Block               primitives.Root `json:"block"`
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+}
+
+type FinalizedCheckpointEventData struct {
+	Block               primitives.Root `json:"block"`
+	State               primitives.Root `json:"state"`
+	Epoch               uint64          `json:"epoch,string"`
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+}
+
+type BlobSidecarEventData struct {
+	BlockRoot     primitives.Root       `json:"block_root"`
+	Index         uint64                `json:"index,string"`
+	Slot          uint64                `json:"slot,string"`
+	KZGCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	VersionedHash common.ExecutionHash  `json:"versioned_hash"`
+}
+
+type PayloadAttributesEventData struct {
+	Version string                 `json:"version"`
+	Data    *PayloadAttributesData `json:"data"`
+}
+
+type PayloadAttributesData struct {
+	ProposalSlot      uint64               `json:"proposal_slot,string"`
+	ParentBlockNumber uint64               `json:"parent_block_number,string"`
+	ParentBlockRoot   primitives.Root      `json:"parent_block_root"`
+	ParentBlockHash   common.ExecutionHash `json:"parent_block_hash"`
+	PayloadAttributes *PayloadAttributesV3 `json:"payload_attributes"`
+}
+
+type PayloadAttributesV3 struct {
+	Timestamp             uint64                  `json:"timestamp,string"`
+	PrevRandao            primitives.Bytes32      `json:"prev_randao"`
+	SuggestedFeeRecipient common.ExecutionAddress `json:"suggested_fee_recipient"`
+	Withdrawals           []*WithdrawalData       `json:"withdrawals"`
+	ParentBeaconBlockRoot primitives.Root         `json:"parent_beacon_block_root"`
+}
+
+type WithdrawalData struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
@@ -24,6 +24,8 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/berachain/beacon-kit/mod/node-api/events"
+
 	"github.com/go-playground/validator/v10"
 )
 
@@ -37,6 +39,7 @@ func ConstructValidator() *validator.Validate {
 		"slot":             ValidateUint64,
 		"committee_index":  ValidateUint64,
 		"hex":              ValidateHex,
+		"event_topic":      ValidateEventTopic,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -117,6 +120,10 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	return validateAllowedStrings(fl, allowedStatuses)
 }
 
+func ValidateEventTopic(fl validator.FieldLevel) bool {
+	return events.IsSupportedTopic(fl.Field().String())
+}
+
 func validateAllowedStrings(
 	fl validator.FieldLevel,
 	allowedValues map[string]bool,
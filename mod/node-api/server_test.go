@@ -84,6 +84,38 @@ func getTestcases() []testcase {
 			expectedStatus: http.StatusOK,
 			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"data\":{\"root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\"}}}\n",
 		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/states/1/root",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"data\":{\"root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\"}}}\n",
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/states/0x0100000000000000000000000000000000000000000000000000000000000000/root",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"data\":{\"root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\"}}}\n",
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/states/2/root",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/states/0x0200000000000000000000000000000000000000000000000000000000000000/root",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/states/0x01/root",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/states/latest/root",
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/states/:state_id/fork",
@@ -178,7 +210,17 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/blocks/:block_id/root",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blocks/justified/root",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blocks/2/root",
+			expectedStatus: http.StatusNotFound,
 		},
 		{
 			method:         "GET",
@@ -204,7 +246,7 @@ func getTestcases() []testcase {
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/rewards/blocks/:block_id",
 			expectedStatus: http.StatusOK,
-			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"proposer_index\":\"1\",\"total\":\"1\",\"attestations\":\"1\",\"sync_aggregate\":\"1\",\"proposer_slashings\":\"1\",\"attester_slashings\":\"1\"}}\n",
+			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"proposer_index\":\"1\",\"total\":\"0\",\"attestations\":\"0\",\"sync_aggregate\":\"0\",\"proposer_slashings\":\"0\",\"attester_slashings\":\"0\"}}\n",
 		},
 		{
 			method:         "POST",
@@ -284,12 +326,20 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/pool/bls_to_execution_changes",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":[]}\n",
 		},
 		{
 			method:         "POST",
 			endpoint:       "/eth/v1/beacon/pool/bls_to_execution_changes",
-			expectedStatus: http.StatusNotImplemented,
+			body:           `[]`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			method:         "POST",
+			endpoint:       "/eth/v1/beacon/pool/bls_to_execution_changes",
+			body:           `[null]`,
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "GET",
@@ -299,12 +349,13 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/config/fork_schedule",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":[{\"previous_version\":\"0x04000000\",\"current_version\":\"0x04000000\",\"epoch\":\"0\"},{\"previous_version\":\"0x04000000\",\"current_version\":\"0x05000000\",\"epoch\":\"10\"}]}\n",
 		},
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/config/spec",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "GET",
@@ -329,7 +380,7 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/events?topics=head&topics=proposer_slashing",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "GET",
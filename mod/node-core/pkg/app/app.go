@@ -91,6 +91,9 @@ func (app *BeaconApp) setupBeaconModule() {
 	)
 	app.SetPreBlocker(beaconModule.ABCIFinalizeBlockMiddleware().PreBlock)
 
+	// Serve the node API from the committed state of the application.
+	beaconModule.SetQueryContextFn(app.CreateQueryContext)
+
 	// TODO: this needs to be made un-hood.
 	if err := beaconModule.StartServices(
 		context.Background(),
@@ -25,6 +25,7 @@ import (
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	dastore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -38,6 +39,7 @@ type DBManagerInput struct {
 	Logger             log.Logger
 	DepositPruner      pruner.Pruner[*dastore.KVStore[*types.Deposit]]
 	AvailabilityPruner pruner.Pruner[*filedb.RangeDB]
+	BlockPruner        pruner.Pruner[*block.KVStore[*types.BeaconBlock]]
 }
 
 // ProvideDBManager provides a DBManager for the depinject framework.
@@ -55,5 +57,6 @@ func ProvideDBManager(
 		in.Logger.With("service", "db-manager"),
 		in.DepositPruner,
 		in.AvailabilityPruner,
+		in.BlockPruner,
 	)
 }
@@ -29,6 +29,7 @@ func DefaultComponentsWithStandardTypes() []any {
 	return []any{
 		ProvideAvailibilityStore[*types.BeaconBlockBody],
 		ProvideBlsSigner,
+		ProvideBlockStore[*types.BeaconBlock],
 		ProvideTrustedSetup,
 		ProvideDepositStore[*types.Deposit],
 		ProvideConfig,
@@ -47,8 +48,11 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideLocalBuilder,
 		ProvideStateProcessor,
 		ProvideBlockFeed[*types.BeaconBlock],
+		ProvidePayloadAttributesFeed,
+		ProvideBLSToExecutionChangePool,
 		ProvideDepositPruner,
 		ProvideAvailabilityPruner,
+		ProvideBlockPruner,
 		ProvideDBManager,
 		ProvideDepositService,
 	}
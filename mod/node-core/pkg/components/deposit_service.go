@@ -29,7 +29,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/ethereum/go-ethereum/event"
 )
@@ -70,7 +69,7 @@ func ProvideDepositService(in DepositServiceIn) *deposit.Service[
 		event.Subscription,
 	](
 		in.Logger.With("service", "deposit"),
-		math.U64(in.ChainSpec.Eth1FollowDistance()),
+		in.ChainSpec,
 		in.EngineClient,
 		in.TelemetrySink,
 		in.DepositStore,
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/interfaces"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -50,7 +51,9 @@ func ProvideDepositStore[
 	DepositT interface {
 		interfaces.SSZMarshallable
 		GetIndex() uint64
+		GetDepositDataRoot() (common.Root, error)
 		HashTreeRoot() ([32]byte, error)
+		SetProof(proof [33]common.Root)
 	},
 ](
 	in DepositStoreInput,
@@ -65,12 +65,18 @@ func ProvideEngineClient[
 ](
 	in EngineClientInputs,
 ) *engineclient.EngineClient[ExecutionPayloadT] {
+	schedule := in.ChainSpec.ForkSchedule()
+	forkVersions := make([]uint32, 0, len(schedule))
+	for _, fork := range schedule {
+		forkVersions = append(forkVersions, fork.Version)
+	}
 	return engineclient.New[ExecutionPayloadT](
 		&in.Config.Engine,
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		forkVersions,
 	)
 }
 
@@ -42,14 +42,18 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/spf13/cast"
 )
 
 // TODO: we don't allow generics here? Why? Is it fixable?
@@ -75,8 +79,10 @@ type DepInjectInput struct {
 	BeaconDepositContract *deposit.WrappedBeaconDepositContract[
 		*types.Deposit, types.WithdrawalCredentials,
 	]
-	BlockFeed     *event.FeedOf[*feed.Event[*types.BeaconBlock]]
-	BlobProcessor *dablobs.Processor[
+	BlockFeed                *event.FeedOf[*feed.Event[*types.BeaconBlock]]
+	BlockStore               *block.KVStore[*types.BeaconBlock]
+	BLSToExecutionChangePool *pool.Pool[*types.SignedBLSToExecutionChange]
+	BlobProcessor            *dablobs.Processor[
 		*dastore.Store[*types.BeaconBlockBody],
 		*types.BeaconBlockBody,
 	]
@@ -103,8 +109,9 @@ type DepInjectInput struct {
 		*types.ExecutionPayload,
 		*types.ExecutionPayloadHeader,
 	]
-	Signer         crypto.BLSSigner
-	StateProcessor blockchain.StateProcessor[
+	PayloadAttributesFeed *components.PayloadAttributesFeed
+	Signer                crypto.BLSSigner
+	StateProcessor        blockchain.StateProcessor[
 		*types.BeaconBlock,
 		components.BeaconState,
 		*datypes.BlobSidecars,
@@ -146,6 +153,13 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 		in.DepositStore,
 	)
 
+	nodeAPIStateProvider := components.NewNodeAPIStateProvider(
+		in.ChainSpec,
+		storageBackend.StateFromContext,
+		cast.ToString(in.AppOpts.Get(flags.FlagHome))+
+			"/data/genesis_state.ssz",
+	)
+
 	// TODO: this is hood as fuck.
 	if in.BeaconConfig.KZG.Implementation == "" {
 		in.BeaconConfig.KZG.Implementation = "crate-crypto/go-kzg-4844"
@@ -155,6 +169,8 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 		in.BeaconConfig,
 		in.BlobProcessor,
 		in.BlockFeed,
+		in.PayloadAttributesFeed,
+		in.BlockStore,
 		in.ChainSpec,
 		in.DBManager,
 		in.DepositService,
@@ -164,6 +180,8 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 		in.StateProcessor,
 		storageBackend,
 		in.LocalBuilder,
+		nodeAPIStateProvider,
+		in.BLSToExecutionChangePool,
 		in.TelemetrySink,
 		in.Environment.Logger.With("module", "beacon-kit"),
 	)
@@ -172,6 +190,6 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 	}
 
 	return DepInjectOutput{
-		Module: NewAppModule(runtime),
+		Module: NewAppModule(runtime, nodeAPIStateProvider),
 	}, nil
 }
@@ -48,17 +48,40 @@ var (
 // AppModule implements an application module for the evm module.
 type AppModule struct {
 	*components.BeaconKitRuntime
+	nodeAPIStateProvider *components.NodeAPIStateProvider
 }
 
 // NewAppModule creates a new AppModule object.
 func NewAppModule(
 	runtime *components.BeaconKitRuntime,
+	nodeAPIStateProvider *components.NodeAPIStateProvider,
 ) AppModule {
 	return AppModule{
-		BeaconKitRuntime: runtime,
+		BeaconKitRuntime:     runtime,
+		nodeAPIStateProvider: nodeAPIStateProvider,
 	}
 }
 
+// InitGenesis initializes the beacon state from the genesis data and
+// persists it for the node API, as it is not committed at a height of its
+// own.
+func (am AppModule) InitGenesis(
+	ctx context.Context,
+	bz json.RawMessage,
+) ([]appmodulev2.ValidatorUpdate, error) {
+	updates, err := am.BeaconKitRuntime.InitGenesis(ctx, bz)
+	if err != nil {
+		return nil, err
+	}
+	return updates, am.nodeAPIStateProvider.StoreGenesisState(ctx)
+}
+
+// SetQueryContextFn sets the function used by the node API to query the
+// committed state of the application.
+func (am AppModule) SetQueryContextFn(fn components.QueryContextFn) {
+	am.nodeAPIStateProvider.SetQueryContextFn(fn)
+}
+
 // Name is the name of this module.
 func (am AppModule) Name() string {
 	return ModuleName
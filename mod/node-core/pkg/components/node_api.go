@@ -0,0 +1,342 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/electra"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	sdkruntime "github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// errQueryContextNotSet is returned when the node API is queried before
+	// the application has been attached to it.
+	errQueryContextNotSet = errors.New("query context is not set")
+)
+
+// QueryContextFn creates a context for querying the committed state of the
+// application at the given height, or at the latest height if it is 0.
+type QueryContextFn func(height int64, prove bool) (sdk.Context, error)
+
+// NodeAPIStateProvider provides the beacon states of the node to the node API
+// backend by querying the committed state of the application. The genesis
+// state is not committed at a height of its own, so it is persisted apart
+// when the chain is initialized.
+type NodeAPIStateProvider struct {
+	cs               primitives.ChainSpec
+	stateFromContext func(context.Context) BeaconState
+	queryContextFn   QueryContextFn
+	genesisStatePath string
+
+	mu           sync.Mutex
+	genesisState backend.StateDB
+}
+
+// NewNodeAPIStateProvider creates a new state provider that builds the beacon
+// states from the given function and persists the genesis state at the given
+// path.
+func NewNodeAPIStateProvider(
+	cs primitives.ChainSpec,
+	stateFromContext func(context.Context) BeaconState,
+	genesisStatePath string,
+) *NodeAPIStateProvider {
+	return &NodeAPIStateProvider{
+		cs:               cs,
+		stateFromContext: stateFromContext,
+		genesisStatePath: genesisStatePath,
+	}
+}
+
+// SetQueryContextFn sets the function used to query the committed state of
+// the application. It must be set before the node API is started.
+func (p *NodeAPIStateProvider) SetQueryContextFn(fn QueryContextFn) {
+	p.queryContextFn = fn
+}
+
+// StoreGenesisState persists the beacon state of the given context, which
+// must be the state of the chain right after its initialization.
+func (p *NodeAPIStateProvider) StoreGenesisState(ctx context.Context) error {
+	st, err := p.stateFromContext(ctx).GetMarshallable()
+	if err != nil {
+		return err
+	}
+	bz, err := st.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(p.genesisStatePath), 0o700); err != nil {
+		return err
+	}
+	if err = os.WriteFile(p.genesisStatePath, bz, 0o600); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.genesisState = nil
+	return nil
+}
+
+// HeadState returns the beacon state of the latest committed block.
+func (p *NodeAPIStateProvider) HeadState(
+	context.Context,
+) (backend.StateDB, error) {
+	return p.stateAtHeight(0)
+}
+
+// StateAtSlot returns the beacon state after the block at the given slot has
+// been committed. The slot of a block matches its CometBFT height.
+func (p *NodeAPIStateProvider) StateAtSlot(
+	_ context.Context,
+	slot math.Slot,
+) (backend.StateDB, error) {
+	if slot == 0 {
+		return p.loadGenesisState()
+	}
+	return p.stateAtHeight(int64(slot.Unwrap()))
+}
+
+// stateAtHeight returns the beacon state committed at the given height.
+func (p *NodeAPIStateProvider) stateAtHeight(
+	height int64,
+) (backend.StateDB, error) {
+	if p.queryContextFn == nil {
+		return nil, errQueryContextNotSet
+	}
+	ctx, err := p.queryContextFn(height, false)
+	if err != nil {
+		return nil, errors.Wrapf(
+			apitypes.ErrStateNotFound, "height: %d, %v", height, err,
+		)
+	}
+	return p.stateFromContext(ctx), nil
+}
+
+// loadGenesisState returns the persisted genesis state, decoding it on first
+// use.
+func (p *NodeAPIStateProvider) loadGenesisState() (backend.StateDB, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.genesisState != nil {
+		return p.genesisState, nil
+	}
+
+	bz, err := os.ReadFile(p.genesisStatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrap(
+			apitypes.ErrStateNotFound, "genesis state is not persisted",
+		)
+	} else if err != nil {
+		return nil, err
+	}
+
+	st, err := p.newGenesisState(p.cs.ActiveForkVersionForSlot(0), bz)
+	if err != nil {
+		return nil, err
+	}
+	p.genesisState = st
+	return st, nil
+}
+
+// newGenesisState decodes the genesis state from its SSZ encoding in the
+// container of the given fork version and loads it into a beacon state kept
+// in memory, which is served like the committed states.
+func (p *NodeAPIStateProvider) newGenesisState(
+	forkVersion uint32,
+	bz []byte,
+) (BeaconState, error) {
+	decoded, err := new(state.BeaconState[
+		*types.BeaconBlockHeader, *types.ExecutionPayloadHeader,
+		*types.Eth1Data, *types.Fork, *types.Validator,
+	]).NewFromSSZ(bz, forkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Electra container holds the fields of every fork, but the header
+	// is kept in the version of the fork of the state.
+	var (
+		st     *electra.BeaconState
+		header = new(types.ExecutionPayloadHeader)
+	)
+	switch c := decoded.Marshallable.(type) {
+	case *deneb.BeaconState:
+		header.InnerExecutionPayloadHeader = c.LatestExecutionPayloadHeader
+		if st, err = state.UpgradeToElectra(c); err != nil {
+			return nil, err
+		}
+	case *electra.BeaconState:
+		header.InnerExecutionPayloadHeader = c.LatestExecutionPayloadHeader
+		st = c
+	}
+
+	kv, err := newMemoryBeaconStore()
+	if err != nil {
+		return nil, err
+	}
+	if err = setBeaconState(kv, st, header); err != nil {
+		return nil, err
+	}
+	return statedb.NewBeaconStateFromDB[BeaconState](kv, p.cs), nil
+}
+
+// newMemoryBeaconStore returns a beacon store backed by an in-memory
+// database.
+func newMemoryBeaconStore() (*storage.KVStore, error) {
+	var (
+		key = storetypes.NewKVStoreKey("beacon")
+		db  = dbm.NewMemDB()
+		cms = store.NewCommitMultiStore(
+			db, log.NewNopLogger(), metrics.NewNoOpMetrics(),
+		)
+	)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeDB, db)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	return beacondb.New[
+		*types.Fork,
+		*types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader,
+		*types.Eth1Data,
+		*types.Validator,
+	](
+		sdkruntime.NewKVStoreService(key),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	).WithContext(sdk.NewContext(cms, false, log.NewNopLogger())), nil
+}
+
+// setBeaconState writes the fields of the given state to the beacon store.
+//
+//nolint:funlen // mirrors GetMarshallable.
+func setBeaconState(
+	kv *storage.KVStore,
+	st *electra.BeaconState,
+	header *types.ExecutionPayloadHeader,
+) error {
+	if err := kv.SetGenesisValidatorsRoot(
+		st.GenesisValidatorsRoot,
+	); err != nil {
+		return err
+	}
+	if err := kv.SetSlot(st.Slot); err != nil {
+		return err
+	}
+	if err := kv.SetFork(st.Fork); err != nil {
+		return err
+	}
+	if err := kv.SetLatestBlockHeader(st.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range st.BlockRoots {
+		if err := kv.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range st.StateRoots {
+		if err := kv.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err := kv.SetEth1Data(st.Eth1Data); err != nil {
+		return err
+	}
+	if err := kv.SetEth1DepositIndex(st.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err := kv.SetLatestExecutionPayloadHeader(header); err != nil {
+		return err
+	}
+	if err := kv.SetDepositRequestsStartIndex(
+		st.DepositRequestsStartIndex,
+	); err != nil {
+		return err
+	}
+	for _, val := range st.Validators {
+		if err := kv.AddValidator(val); err != nil {
+			return err
+		}
+	}
+	for i, balance := range st.Balances {
+		if err := kv.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(balance),
+		); err != nil {
+			return err
+		}
+	}
+	for i, participation := range st.EpochParticipation {
+		if err := kv.SetEpochParticipationAtIndex(
+			math.ValidatorIndex(i), participation,
+		); err != nil {
+			return err
+		}
+	}
+	for i, score := range st.InactivityScores {
+		if err := kv.SetInactivityScoreAtIndex(
+			math.ValidatorIndex(i), score,
+		); err != nil {
+			return err
+		}
+	}
+	for i, mix := range st.RandaoMixes {
+		if err := kv.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := kv.SetNextWithdrawalIndex(st.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := kv.SetNextWithdrawalValidatorIndex(
+		st.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	for i, amount := range st.Slashings {
+		if err := kv.SetSlashingAtIndex(
+			uint64(i), math.Gwei(amount),
+		); err != nil {
+			return err
+		}
+	}
+	return kv.SetTotalSlashing(st.TotalSlashing)
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/electra"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testGenesisState is a beacon state that only serves its SSZ container.
+type testGenesisState struct {
+	components.BeaconState
+	marshallable ssz.Marshallable
+}
+
+func (s testGenesisState) GetMarshallable() (ssz.Marshallable, error) {
+	return s.marshallable, nil
+}
+
+func TestNodeAPIStateProvider_GenesisState(t *testing.T) {
+	var (
+		fork      = &types.Fork{CurrentVersion: common.Version{0x04}}
+		header    = &types.BeaconBlockHeader{BodyRoot: common.Root{0x01}}
+		eth1Data  = &types.Eth1Data{BlockHash: common.ExecutionHash{0x02}}
+		validator = &types.Validator{
+			Pubkey:           crypto.BLSPubkey{0x03},
+			EffectiveBalance: math.Gwei(32e9),
+		}
+		blockHash   = common.ExecutionHash{0x04}
+		denebHeader = &types.ExecutionPayloadHeaderDeneb{
+			LogsBloom: make([]byte, 256),
+			BlockHash: blockHash,
+		}
+		electraHeader = &types.ExecutionPayloadHeaderElectra{
+			LogsBloom: make([]byte, 256),
+			BlockHash: blockHash,
+		}
+	)
+
+	tests := []struct {
+		name             string
+		electraForkEpoch math.Epoch
+		state            ssz.Marshallable
+	}{
+		{
+			name:             "deneb",
+			electraForkEpoch: 10,
+			state: &deneb.BeaconState{
+				GenesisValidatorsRoot:        common.Root{0x05},
+				Fork:                         fork,
+				LatestBlockHeader:            header,
+				BlockRoots:                   []common.Root{{0x06}},
+				StateRoots:                   []common.Root{{0x07}},
+				Eth1Data:                     eth1Data,
+				LatestExecutionPayloadHeader: denebHeader,
+				Validators:                   []*types.Validator{validator},
+				Balances:                     []uint64{32e9},
+				RandaoMixes:                  []primitives.Bytes32{{0x09}},
+				Slashings:                    []uint64{1},
+				EpochParticipation:           []uint64{2},
+				InactivityScores:             []uint64{3},
+			},
+		},
+		{
+			name:             "electra",
+			electraForkEpoch: 0,
+			state: &electra.BeaconState{
+				GenesisValidatorsRoot:        common.Root{0x05},
+				Fork:                         fork,
+				LatestBlockHeader:            header,
+				BlockRoots:                   []common.Root{{0x06}},
+				StateRoots:                   []common.Root{{0x07}},
+				Eth1Data:                     eth1Data,
+				LatestExecutionPayloadHeader: electraHeader,
+				Validators:                   []*types.Validator{validator},
+				Balances:                     []uint64{32e9},
+				RandaoMixes:                  []primitives.Bytes32{{0x09}},
+				Slashings:                    []uint64{1},
+				EpochParticipation:           []uint64{2},
+				InactivityScores:             []uint64{3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				cs  = chain.NewChainSpec(chain.SpecData[
+					common.DomainType, math.Epoch, common.ExecutionAddress,
+					math.Slot, any,
+				]{
+					SlotsPerEpoch:             8,
+					SlotsPerHistoricalRoot:    1,
+					EpochsPerHistoricalVector: 1,
+					ForkSchedule: []chain.ScheduledFork[math.Epoch]{
+						{Version: version.Deneb, Epoch: 0},
+						{Version: version.Electra, Epoch: tt.electraForkEpoch},
+					},
+				})
+				path        = filepath.Join(t.TempDir(), "data", "genesis.ssz")
+				newProvider = func() *components.NodeAPIStateProvider {
+					return components.NewNodeAPIStateProvider(
+						cs,
+						func(context.Context) components.BeaconState {
+							return testGenesisState{marshallable: tt.state}
+						},
+						path,
+					)
+				}
+				p = newProvider()
+			)
+
+			// The genesis state cannot be served before it is persisted.
+			_, err := p.StateAtSlot(ctx, 0)
+			require.ErrorIs(t, err, apitypes.ErrStateNotFound)
+
+			require.NoError(t, p.StoreGenesisState(ctx))
+
+			// The genesis state is served after a restart of the node.
+			st, err := newProvider().StateAtSlot(ctx, 0)
+			require.NoError(t, err)
+
+			expectedRoot, err := tt.state.HashTreeRoot()
+			require.NoError(t, err)
+			root, err := st.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, expectedRoot, root)
+
+			genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+			require.NoError(t, err)
+			require.Equal(t, common.Root{0x05}, genesisValidatorsRoot)
+
+			stFork, err := st.GetFork()
+			require.NoError(t, err)
+			require.Equal(t, fork, stFork)
+
+			payloadHeader, err := st.GetLatestExecutionPayloadHeader()
+			require.NoError(t, err)
+			require.Equal(t, blockHash, payloadHeader.GetBlockHash())
+
+			blockRoot, err := st.GetBlockRootAtIndex(0)
+			require.NoError(t, err)
+			require.Equal(t, common.Root{0x06}, blockRoot)
+			_, err = st.StateRootAtIndex(1)
+			require.Error(t, err)
+
+			idx, err := st.ValidatorIndexByPubkey(validator.Pubkey)
+			require.NoError(t, err)
+			require.Equal(t, math.ValidatorIndex(0), idx)
+			val, err := st.ValidatorByIndex(idx)
+			require.NoError(t, err)
+			require.Equal(t, validator.Pubkey, val.Pubkey)
+			balance, err := st.GetBalance(idx)
+			require.NoError(t, err)
+			require.Equal(t, math.Gwei(32e9), balance)
+			_, err = st.ValidatorIndexByPubkey(crypto.BLSPubkey{0x08})
+			require.Error(t, err)
+		})
+	}
+}
@@ -39,6 +39,7 @@ type LocalBuilderInput struct {
 	ChainSpec       primitives.ChainSpec
 	Logger          log.Logger
 	ExecutionEngine *execution.Engine[*types.ExecutionPayload]
+	AttributesFeed  *PayloadAttributesFeed
 }
 
 func ProvideLocalBuilder(
@@ -54,5 +55,6 @@ func ProvideLocalBuilder(
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
 		cache.NewPayloadIDCache[engineprimitives.PayloadID, [32]byte, math.Slot](),
+		in.AttributesFeed,
 	)
 }
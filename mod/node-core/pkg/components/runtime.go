@@ -32,6 +32,8 @@ import (
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
@@ -39,10 +41,12 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
@@ -84,6 +88,8 @@ func ProvideRuntime(
 		*types.BeaconBlockBody,
 	],
 	blockFeed *event.FeedOf[*feed.Event[*types.BeaconBlock]],
+	payloadAttributesFeed *PayloadAttributesFeed,
+	blockStore *block.KVStore[*types.BeaconBlock],
 	chainSpec primitives.ChainSpec,
 	dbManagerService *manager.DBManager[
 		*types.BeaconBlock,
@@ -120,6 +126,8 @@ func ProvideRuntime(
 	localBuilder *payloadbuilder.PayloadBuilder[
 		BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
 	],
+	nodeAPIStateProvider *NodeAPIStateProvider,
+	blsToExecutionChangePool *pool.Pool[*types.SignedBLSToExecutionChange],
 	telemetrySink *metrics.TelemetrySink,
 	logger log.Logger,
 ) (*BeaconKitRuntime, error) {
@@ -151,6 +159,7 @@ func ProvideRuntime(
 		[]validator.PayloadBuilder[BeaconState, *types.ExecutionPayload]{
 			localBuilder,
 		},
+		blsToExecutionChangePool,
 		telemetrySink,
 	)
 
@@ -164,6 +173,7 @@ func ProvideRuntime(
 		*depositdb.KVStore[*types.Deposit],
 	](
 		storageBackend,
+		blockStore,
 		logger.With("service", "blockchain"),
 		chainSpec,
 		executionEngine,
@@ -175,6 +185,22 @@ func ProvideRuntime(
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		cfg.Validator.EnableOptimisticPayloadBuilds,
 	)
+	// Build the node API server.
+	nodeAPIServer := server.New(
+		cfg.NodeAPI,
+		logger.With("service", "node-api"),
+		backend.New(
+			chainSpec,
+			nodeAPIStateProvider,
+			blockStore,
+			executionEngine.OptimisticTracker(),
+			blsToExecutionChangePool,
+			signer,
+		),
+		blockFeed,
+		payloadAttributesFeed,
+	)
+
 	// Build the service registry.
 	svcRegistry := service.NewRegistry(
 		service.WithLogger(logger.With("service", "service-registry")),
@@ -188,6 +214,7 @@ func ProvideRuntime(
 			sdkversion.Version,
 		)),
 		service.WithService(dbManagerService),
+		service.WithService(nodeAPIServer),
 	)
 
 	// Pass all the services and options into the BeaconKitRuntime.
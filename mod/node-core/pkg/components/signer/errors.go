@@ -27,6 +27,13 @@ var (
 	// ErrInvalidSignature is returned when a signature is invalid.
 	ErrInvalidSignature = errors.New("invalid BLS signature")
 
+	// ErrInvalidPubkey is returned when a public key cannot be decoded.
+	ErrInvalidPubkey = errors.New("invalid BLS public key")
+
+	// ErrNoPubkeys is returned when an aggregate signature is verified
+	// against an empty set of public keys.
+	ErrNoPubkeys = errors.New("no public keys to verify against")
+
 	// ErrValidatorPrivateKeyRequired is returned when the validator private key
 	// is required but not provided.
 	ErrValidatorPrivateKeyRequired = errors.New(
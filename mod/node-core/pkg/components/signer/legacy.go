@@ -81,6 +81,16 @@ func (LegacySigner) VerifySignature(
 	return nil
 }
 
+// VerifyAggregateSignature verifies an aggregate signature against a message
+// and a set of public keys.
+func (LegacySigner) VerifyAggregateSignature(
+	pubKeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return verifyAggregateSignature(pubKeys, msg, signature)
+}
+
 // LegacyKey is a byte array that represents a BLS12-381 secret key.
 type LegacyKey [constants.BLSSecretKeyLength]byte
 
@@ -78,3 +78,13 @@ func (f BLSSigner) VerifySignature(
 	}
 	return nil
 }
+
+// VerifyAggregateSignature verifies an aggregate signature against a message
+// and a set of public keys.
+func (f BLSSigner) VerifyAggregateSignature(
+	pubKeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return verifyAggregateSignature(pubKeys, msg, signature)
+}
@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
@@ -39,6 +40,7 @@ type StateProcessorInput struct {
 	depinject.In
 	ChainSpec       primitives.ChainSpec
 	ExecutionEngine *execution.Engine[*types.ExecutionPayload]
+	Logger          log.Logger
 	Signer          crypto.BLSSigner
 }
 
@@ -54,6 +56,8 @@ func ProvideStateProcessor(
 	*types.Deposit,
 ] {
 	return core.NewStateProcessor[
+		*types.AttesterSlashing,
+		*types.SignedBLSToExecutionChange,
 		*types.BeaconBlock,
 		*types.BeaconBlockBody,
 		*types.BeaconBlockHeader,
@@ -66,12 +70,15 @@ func ProvideStateProcessor(
 		*types.ExecutionPayloadHeader,
 		*types.Fork,
 		*types.ForkData,
+		*types.ProposerSlashing,
 		*types.Validator,
+		*types.SignedVoluntaryExit,
 		*engineprimitives.Withdrawal,
 		types.WithdrawalCredentials,
 	](
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.Signer,
+		in.Logger.With("service", "state-processor"),
 	)
 }
@@ -25,9 +25,11 @@ import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	viperlib "github.com/berachain/beacon-kit/mod/node-core/pkg/config/viper"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
@@ -37,8 +39,10 @@ import (
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
+		BlockStore:     block.DefaultConfig(),
 		Engine:         engineclient.DefaultConfig(),
 		KZG:            kzg.DefaultConfig(),
+		NodeAPI:        server.DefaultConfig(),
 		PayloadBuilder: builder.DefaultConfig(),
 		Validator:      validator.DefaultConfig(),
 	}
@@ -46,10 +50,14 @@ func DefaultConfig() *Config {
 
 // Config is the main configuration struct for the BeaconKit chain.
 type Config struct {
+	// BlockStore is the configuration for the block store.
+	BlockStore block.Config `mapstructure:"block-store"`
 	// Engine is the configuration for the execution client.
 	Engine engineclient.Config `mapstructure:"engine"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// NodeAPI is the configuration for the node API server.
+	NodeAPI server.Config `mapstructure:"node-api"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
 	// Validator is the configuration for the validator client.
@@ -113,6 +121,9 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		"path to the execution client secret")
 	startCmd.Flags().String(
 		flags.RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url")
+	startCmd.Flags().String(
+		flags.RPCFallbackDialURLs, "",
+		"comma separated list of fallback rpc dial urls")
 	startCmd.Flags().Uint64(
 		flags.RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries")
 	startCmd.Flags().Duration(
@@ -124,6 +135,9 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Duration(flags.RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval")
+	startCmd.Flags().Duration(flags.RPCHealthCheckInteval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval")
 	startCmd.Flags().String(flags.SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
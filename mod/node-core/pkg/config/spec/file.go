@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/viper"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/mitchellh/mapstructure"
+	"gopkg.in/yaml.v3"
+)
+
+// forkScheduleKey is the key of the fork schedule, the only value of a chain
+// spec file that is not a scalar. It lists the forks in the order they are
+// activated, each of them mapping VERSION and EPOCH to their values.
+const forkScheduleKey = "FORK_SCHEDULE"
+
+// FileChainSpec loads the ChainSpec from a consensus-specs style YAML or
+// JSON file, which maps the upper snake case name of each value to the
+// value, e.g. `SLOTS_PER_EPOCH: 32`. Values that are not set by the file
+// default to the values of BaseSpec. The resulting spec is validated for
+// internal consistency.
+func FileChainSpec(path string) (chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+], error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]any
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		values, err = parseYAML(bz)
+	case ".json":
+		values, err = parseJSON(bz)
+	default:
+		return nil, errors.Wrap(ErrUnsupportedFormat, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := BaseSpec()
+	if err = decodeValues(values, &data); err != nil {
+		return nil, err
+	}
+	if err = data.Validate(); err != nil {
+		return nil, err
+	}
+	return chain.NewChainSpec(data), nil
+}
+
+// parseYAML parses the values of a YAML chain spec file. Every scalar is
+// kept as it is written, so that hex values such as domain types are not
+// interpreted as integers.
+func parseYAML(bz []byte) (map[string]any, error) {
+	var nodes map[string]yaml.Node
+	if err := yaml.Unmarshal(bz, &nodes); err != nil {
+		return nil, errors.Wrap(ErrInvalidValue, err.Error())
+	}
+
+	values := make(map[string]any, len(nodes))
+	for key, node := range nodes {
+		var err error
+		if strings.EqualFold(key, forkScheduleKey) {
+			var forks []map[string]string
+			err = node.Decode(&forks)
+			values[key] = forks
+		} else {
+			var value string
+			err = node.Decode(&value)
+			values[key] = value
+		}
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidValue, key)
+		}
+	}
+	return values, nil
+}
+
+// parseJSON parses the values of a JSON chain spec file, which are either
+// strings or numbers.
+func parseJSON(bz []byte) (map[string]any, error) {
+	var raw map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]any, len(raw))
+	for key, value := range raw {
+		if strings.EqualFold(key, forkScheduleKey) {
+			forks, err := parseJSONForkSchedule(value)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = forks
+			continue
+		}
+
+		scalar, ok := jsonScalar(value)
+		if !ok {
+			return nil, errors.Wrap(ErrInvalidValue, key)
+		}
+		values[key] = scalar
+	}
+	return values, nil
+}
+
+// parseJSONForkSchedule parses the forks of the fork schedule of a JSON
+// chain spec file.
+func parseJSONForkSchedule(value any) ([]map[string]string, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, errors.Wrap(ErrInvalidValue, forkScheduleKey)
+	}
+
+	forks := make([]map[string]string, len(list))
+	for i, item := range list {
+		fork, isMap := item.(map[string]any)
+		if !isMap {
+			return nil, errors.Wrap(ErrInvalidValue, forkScheduleKey)
+		}
+		forks[i] = make(map[string]string, len(fork))
+		for key, v := range fork {
+			if forks[i][key], ok = jsonScalar(v); !ok {
+				return nil, errors.Wrap(ErrInvalidValue, forkScheduleKey)
+			}
+		}
+	}
+	return forks, nil
+}
+
+// jsonScalar returns the value of a JSON string or number as it is written.
+func jsonScalar(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	default:
+		return "", false
+	}
+}
+
+// decodeValues decodes the values into the chain spec data. Only the values
+// that are part of the chain spec may be set. A fork schedule set by the
+// file replaces the base fork schedule as a whole.
+func decodeValues(
+	values map[string]any,
+	data *chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	],
+) error {
+	known := data.Values()
+	for key := range values {
+		if strings.EqualFold(key, forkScheduleKey) {
+			data.ForkSchedule = nil
+			continue
+		}
+		if _, ok := known[strings.ToUpper(key)]; !ok {
+			return errors.Wrap(ErrUnknownKey, key)
+		}
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       viper.StringToByteArrayFunc(),
+		WeaklyTypedInput: true,
+		ErrorUnused:      true,
+		// The keys are the upper snake case form of the struct tags.
+		MatchName: func(key, tag string) bool {
+			return strings.EqualFold(key, strings.ReplaceAll(tag, "-", "_"))
+		},
+		Result: data,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(values)
+}
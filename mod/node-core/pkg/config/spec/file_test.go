@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSpecFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestBaseSpec_Validate(t *testing.T) {
+	require.NoError(t, spec.BaseSpec().Validate())
+}
+
+func TestFileChainSpec_YAML(t *testing.T) {
+	path := writeSpecFile(t, "spec.yaml", `
+SLOTS_PER_EPOCH: 8
+DOMAIN_TYPE_BEACON_PROPOSER: 0x01000000
+FORK_SCHEDULE:
+  - VERSION: 4
+    EPOCH: 0
+DEPOSIT_REQUESTS_FORK_EPOCH: 18446744073709551615
+`)
+	cs, err := spec.FileChainSpec(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), cs.SlotsPerEpoch())
+	require.Equal(t, common.DomainType{0x01}, cs.DomainTypeProposer())
+
+	// Values that are not set by the file are the base values.
+	base := spec.BaseSpec()
+	require.Equal(t, base.MaxEffectiveBalance, cs.MaxEffectiveBalance())
+	require.Equal(t, "8", cs.Values()["SLOTS_PER_EPOCH"])
+	require.Equal(t, "0x01000000", cs.Values()["DOMAIN_TYPE_BEACON_PROPOSER"])
+
+	// The fork schedule replaces the base fork schedule.
+	require.Equal(t, []chain.ScheduledFork[math.Epoch]{
+		{Version: version.Deneb, Epoch: 0},
+	}, cs.ForkSchedule())
+}
+
+func TestFileChainSpec_JSON(t *testing.T) {
+	path := writeSpecFile(t, "spec.json", `{
+	"SLOTS_PER_EPOCH": "16",
+	"MAX_WITHDRAWALS_PER_PAYLOAD": 4,
+	"FORK_SCHEDULE": [
+		{"VERSION": 4, "EPOCH": "0"},
+		{"VERSION": "5", "EPOCH": 20}
+	],
+	"DEPOSIT_REQUESTS_FORK_EPOCH": 20
+}`)
+	cs, err := spec.FileChainSpec(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), cs.SlotsPerEpoch())
+	require.Equal(t, uint64(4), cs.MaxWithdrawalsPerPayload())
+	require.Equal(t, []chain.ScheduledFork[math.Epoch]{
+		{Version: version.Deneb, Epoch: 0},
+		{Version: version.Electra, Epoch: 20},
+	}, cs.ForkSchedule())
+	require.Equal(t, version.Electra, cs.ActiveForkVersionForEpoch(20))
+}
+
+func TestFileChainSpec_RoundTrip(t *testing.T) {
+	var content string
+	for key, value := range chain.NewChainSpec(spec.BaseSpec()).Values() {
+		content += key + ": " + value + "\n"
+	}
+	cs, err := spec.FileChainSpec(writeSpecFile(t, "spec.yml", content))
+	require.NoError(t, err)
+	require.Equal(
+		t, chain.NewChainSpec(spec.BaseSpec()).Values(), cs.Values(),
+	)
+}
+
+func TestFileChainSpec_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		file        string
+		content     string
+		expectedErr error
+	}{
+		{
+			name:        "unsupported format",
+			file:        "spec.toml",
+			content:     "SLOTS_PER_EPOCH = 8",
+			expectedErr: spec.ErrUnsupportedFormat,
+		},
+		{
+			name:        "unknown key",
+			file:        "spec.yaml",
+			content:     "SECONDS_PER_SLOT: 12",
+			expectedErr: spec.ErrUnknownKey,
+		},
+		{
+			name:        "non scalar value",
+			file:        "spec.json",
+			content:     `{"SLOTS_PER_EPOCH": [8]}`,
+			expectedErr: spec.ErrInvalidValue,
+		},
+		{
+			name:        "slots per historical root is not a power of two",
+			file:        "spec.yaml",
+			content:     "SLOTS_PER_HISTORICAL_ROOT: 100",
+			expectedErr: chain.ErrNotPowerOfTwo,
+		},
+		{
+			name:        "zero slots per epoch",
+			file:        "spec.yaml",
+			content:     "SLOTS_PER_EPOCH: 0",
+			expectedErr: chain.ErrZeroValue,
+		},
+		{
+			name:        "ejection balance above max effective balance",
+			file:        "spec.yaml",
+			content:     "EJECTION_BALANCE: 64000000000",
+			expectedErr: chain.ErrInconsistentValues,
+		},
+		{
+			name: "deposit requests before electra",
+			file: "spec.yaml",
+			content: "FORK_SCHEDULE: [{VERSION: 4, EPOCH: 0}, " +
+				"{VERSION: 5, EPOCH: 10}]\n" +
+				"DEPOSIT_REQUESTS_FORK_EPOCH: 9",
+			expectedErr: chain.ErrInconsistentValues,
+		},
+		{
+			name:        "fork schedule is not a list",
+			file:        "spec.json",
+			content:     `{"FORK_SCHEDULE": 4}`,
+			expectedErr: spec.ErrInvalidValue,
+		},
+		{
+			name:        "empty fork schedule",
+			file:        "spec.yaml",
+			content:     "FORK_SCHEDULE: []",
+			expectedErr: chain.ErrInvalidForkSchedule,
+		},
+		{
+			name:        "first fork after genesis",
+			file:        "spec.yaml",
+			content:     "FORK_SCHEDULE: [{VERSION: 4, EPOCH: 1}]",
+			expectedErr: chain.ErrInvalidForkSchedule,
+		},
+		{
+			name:        "unsupported fork version",
+			file:        "spec.yaml",
+			content:     "FORK_SCHEDULE: [{VERSION: 3, EPOCH: 0}]",
+			expectedErr: chain.ErrInvalidForkSchedule,
+		},
+		{
+			name: "fork versions out of order",
+			file: "spec.yaml",
+			content: "FORK_SCHEDULE: [{VERSION: 5, EPOCH: 0}, " +
+				"{VERSION: 4, EPOCH: 10}]",
+			expectedErr: chain.ErrInvalidForkSchedule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := spec.FileChainSpec(
+				writeSpecFile(t, tt.file, tt.content),
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
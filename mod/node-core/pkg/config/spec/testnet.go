@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	cmttypes "github.com/cometbft/cometbft/types"
 )
 
@@ -60,14 +61,24 @@ func BaseSpec() chain.SpecData[
 		any,
 	]{
 		// // Gwei value constants.
-		MinDepositAmount:          uint64(1e9),
-		MaxEffectiveBalance:       uint64(32e9),
-		EjectionBalance:           uint64(16e9),
-		EffectiveBalanceIncrement: uint64(1e9),
+		MinDepositAmount:             uint64(1e9),
+		MaxEffectiveBalance:          uint64(32e9),
+		EjectionBalance:              uint64(16e9),
+		EffectiveBalanceIncrement:    uint64(1e9),
+		HysteresisQuotient:           4,
+		HysteresisDownwardMultiplier: 1,
+		HysteresisUpwardMultiplier:   5,
 		// Time parameters constants.
-		SlotsPerEpoch:                32,
-		MinEpochsToInactivityPenalty: 4,
-		SlotsPerHistoricalRoot:       8,
+		SlotsPerEpoch:                    32,
+		MinEpochsToInactivityPenalty:     4,
+		SlotsPerHistoricalRoot:           8,
+		MaxSeedLookahead:                 4,
+		MinValidatorWithdrawabilityDelay: 256,
+		ShardCommitteePeriod:             256,
+		// Validator cycle.
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    1 << 16,
+		MaxValidatorSetSize:   256,
 		// Signature domains.
 		DomainTypeProposer: common.DomainType{
 			0x00, 0x00, 0x00, 0x00,
@@ -90,6 +101,9 @@ func BaseSpec() chain.SpecData[
 		DomainTypeAggregateAndProof: common.DomainType{
 			0x06, 0x00, 0x00, 0x00,
 		},
+		DomainTypeBLSToExecutionChange: common.DomainType{
+			0x0a, 0x00, 0x00, 0x00,
+		},
 		DomainTypeApplicationMask: common.DomainType{
 			0x00, 0x00, 0x00, 0x01,
 		},
@@ -101,16 +115,30 @@ func BaseSpec() chain.SpecData[
 		Eth1FollowDistance:        1,
 		TargetSecondsPerEth1Block: 3,
 		// Fork-related values.
-		ElectraForkEpoch: 9999999999999999,
+		ForkSchedule: []chain.ScheduledFork[math.Epoch]{
+			{Version: version.Deneb, Epoch: 0},
+			{Version: version.Electra, Epoch: 9999999999999999},
+		},
+		DepositRequestsForkEpoch: 9999999999999999,
 		// State list length constants.
 		EpochsPerHistoricalVector: 8,
 		EpochsPerSlashingsVector:  8,
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
 		// Max operations per block constants.
-		MaxDepositsPerBlock: 16,
+		MaxDepositsPerBlock:              16,
+		MaxProposerSlashingsPerBlock:     16,
+		MaxAttesterSlashingsPerBlock:     2,
+		MaxVoluntaryExitsPerBlock:        16,
+		MaxBLSToExecutionChangesPerBlock: 16,
+		// Rewards and penalties.
+		BaseRewardFactor:          64,
+		InactivityPenaltyQuotient: 1 << 24,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     128,
+		WhistleblowerRewardQuotient:    512,
+		ProposerRewardQuotient:         8,
 		// Capella values.
 		MaxWithdrawalsPerPayload:         16,
 		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
@@ -26,10 +26,20 @@ const Template = `
 ###                                BeaconKit                                ###
 ###############################################################################
 
+[beacon-kit.block-store]
+# Number of slots for which finalized blocks are kept in the block store.
+# Older blocks are pruned, unless set to 0.
+availability-window = {{ .BeaconKit.BlockStore.AvailabilityWindow }}
+
 [beacon-kit.engine]
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
+# Comma separated list of additional execution client JSON-RPC endpoints. Engine
+# API calls are sent to every endpoint and the primary fails over to them when
+# it becomes unhealthy.
+rpc-fallback-dial-urls = "{{ range $i, $url := .BeaconKit.Engine.RPCFallbackDialURLs }}{{ if $i }},{{ end }}{{ $url }}{{ end }}"
+
 # Number of retries before shutting down consensus client.
 rpc-retries = "{{.BeaconKit.Engine.RPCRetries}}"
 
@@ -42,6 +52,9 @@ rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Interval for the execution client endpoint health check.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
@@ -53,6 +66,17 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+[beacon-kit.node-api]
+# Enabled determines if the node API server is enabled.
+enabled = {{ .BeaconKit.NodeAPI.Enabled }}
+
+# Address the node API server listens on.
+address = "{{ .BeaconKit.NodeAPI.Address }}"
+
+# Comma separated list of origins that are allowed to make cross origin
+# requests to the node API server.
+allow-origins = "{{ range $i, $origin := .BeaconKit.NodeAPI.AllowOrigins }}{{ if $i }},{{ end }}{{ $origin }}{{ end }}"
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	beaconurl "github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -82,3 +83,29 @@ func StringTo[T any](
 		return constructor(data.(string))
 	}
 }
+
+// StringToByteArrayFunc returns a DecodeHookFunc that converts a 0x prefixed
+// hex string to a byte array of the same length, such as a domain type.
+func StringToByteArrayFunc() mapstructure.DecodeHookFunc {
+	return func(
+		f reflect.Type,
+		t reflect.Type,
+		data interface{},
+	) (interface{}, error) {
+		if f.Kind() != reflect.String || t.Kind() != reflect.Array ||
+			t.Elem().Kind() != reflect.Uint8 {
+			return data, nil
+		}
+
+		bz, err := hexutil.Decode(data.(string))
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != t.Len() {
+			return nil, ErrInvalidByteArrayLength
+		}
+		arr := reflect.New(t).Elem()
+		reflect.Copy(arr, reflect.ValueOf(bz))
+		return arr.Interface(), nil
+	}
+}
@@ -21,7 +21,13 @@
 package builder
 
 import (
+	"context"
+
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -35,7 +41,7 @@ func (pb *PayloadBuilder[
 	slot math.Slot,
 	timestamp uint64,
 	prevHeadRoot [32]byte,
-) (engineprimitives.PayloadAttributer, error) {
+) (*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal], error) {
 	var (
 		prevRandao [32]byte
 	)
@@ -70,3 +76,40 @@ func (pb *PayloadBuilder[
 		prevHeadRoot,
 	)
 }
+
+// publishPayloadAttributes publishes the payload attributes that were sent to
+// the execution client to build a payload for the given slot.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) publishPayloadAttributes(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	parentBlockRoot primitives.Root,
+	parentEth1Hash common.ExecutionHash,
+	attrs *engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+) {
+	// The payload is built on top of the latest execution payload of the
+	// state, which provides the parent block number.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		pb.logger.Error(
+			"Could not get latest execution payload header to publish "+
+				"payload attributes",
+			"error", err,
+		)
+		return
+	}
+
+	pb.attributesFeed.Send(feed.NewEvent(
+		ctx,
+		events.PayloadAttributesSent,
+		&engineprimitives.PayloadAttributesEvent{
+			ProposalSlot:      slot,
+			ParentBlockRoot:   parentBlockRoot,
+			ParentBlockHash:   parentEth1Hash,
+			ParentBlockNumber: lph.GetNumber(),
+			Attributes:        attrs,
+		},
+	))
+}
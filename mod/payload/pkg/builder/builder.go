@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -43,6 +44,7 @@ type PayloadBuilder[
 	ExecutionPayloadHeaderT interface {
 		GetBlockHash() common.ExecutionHash
 		GetParentHash() common.ExecutionHash
+		GetNumber() math.U64
 	},
 ] struct {
 	// cfg holds the configuration settings for the PayloadBuilder.
@@ -59,6 +61,9 @@ type PayloadBuilder[
 	pc *cache.PayloadIDCache[
 		engineprimitves.PayloadID, [32]byte, math.Slot,
 	]
+	// attributesFeed is the feed the payload attributes are published on
+	// once they have been sent to the execution client.
+	attributesFeed EventFeed[*feed.Event[*engineprimitves.PayloadAttributesEvent]]
 }
 
 // NewService creates a new service.
@@ -74,6 +79,7 @@ func New[
 	ExecutionPayloadHeaderT interface {
 		GetBlockHash() common.ExecutionHash
 		GetParentHash() common.ExecutionHash
+		GetNumber() math.U64
 	},
 ](
 	cfg *Config,
@@ -83,17 +89,19 @@ func New[
 	pc *cache.PayloadIDCache[
 		engineprimitves.PayloadID, [32]byte, math.Slot,
 	],
+	attributesFeed EventFeed[*feed.Event[*engineprimitves.PayloadAttributesEvent]],
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ] {
 	return &PayloadBuilder[
 		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	]{
-		cfg:       cfg,
-		chainSpec: chainSpec,
-		logger:    logger,
-		ee:        ee,
-		pc:        pc,
+		cfg:            cfg,
+		chainSpec:      chainSpec,
+		logger:         logger,
+		ee:             ee,
+		pc:             pc,
+		attributesFeed: attributesFeed,
 	}
 }
 
@@ -97,6 +97,9 @@ func (pb *PayloadBuilder[
 			payloadID,
 		)
 		pb.pc.Set(slot, parentBlockRoot, *payloadID)
+		pb.publishPayloadAttributes(
+			ctx, st, slot, parentBlockRoot, headEth1BlockHash, attrs,
+		)
 	}
 
 	return payloadID, nil
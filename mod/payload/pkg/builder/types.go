@@ -35,6 +35,7 @@ import (
 type BeaconState[ExecutionPayloadHeaderT interface {
 	GetBlockHash() common.ExecutionHash
 	GetParentHash() common.ExecutionHash
+	GetNumber() math.U64
 }] interface {
 	// GetRandaoMixAtIndex retrieves the RANDAO mix at a specified index.
 	GetRandaoMixAtIndex(uint64) (primitives.Bytes32, error)
@@ -66,3 +67,10 @@ type ExecutionEngine[ExecutionPayloadT any] interface {
 		req *engineprimitives.ForkchoiceUpdateRequest,
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
 }
+
+// EventFeed is a generic interface for sending events.
+type EventFeed[EventT any] interface {
+	// Send sends an event and returns the number of
+	// subscribers that received it.
+	Send(event EventT) int
+}
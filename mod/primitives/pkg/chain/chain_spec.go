@@ -20,6 +20,8 @@
 
 package chain
 
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+
 // Spec defines an interface for accessing chain-specific parameters.
 type Spec[
 	DomainTypeT ~[4]byte,
@@ -41,6 +43,15 @@ type Spec[
 	// EffectiveBalanceIncrement returns the increment of balance used in reward
 	// calculations.
 	EffectiveBalanceIncrement() uint64
+	// HysteresisQuotient returns the quotient used to derive the hysteresis
+	// increment from the effective balance increment.
+	HysteresisQuotient() uint64
+	// HysteresisDownwardMultiplier returns the multiplier applied to the
+	// hysteresis increment when lowering an effective balance.
+	HysteresisDownwardMultiplier() uint64
+	// HysteresisUpwardMultiplier returns the multiplier applied to the
+	// hysteresis increment when raising an effective balance.
+	HysteresisUpwardMultiplier() uint64
 
 	// Time parameters constants.
 	//
@@ -51,6 +62,27 @@ type Spec[
 	// MinEpochsToInactivityPenalty returns the minimum number of epochs before
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
+	// MaxSeedLookahead returns the number of epochs between the epoch in
+	// which an activation or exit is processed and the epoch it takes effect.
+	MaxSeedLookahead() uint64
+	// MinValidatorWithdrawabilityDelay returns the minimum number of epochs
+	// between the exit of a validator and the epoch it becomes withdrawable.
+	MinValidatorWithdrawabilityDelay() uint64
+	// ShardCommitteePeriod returns the minimum number of epochs a validator
+	// must be active for before it can voluntarily exit.
+	ShardCommitteePeriod() uint64
+
+	// Validator cycle.
+	//
+	// MinPerEpochChurnLimit returns the minimum number of validators that can
+	// be activated or exited per epoch.
+	MinPerEpochChurnLimit() uint64
+	// ChurnLimitQuotient returns the quotient applied to the number of active
+	// validators to compute the churn limit.
+	ChurnLimitQuotient() uint64
+	// MaxValidatorSetSize returns the maximum number of validators in the
+	// CometBFT validator set.
+	MaxValidatorSetSize() uint64
 
 	// Signature Domains
 	//
@@ -68,6 +100,9 @@ type Spec[
 	DomainTypeSelectionProof() DomainTypeT
 	// DomainTypeAggregateAndProof returns the domain for aggregate and proof
 	DomainTypeAggregateAndProof() DomainTypeT
+	// DomainTypeBLSToExecutionChange returns the domain for BLS to execution
+	// change signatures.
+	DomainTypeBLSToExecutionChange() DomainTypeT
 	// DomainTypeApplicationMask returns the domain for application signatures.
 	DomainTypeApplicationMask() DomainTypeT
 
@@ -86,11 +121,35 @@ type Spec[
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
 
+	// Max operations per block.
+	//
+	// MaxProposerSlashingsPerBlock returns the maximum number of proposer
+	// slashing operations per block.
+	MaxProposerSlashingsPerBlock() uint64
+	// MaxAttesterSlashingsPerBlock returns the maximum number of attester
+	// slashing operations per block.
+	MaxAttesterSlashingsPerBlock() uint64
+	// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exit
+	// operations per block.
+	MaxVoluntaryExitsPerBlock() uint64
+	// MaxBLSToExecutionChangesPerBlock returns the maximum number of BLS to
+	// execution change operations per block.
+	MaxBLSToExecutionChangesPerBlock() uint64
+
 	// Fork-related values.
 	//
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// DepositRequestsForkEpoch returns the epoch from which deposits are
+	// processed from the deposit requests of the execution payload.
+	DepositRequestsForkEpoch() EpochT
+	// ForkSchedule returns the forks of the chain in the order they are
+	// activated.
+	ForkSchedule() []ScheduledFork[EpochT]
+	// ForkAtEpoch returns the fork that is active at the given epoch and the
+	// fork that was active before it.
+	ForkAtEpoch(epoch EpochT) (ScheduledFork[EpochT], ScheduledFork[EpochT])
 
 	// State list lengths
 	//
@@ -107,11 +166,23 @@ type Spec[
 
 	// Rewards and Penalties
 	//
+	// BaseRewardFactor returns the factor used to scale the base reward of a
+	// validator.
+	BaseRewardFactor() uint64
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
 	InactivityPenaltyQuotient() uint64
 	// ProportionalSlashingMultiplier returns the multiplier for calculating
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
+	// MinSlashingPenaltyQuotient returns the quotient used to compute the
+	// initial slashing penalty.
+	MinSlashingPenaltyQuotient() uint64
+	// WhistleblowerRewardQuotient returns the quotient used to compute the
+	// whistleblower reward.
+	WhistleblowerRewardQuotient() uint64
+	// ProposerRewardQuotient returns the quotient used to compute the
+	// proposer's share of the whistleblower reward.
+	ProposerRewardQuotient() uint64
 
 	// Capella Values
 	//
@@ -153,6 +224,10 @@ type Spec[
 
 	// CometBFT Consensus
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
+
+	// Values returns the chain spec values keyed by their consensus-specs
+	// style name.
+	Values() map[string]string
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -215,6 +290,27 @@ func (c chainSpec[
 	return c.Data.EffectiveBalanceIncrement
 }
 
+// HysteresisQuotient returns the hysteresis quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisQuotient() uint64 {
+	return c.Data.HysteresisQuotient
+}
+
+// HysteresisDownwardMultiplier returns the hysteresis downward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisDownwardMultiplier() uint64 {
+	return c.Data.HysteresisDownwardMultiplier
+}
+
+// HysteresisUpwardMultiplier returns the hysteresis upward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisUpwardMultiplier() uint64 {
+	return c.Data.HysteresisUpwardMultiplier
+}
+
 // SlotsPerEpoch returns the number of slots per epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -237,6 +333,50 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MaxSeedLookahead returns the maximum seed lookahead.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxSeedLookahead() uint64 {
+	return c.Data.MaxSeedLookahead
+}
+
+// MinValidatorWithdrawabilityDelay returns the minimum number of epochs
+// between the exit of a validator and the epoch it becomes withdrawable.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// ShardCommitteePeriod returns the minimum number of epochs a validator must
+// be active for before it can voluntarily exit.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ShardCommitteePeriod() uint64 {
+	return c.Data.ShardCommitteePeriod
+}
+
+// MinPerEpochChurnLimit returns the minimum churn limit per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the churn limit quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
+// MaxValidatorSetSize returns the maximum size of the validator set.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxValidatorSetSize() uint64 {
+	return c.Data.MaxValidatorSetSize
+}
+
 // DomainProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -287,6 +427,14 @@ func (c chainSpec[
 	return c.Data.DomainTypeAggregateAndProof
 }
 
+// DomainTypeBLSToExecutionChange returns the domain for BLS to execution
+// change signatures.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DomainTypeBLSToExecutionChange() DomainTypeT {
+	return c.Data.DomainTypeBLSToExecutionChange
+}
+
 // DomainTypeApplicationMask returns the domain for the application mask.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -330,11 +478,52 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
-// ElectraForkEpoch returns the epoch of the Electra fork.
+// MaxProposerSlashingsPerBlock returns the maximum number of proposer
+// slashings per block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxProposerSlashingsPerBlock() uint64 {
+	return c.Data.MaxProposerSlashingsPerBlock
+}
+
+// MaxAttesterSlashingsPerBlock returns the maximum number of attester
+// slashings per block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxAttesterSlashingsPerBlock() uint64 {
+	return c.Data.MaxAttesterSlashingsPerBlock
+}
+
+// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exits per
+// block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxVoluntaryExitsPerBlock() uint64 {
+	return c.Data.MaxVoluntaryExitsPerBlock
+}
+
+// MaxBLSToExecutionChangesPerBlock returns the maximum number of BLS to
+// execution changes per block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxBLSToExecutionChangesPerBlock() uint64 {
+	return c.Data.MaxBLSToExecutionChangesPerBlock
+}
+
+// ElectraForkEpoch returns the epoch of the Electra fork, or the far future
+// epoch if the Electra fork is not scheduled.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ElectraForkEpoch() EpochT {
-	return c.Data.ElectraForkEpoch
+	return c.Data.forkEpoch(version.Electra)
+}
+
+// DepositRequestsForkEpoch returns the epoch from which deposits are
+// processed from execution payload deposit requests.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DepositRequestsForkEpoch() EpochT {
+	return c.Data.DepositRequestsForkEpoch
 }
 
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
@@ -365,6 +554,13 @@ func (c chainSpec[
 	return c.Data.ValidatorRegistryLimit
 }
 
+// BaseRewardFactor returns the base reward factor.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) BaseRewardFactor() uint64 {
+	return c.Data.BaseRewardFactor
+}
+
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -379,6 +575,27 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the minimum slashing penalty quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
+// WhistleblowerRewardQuotient returns the whistleblower reward quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) WhistleblowerRewardQuotient() uint64 {
+	return c.Data.WhistleblowerRewardQuotient
+}
+
+// ProposerRewardQuotient returns the proposer reward quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ProposerRewardQuotient() uint64 {
+	return c.Data.ProposerRewardQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
@@ -439,3 +656,11 @@ func (c chainSpec[
 ]) GetCometBFTConfigForSlot(_ SlotT) CometBFTConfigT {
 	return c.Data.CometValues
 }
+
+// Values returns the chain spec values keyed by their consensus-specs style
+// name.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Values() map[string]string {
+	return c.Data.Values()
+}
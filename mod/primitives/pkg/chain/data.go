@@ -42,6 +42,15 @@ type SpecData[
 	EjectionBalance uint64 `mapstructure:"ejection-balance"`
 	// EffectiveBalanceIncrement is the effective balance increment.
 	EffectiveBalanceIncrement uint64 `mapstructure:"effective-balance-increment"`
+	// HysteresisQuotient is the quotient used to derive the hysteresis
+	// increment from the effective balance increment.
+	HysteresisQuotient uint64 `mapstructure:"hysteresis-quotient"`
+	// HysteresisDownwardMultiplier is the number of hysteresis increments
+	// a balance must drop below the effective balance to lower it.
+	HysteresisDownwardMultiplier uint64 `mapstructure:"hysteresis-downward-multiplier"`
+	// HysteresisUpwardMultiplier is the number of hysteresis increments a
+	// balance must rise above the effective balance to raise it.
+	HysteresisUpwardMultiplier uint64 `mapstructure:"hysteresis-upward-multiplier"`
 
 	// Time parameters constants.
 	//
@@ -52,6 +61,27 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// MaxSeedLookahead is the number of epochs between the epoch in which an
+	// activation or exit is processed and the epoch it takes effect.
+	MaxSeedLookahead uint64 `mapstructure:"max-seed-lookahead"`
+	// MinValidatorWithdrawabilityDelay is the minimum number of epochs
+	// between the exit of a validator and the epoch it becomes withdrawable.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+	// ShardCommitteePeriod is the minimum number of epochs a validator must
+	// be active for before it can voluntarily exit.
+	ShardCommitteePeriod uint64 `mapstructure:"shard-committee-period"`
+
+	// Validator cycle.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that can be
+	// activated or exited per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the quotient applied to the number of active
+	// validators to compute the churn limit.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+	// MaxValidatorSetSize is the maximum number of validators in the
+	// CometBFT validator set.
+	MaxValidatorSetSize uint64 `mapstructure:"max-validator-set-size"`
 
 	// Signature domains.
 	//
@@ -71,6 +101,9 @@ type SpecData[
 	// DomainTypeAggregateAndProof is the domain for aggregate and proof
 	// signatures.
 	DomainTypeAggregateAndProof DomainTypeT `mapstructure:"domain-type-aggregate-and-proof"`
+	// DomainTypeBLSToExecutionChange is the domain for BLS to execution
+	// change signatures.
+	DomainTypeBLSToExecutionChange DomainTypeT `mapstructure:"domain-type-bls-to-execution-change"`
 	// DomainTypeApplicationMask is the domain for the application mask.
 	DomainTypeApplicationMask DomainTypeT `mapstructure:"domain-type-application-mask"`
 
@@ -89,10 +122,30 @@ type SpecData[
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
 
+	// Max operations per block.
+	//
+	// MaxProposerSlashingsPerBlock specifies the maximum number of proposer
+	// slashing operations allowed per block.
+	MaxProposerSlashingsPerBlock uint64 `mapstructure:"max-proposer-slashings-per-block"`
+	// MaxAttesterSlashingsPerBlock specifies the maximum number of attester
+	// slashing operations allowed per block.
+	MaxAttesterSlashingsPerBlock uint64 `mapstructure:"max-attester-slashings-per-block"`
+	// MaxVoluntaryExitsPerBlock specifies the maximum number of voluntary
+	// exit operations allowed per block.
+	MaxVoluntaryExitsPerBlock uint64 `mapstructure:"max-voluntary-exits-per-block"`
+	// MaxBLSToExecutionChangesPerBlock specifies the maximum number of BLS to
+	// execution change operations allowed per block.
+	MaxBLSToExecutionChangesPerBlock uint64 `mapstructure:"max-bls-to-execution-changes-per-block"`
+
 	// Fork-related values.
 	//
-	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
-	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// ForkSchedule is the forks of the chain in the order they are
+	// activated. The first fork is active from genesis.
+	ForkSchedule []ScheduledFork[EpochT] `mapstructure:"fork-schedule"`
+	// DepositRequestsForkEpoch is the epoch from which deposits are taken
+	// from the deposit requests of the execution payload instead of the
+	// deposit contract logs.
+	DepositRequestsForkEpoch EpochT `mapstructure:"deposit-requests-fork-epoch"`
 
 	// State list lengths
 	//
@@ -109,11 +162,23 @@ type SpecData[
 
 	// Rewards and penalties constants.
 	//
+	// BaseRewardFactor is the factor used to scale the base reward of a
+	// validator.
+	BaseRewardFactor uint64 `mapstructure:"base-reward-factor"`
 	// InactivityPenaltyQuotient is the inactivity penalty quotient.
 	InactivityPenaltyQuotient uint64 `mapstructure:"inactivity-penalty-quotient"`
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient applied to the effective
+	// balance of a validator to compute its initial slashing penalty.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// WhistleblowerRewardQuotient is the quotient applied to the effective
+	// balance of a slashed validator to compute the whistleblower reward.
+	WhistleblowerRewardQuotient uint64 `mapstructure:"whistleblower-reward-quotient"`
+	// ProposerRewardQuotient is the quotient applied to the whistleblower
+	// reward to compute the share paid to the block proposer.
+	ProposerRewardQuotient uint64 `mapstructure:"proposer-reward-quotient"`
 
 	// Capella Values
 	//
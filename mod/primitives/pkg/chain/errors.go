@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrZeroValue is returned when a chain spec value that must be
+	// non-zero is zero.
+	ErrZeroValue = errors.New("chain spec value must not be zero")
+	// ErrNotPowerOfTwo is returned when a chain spec value that must be a
+	// power of two is not.
+	ErrNotPowerOfTwo = errors.New("chain spec value must be a power of two")
+	// ErrInconsistentValues is returned when chain spec values contradict
+	// each other.
+	ErrInconsistentValues = errors.New("chain spec values are inconsistent")
+	// ErrInvalidForkSchedule is returned when the fork schedule cannot be
+	// followed by the chain.
+	ErrInvalidForkSchedule = errors.New("fork schedule is invalid")
+)
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
+// ScheduledFork is a fork of the chain that is activated at a given epoch.
+type ScheduledFork[EpochT ~uint64] struct {
+	// Version is the fork version, as enumerated in the version package.
+	Version uint32 `mapstructure:"version"`
+	// Epoch is the epoch at which the fork is activated.
+	Epoch EpochT `mapstructure:"epoch"`
+}
+
+// forkEpoch returns the epoch at which the fork with the given version is
+// activated, or the far future epoch if the fork is not scheduled.
+func (d SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) forkEpoch(forkVersion uint32) EpochT {
+	for _, fork := range d.ForkSchedule {
+		if fork.Version == forkVersion {
+			return fork.Epoch
+		}
+	}
+	return EpochT(constants.FarFutureEpoch)
+}
+
+// ForkSchedule returns the forks of the chain in the order they are
+// activated.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ForkSchedule() []ScheduledFork[EpochT] {
+	return c.Data.ForkSchedule
+}
+
+// ForkAtEpoch returns the fork that is active at the given epoch and the
+// fork that was active before it. The genesis fork is its own predecessor.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ForkAtEpoch(
+	epoch EpochT,
+) (ScheduledFork[EpochT], ScheduledFork[EpochT]) {
+	schedule := c.ForkSchedule()
+	if len(schedule) == 0 {
+		return ScheduledFork[EpochT]{}, ScheduledFork[EpochT]{}
+	}
+	for i := len(schedule) - 1; i > 0; i-- {
+		if epoch >= schedule[i].Epoch {
+			return schedule[i-1], schedule[i]
+		}
+	}
+	return schedule[0], schedule[0]
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduleSpec(
+	schedule ...chain.ScheduledFork[math.Epoch],
+) chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch: 32,
+		ForkSchedule:  schedule,
+	})
+}
+
+func newForkSpec(electraForkEpoch math.Epoch) chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return newScheduleSpec(
+		chain.ScheduledFork[math.Epoch]{Version: version.Deneb, Epoch: 0},
+		chain.ScheduledFork[math.Epoch]{
+			Version: version.Electra, Epoch: electraForkEpoch,
+		},
+	)
+}
+
+func TestForkSchedule(t *testing.T) {
+	cs := newForkSpec(4)
+	require.Equal(t, []chain.ScheduledFork[math.Epoch]{
+		{Version: version.Deneb, Epoch: 0},
+		{Version: version.Electra, Epoch: 4},
+	}, cs.ForkSchedule())
+	require.Equal(t, math.Epoch(4), cs.ElectraForkEpoch())
+}
+
+func TestForkSchedule_SingleFork(t *testing.T) {
+	// A chain that starts at Electra has no prior fork.
+	cs := newScheduleSpec(
+		chain.ScheduledFork[math.Epoch]{Version: version.Electra, Epoch: 0},
+	)
+	previous, active := cs.ForkAtEpoch(100)
+	require.Equal(t, version.Electra, previous.Version)
+	require.Equal(t, version.Electra, active.Version)
+	require.Equal(t, math.Epoch(0), cs.ElectraForkEpoch())
+
+	// A chain that never upgrades to Electra.
+	cs = newScheduleSpec(
+		chain.ScheduledFork[math.Epoch]{Version: version.Deneb, Epoch: 0},
+	)
+	require.Equal(t, version.Deneb, cs.ActiveForkVersionForEpoch(100))
+	require.Equal(
+		t, math.Epoch(constants.FarFutureEpoch), cs.ElectraForkEpoch(),
+	)
+}
+
+func TestActiveForkVersion_CrossesForkBoundary(t *testing.T) {
+	cs := newForkSpec(4)
+
+	tests := []struct {
+		slot     math.Slot
+		expected uint32
+	}{
+		{slot: 0, expected: version.Deneb},
+		{slot: 4*32 - 1, expected: version.Deneb},
+		{slot: 4 * 32, expected: version.Electra},
+		{slot: 100 * 32, expected: version.Electra},
+	}
+	for _, tt := range tests {
+		require.Equal(
+			t, tt.expected, cs.ActiveForkVersionForSlot(tt.slot),
+			"slot %d", tt.slot,
+		)
+	}
+}
+
+func TestForkAtEpoch(t *testing.T) {
+	cs := newForkSpec(4)
+
+	previous, active := cs.ForkAtEpoch(3)
+	require.Equal(t, version.Deneb, previous.Version)
+	require.Equal(t, version.Deneb, active.Version)
+
+	previous, active = cs.ForkAtEpoch(4)
+	require.Equal(t, version.Deneb, previous.Version)
+	require.Equal(t, version.Electra, active.Version)
+	require.Equal(t, math.Epoch(4), active.Epoch)
+}
+
+func TestForkAtEpoch_ForkAtGenesis(t *testing.T) {
+	cs := newForkSpec(0)
+
+	previous, active := cs.ForkAtEpoch(0)
+	require.Equal(t, version.Deneb, previous.Version)
+	require.Equal(t, version.Electra, active.Version)
+}
@@ -20,10 +20,6 @@
 
 package chain
 
-import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
-)
-
 // ActiveForkVersion returns the active fork version for a given slot.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -33,17 +29,15 @@ func (c chainSpec[
 	return c.ActiveForkVersionForEpoch(c.SlotToEpoch(slot))
 }
 
-// ActiveForkVersionBySlot returns the active fork version for a given epoch.
+// ActiveForkVersionForEpoch returns the active fork version for a given
+// epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ActiveForkVersionForEpoch(
 	epoch EpochT,
 ) uint32 {
-	if epoch >= c.Data.ElectraForkEpoch {
-		return version.Electra
-	}
-
-	return version.Deneb
+	_, active := c.ForkAtEpoch(epoch)
+	return active.Version
 }
 
 // SlotToEpoch converts a slot to an epoch.
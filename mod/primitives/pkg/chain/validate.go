@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Validate checks that the chain spec values are consistent with each other.
+func (d SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Validate() error {
+	// Values that are divided by or used as the length of a ring buffer.
+	for _, v := range []specValue{
+		{"SLOTS_PER_EPOCH", d.SlotsPerEpoch},
+		{"EFFECTIVE_BALANCE_INCREMENT", d.EffectiveBalanceIncrement},
+		{"HYSTERESIS_QUOTIENT", d.HysteresisQuotient},
+		{"CHURN_LIMIT_QUOTIENT", d.ChurnLimitQuotient},
+		{"EPOCHS_PER_SLASHINGS_VECTOR", d.EpochsPerSlashingsVector},
+		{"MIN_SLASHING_PENALTY_QUOTIENT", d.MinSlashingPenaltyQuotient},
+		{"WHISTLEBLOWER_REWARD_QUOTIENT", d.WhistleblowerRewardQuotient},
+		{"PROPOSER_REWARD_QUOTIENT", d.ProposerRewardQuotient},
+		{"MAX_VALIDATOR_SET_SIZE", d.MaxValidatorSetSize},
+		{"MAX_WITHDRAWALS_PER_PAYLOAD", d.MaxWithdrawalsPerPayload},
+	} {
+		if v.value == 0 {
+			return errors.Wrap(ErrZeroValue, v.name)
+		}
+	}
+
+	// Ring buffers that are merkleized as vectors of the given length.
+	for _, v := range []specValue{
+		{"SLOTS_PER_HISTORICAL_ROOT", d.SlotsPerHistoricalRoot},
+		{"EPOCHS_PER_HISTORICAL_VECTOR", d.EpochsPerHistoricalVector},
+	} {
+		if !isPowerOfTwo(v.value) {
+			return errors.Wrap(ErrNotPowerOfTwo, v.name)
+		}
+	}
+
+	if err := d.validateForkSchedule(); err != nil {
+		return err
+	}
+
+	switch {
+	case d.DepositRequestsForkEpoch < d.forkEpoch(version.Electra):
+		// Deposit requests are only part of Electra execution payloads.
+		return errors.Wrap(
+			ErrInconsistentValues,
+			"DEPOSIT_REQUESTS_FORK_EPOCH is before the Electra fork epoch",
+		)
+	case d.MinDepositAmount > d.MaxEffectiveBalance:
+		return errors.Wrap(
+			ErrInconsistentValues,
+			"MIN_DEPOSIT_AMOUNT exceeds MAX_EFFECTIVE_BALANCE",
+		)
+	case d.EjectionBalance >= d.MaxEffectiveBalance:
+		return errors.Wrap(
+			ErrInconsistentValues,
+			"EJECTION_BALANCE must be below MAX_EFFECTIVE_BALANCE",
+		)
+	case d.MaxEffectiveBalance%d.EffectiveBalanceIncrement != 0:
+		return errors.Wrap(
+			ErrInconsistentValues,
+			"MAX_EFFECTIVE_BALANCE must be a multiple of "+
+				"EFFECTIVE_BALANCE_INCREMENT",
+		)
+	case d.MaxBlobsPerBlock > d.MaxBlobCommitmentsPerBlock:
+		return errors.Wrap(
+			ErrInconsistentValues,
+			"MAX_BLOBS_PER_BLOCK exceeds MAX_BLOB_COMMITMENTS_PER_BLOCK",
+		)
+	case d.BytesPerBlob != d.FieldElementsPerBlob*bytesPerFieldElement:
+		return errors.Wrap(
+			ErrInconsistentValues,
+			"BYTES_PER_BLOB must match FIELD_ELEMENTS_PER_BLOB",
+		)
+	}
+	return nil
+}
+
+// validateForkSchedule checks that the fork schedule starts at genesis with
+// a supported fork and that every later fork succeeds the one before it.
+func (d SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) validateForkSchedule() error {
+	schedule := d.ForkSchedule
+	switch {
+	case len(schedule) == 0:
+		return errors.Wrap(ErrInvalidForkSchedule, "FORK_SCHEDULE is empty")
+	case schedule[0].Epoch != EpochT(constants.GenesisEpoch):
+		return errors.Wrap(
+			ErrInvalidForkSchedule,
+			"the first fork of FORK_SCHEDULE must be active from genesis",
+		)
+	}
+
+	for i, fork := range schedule {
+		switch {
+		case fork.Version < version.Deneb || fork.Version > version.Electra:
+			return errors.Wrapf(
+				ErrInvalidForkSchedule,
+				"fork version %d is not supported", fork.Version,
+			)
+		case i == 0:
+			continue
+		case fork.Version <= schedule[i-1].Version:
+			return errors.Wrapf(
+				ErrInvalidForkSchedule,
+				"fork version %d must succeed fork version %d",
+				fork.Version, schedule[i-1].Version,
+			)
+		case fork.Epoch < schedule[i-1].Epoch:
+			return errors.Wrapf(
+				ErrInvalidForkSchedule,
+				"fork version %d is activated before fork version %d",
+				fork.Version, schedule[i-1].Version,
+			)
+		}
+	}
+	return nil
+}
+
+// specValue is a named chain spec value.
+type specValue struct {
+	name  string
+	value uint64
+}
+
+// bytesPerFieldElement is the number of bytes of a field element of a blob.
+const bytesPerFieldElement = 32
+
+// isPowerOfTwo returns true if the value is a power of two.
+func isPowerOfTwo(value uint64) bool {
+	return value != 0 && value&(value-1) == 0
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Values returns the chain spec values keyed by their consensus-specs style
+// name, e.g. SLOTS_PER_EPOCH for the slots-per-epoch value. Integers are
+// formatted in decimal and byte arrays as 0x prefixed hex. Values that are
+// not scalars, such as the fork schedule and the CometBFT config, are
+// omitted.
+func (d SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Values() map[string]string {
+	v := reflect.ValueOf(&d).Elem()
+	values := make(map[string]string, v.NumField())
+	for i := range v.NumField() {
+		name := strings.ToUpper(strings.ReplaceAll(
+			v.Type().Field(i).Tag.Get("mapstructure"), "-", "_",
+		))
+		field := v.Field(i)
+		switch {
+		case field.Kind() == reflect.Uint64:
+			values[name] = strconv.FormatUint(field.Uint(), 10)
+		case field.Kind() == reflect.Array &&
+			field.Type().Elem().Kind() == reflect.Uint8:
+			values[name] = "0x" + hex.EncodeToString(
+				field.Slice(0, field.Len()).Bytes(),
+			)
+		}
+	}
+	return values
+}
@@ -31,4 +31,13 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// UnsetDepositRequestsStartIndex is the deposit requests start index
+	// before the first deposit request is processed.
+	UnsetDepositRequestsStartIndex = ^uint64(0)
+	// MaxValidatorsPerCommittee is the maximum number of validators that can
+	// attest to the same attestation data.
+	MaxValidatorsPerCommittee uint64 = 2048
+	// DepositContractTreeDepth is the depth of the deposit contract's
+	// incremental Merkle tree.
+	DepositContractTreeDepth uint8 = 32
 )
@@ -34,7 +34,35 @@ const (
 	// MaxDepositsPerBlock is the maximum number of deposits per block.
 	MaxDepositsPerBlock uint64 = 16
 
+	// MaxProposerSlashingsPerBlock is the maximum number of proposer slashings
+	// per block.
+	MaxProposerSlashingsPerBlock uint64 = 16
+
+	// MaxAttesterSlashingsPerBlock is the maximum number of attester slashings
+	// per block.
+	MaxAttesterSlashingsPerBlock uint64 = 2
+
+	// MaxVoluntaryExitsPerBlock is the maximum number of voluntary exits per
+	// block.
+	MaxVoluntaryExitsPerBlock uint64 = 16
+
+	// MaxBLSToExecutionChangesPerBlock is the maximum number of BLS to
+	// execution changes per block.
+	MaxBLSToExecutionChangesPerBlock uint64 = 16
+
 	// MaxWithdrawalsPerPayload is the maximum number of withdrawals in a
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
+
+	// MaxDepositRequestsPerPayload is the maximum number of deposit requests
+	// in a execution payload.
+	MaxDepositRequestsPerPayload uint64 = 8192
+
+	// MaxWithdrawalRequestsPerPayload is the maximum number of withdrawal
+	// requests in a execution payload.
+	MaxWithdrawalRequestsPerPayload uint64 = 16
+
+	// FullExitRequestAmount is the amount of a withdrawal request that
+	// requests the full exit of the validator.
+	FullExitRequestAmount uint64 = 0
 )
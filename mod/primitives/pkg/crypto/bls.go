@@ -58,4 +58,10 @@ type BLSSigner interface {
 
 	// VerifySignature verifies a signature against a message and a public key.
 	VerifySignature(pubKey BLSPubkey, msg []byte, signature BLSSignature) error
+
+	// VerifyAggregateSignature verifies an aggregate signature against a
+	// message and a set of public keys.
+	VerifyAggregateSignature(
+		pubKeys []BLSPubkey, msg []byte, signature BLSSignature,
+	) error
 }
@@ -126,6 +126,54 @@ func (_c *BLSSigner_Sign_Call) RunAndReturn(run func([]byte) (bytes.B96, error))
 	return _c
 }
 
+// VerifyAggregateSignature provides a mock function with given fields: pubKeys, msg, signature
+func (_m *BLSSigner) VerifyAggregateSignature(pubKeys []bytes.B48, msg []byte, signature bytes.B96) error {
+	ret := _m.Called(pubKeys, msg, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyAggregateSignature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]bytes.B48, []byte, bytes.B96) error); ok {
+		r0 = rf(pubKeys, msg, signature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BLSSigner_VerifyAggregateSignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAggregateSignature'
+type BLSSigner_VerifyAggregateSignature_Call struct {
+	*mock.Call
+}
+
+// VerifyAggregateSignature is a helper method to define mock.On call
+//   - pubKeys []bytes.B48
+//   - msg []byte
+//   - signature bytes.B96
+func (_e *BLSSigner_Expecter) VerifyAggregateSignature(pubKeys interface{}, msg interface{}, signature interface{}) *BLSSigner_VerifyAggregateSignature_Call {
+	return &BLSSigner_VerifyAggregateSignature_Call{Call: _e.mock.On("VerifyAggregateSignature", pubKeys, msg, signature)}
+}
+
+func (_c *BLSSigner_VerifyAggregateSignature_Call) Run(run func(pubKeys []bytes.B48, msg []byte, signature bytes.B96)) *BLSSigner_VerifyAggregateSignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]bytes.B48), args[1].([]byte), args[2].(bytes.B96))
+	})
+	return _c
+}
+
+func (_c *BLSSigner_VerifyAggregateSignature_Call) Return(_a0 error) *BLSSigner_VerifyAggregateSignature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BLSSigner_VerifyAggregateSignature_Call) RunAndReturn(run func([]bytes.B48, []byte, bytes.B96) error) *BLSSigner_VerifyAggregateSignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifySignature provides a mock function with given fields: pubKey, msg, signature
 func (_m *BLSSigner) VerifySignature(pubKey bytes.B48, msg []byte, signature bytes.B96) error {
 	ret := _m.Called(pubKey, msg, signature)
@@ -21,8 +21,9 @@
 package events
 
 const (
-	MissedSlot           = "MissedSlot"
-	BeaconBlockAccepted  = "BeaconBlockAccepted"
-	BeaconBlockRejected  = "BeaconBlockRejected"
-	BeaconBlockFinalized = "BeaconBlockFinalized"
+	MissedSlot            = "MissedSlot"
+	BeaconBlockAccepted   = "BeaconBlockAccepted"
+	BeaconBlockRejected   = "BeaconBlockRejected"
+	BeaconBlockFinalized  = "BeaconBlockFinalized"
+	PayloadAttributesSent = "PayloadAttributesSent"
 )
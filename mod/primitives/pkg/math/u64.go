@@ -221,6 +221,25 @@ func (u U64) ILog2Floor() uint8 {
 	return uint8(bits.Len64(uint64(u))) - 1
 }
 
+// ISqrt returns the largest integer x such that x**2 <= u.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#integer_squareroot
+//
+//nolint:lll // link.
+func (u U64) ISqrt() U64 {
+	// Avoid overflowing x + 1 below.
+	if u == 1<<64-1 {
+		return 1<<32 - 1
+	}
+	x := u
+	y := (x + 1) / 2 //nolint:mnd // from the spec.
+	for y < x {
+		x = y
+		y = (x + u/x) / 2 //nolint:mnd // from the spec.
+	}
+	return x
+}
+
 // ---------------------------- Gwei Methods ----------------------------
 
 // GweiToWei returns the value of Wei in Gwei.
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Operation is an operation that applies to a single validator.
+type Operation interface {
+	// GetValidatorIndex returns the index of the validator the operation
+	// applies to.
+	GetValidatorIndex() math.ValidatorIndex
+}
+
+// Pool is an in-memory pool of operations pending inclusion in a block. At
+// most one operation is kept per validator.
+type Pool[OperationT Operation] struct {
+	// mu protects the operations.
+	mu sync.RWMutex
+	// operations are the pending operations, keyed by validator index.
+	operations map[math.ValidatorIndex]OperationT
+}
+
+// New creates a new empty pool.
+func New[OperationT Operation]() *Pool[OperationT] {
+	return &Pool[OperationT]{
+		operations: make(map[math.ValidatorIndex]OperationT),
+	}
+}
+
+// Insert adds the operation to the pool. It returns false if an operation
+// for the same validator is already pending, in which case the pool is left
+// unchanged.
+func (p *Pool[OperationT]) Insert(op OperationT) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	idx := op.GetValidatorIndex()
+	if _, ok := p.operations[idx]; ok {
+		return false
+	}
+	p.operations[idx] = op
+	return true
+}
+
+// Pending returns the pending operations ordered by validator index.
+func (p *Pool[OperationT]) Pending() []OperationT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	ops := make([]OperationT, 0, len(p.operations))
+	for _, op := range p.operations {
+		ops = append(ops, op)
+	}
+	slices.SortFunc(ops, func(a, b OperationT) int {
+		return cmp.Compare(a.GetValidatorIndex(), b.GetValidatorIndex())
+	})
+	return ops
+}
+
+// Remove removes the operations of the given validators from the pool.
+func (p *Pool[OperationT]) Remove(indices ...math.ValidatorIndex) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, idx := range indices {
+		delete(p.operations, idx)
+	}
+}
+
+// Len returns the number of pending operations.
+func (p *Pool[OperationT]) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.operations)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
+	"github.com/stretchr/testify/require"
+)
+
+type testOperation struct {
+	index math.ValidatorIndex
+	data  string
+}
+
+func (o *testOperation) GetValidatorIndex() math.ValidatorIndex {
+	return o.index
+}
+
+func TestPool(t *testing.T) {
+	p := pool.New[*testOperation]()
+	require.Empty(t, p.Pending())
+
+	require.True(t, p.Insert(&testOperation{index: 3, data: "a"}))
+	require.True(t, p.Insert(&testOperation{index: 1, data: "b"}))
+	require.True(t, p.Insert(&testOperation{index: 2, data: "c"}))
+
+	// The first operation seen for a validator is kept.
+	require.False(t, p.Insert(&testOperation{index: 3, data: "d"}))
+	require.Equal(t, 3, p.Len())
+
+	// Pending operations are ordered by validator index.
+	require.Equal(t, []*testOperation{
+		{index: 1, data: "b"},
+		{index: 2, data: "c"},
+		{index: 3, data: "a"},
+	}, p.Pending())
+
+	p.Remove(1, 3, 4)
+	require.Equal(t, []*testOperation{{index: 2, data: "c"}}, p.Pending())
+
+	// A validator can submit a new operation once the previous one is
+	// removed.
+	require.True(t, p.Insert(&testOperation{index: 3, data: "d"}))
+	require.Equal(t, 2, p.Len())
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "context"
+
+// participantsKey is the context key under which the CometBFT addresses of
+// the validators that signed the last commit are stored.
+type participantsKey struct{}
+
+// WithParticipants returns a copy of ctx carrying the CometBFT addresses of
+// the validators that signed the last commit.
+func WithParticipants(
+	ctx context.Context,
+	participants [][]byte,
+) context.Context {
+	return context.WithValue(ctx, participantsKey{}, participants)
+}
+
+// ParticipantsFromContext returns the CometBFT addresses of the validators
+// that signed the last commit, if any were attached to ctx.
+func ParticipantsFromContext(ctx context.Context) [][]byte {
+	if ctx == nil {
+		return nil
+	}
+	participants, _ := ctx.Value(participantsKey{}).([][]byte)
+	return participants
+}
+
+// GetParticipants returns the CometBFT addresses of the validators that
+// signed the last commit.
+func (c *Context) GetParticipants() [][]byte {
+	return ParticipantsFromContext(c.Context)
+}
@@ -119,6 +119,7 @@ func (h *FinalizeBlockMiddleware[
 ) error {
 	startTime := time.Now()
 	defer h.metrics.measureEndBlockDuration(startTime)
+	ctx = withCommitParticipants(ctx, req.GetDecidedLastCommit().Votes)
 
 	blk, blobs, err := encoding.
 		ExtractBlobsAndBlockFromRequest[BeaconBlockT, BlobSidecarsT](req,
@@ -24,6 +24,9 @@ import (
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // convertValidatorUpdate abstracts the conversion of a
@@ -43,3 +46,30 @@ func convertValidatorUpdate(
 		Power: int64(update.EffectiveBalance.Unwrap()),
 	}, nil
 }
+
+// withCommitParticipants attaches the CometBFT addresses of the validators
+// that signed the given commit votes to the context, so that they can be
+// credited by the state transition.
+func withCommitParticipants[
+	VoteT any,
+	VotePtrT interface {
+		*VoteT
+		GetValidator() cmtabci.Validator
+		GetBlockIdFlag() cmtproto.BlockIDFlag
+	},
+](
+	ctx sdk.Context,
+	votes []VoteT,
+) sdk.Context {
+	participants := make([][]byte, 0, len(votes))
+	for i := range votes {
+		vote := VotePtrT(&votes[i])
+		if vote.GetBlockIdFlag() != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		participants = append(participants, vote.GetValidator().Address)
+	}
+	return ctx.WithContext(
+		transition.WithParticipants(ctx.Context(), participants),
+	)
+}
@@ -174,6 +174,7 @@ func (h *ValidatorMiddleware[
 		)
 	)
 	defer h.metrics.measurePrepareProposalDuration(startTime)
+	ctx = withCommitParticipants(ctx, req.GetLocalLastCommit().Votes)
 
 	// Get the best block and blobs.
 	blk, blobs, err := h.validatorService.RequestBlockForProposal(
@@ -229,6 +230,7 @@ func (h *ValidatorMiddleware[
 		)
 	)
 	defer h.metrics.measureProcessProposalDuration(startTime)
+	ctx = withCommitParticipants(ctx, req.GetProposedLastCommit().Votes)
 
 	args := []any{"beacon_block", true, "blob_sidecars", true}
 	blk, err := h.beaconBlockGossiper.Request(ctx, req)
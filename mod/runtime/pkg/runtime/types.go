@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
@@ -79,6 +80,17 @@ type DepositStore interface {
 		startIndex uint64,
 		numView uint64,
 	) ([]*types.Deposit, error)
+	GetDepositsWithProofs(
+		startIndex uint64,
+		numView uint64,
+		depositCount uint64,
+	) ([]*types.Deposit, error)
+	GetDepositCount() (uint64, error)
+	GetDepositRoot(depositCount uint64) (common.Root, error)
+	GetEth1Block() (common.ExecutionHash, uint64, error)
+	GetEth1BlockDepositCount(
+		blockHash common.ExecutionHash,
+	) (uint64, bool, error)
 	EnqueueDeposits(deposits []*types.Deposit) error
 	Prune(index uint64, numPrune uint64) error
 }
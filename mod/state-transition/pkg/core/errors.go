@@ -51,6 +51,19 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDepositCountMismatch is returned when a block does not include the
+	// number of deposits expected by the beacon state.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+
+	// ErrEth1DataDepositCountDecreased is returned when the eth1 data of a
+	// block has a lower deposit count than the one in the beacon state.
+	ErrEth1DataDepositCountDecreased = errors.New(
+		"eth1 data deposit count decreased")
+
+	// ErrDepositIndexMismatch is returned when a deposit in a block is not
+	// the next deposit expected by the beacon state.
+	ErrDepositIndexMismatch = errors.New("deposit index mismatch")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -59,6 +72,65 @@ var (
 	// in a block does not match the expected value.
 	ErrPenaltiesLengthMismatch = errors.New("penalties length mismatch")
 
+	// ErrExceedsBlockProposerSlashingLimit is returned when the block exceeds
+	// the proposer slashing limit.
+	ErrExceedsBlockProposerSlashingLimit = errors.New(
+		"block exceeds proposer slashing limit")
+
+	// ErrExceedsBlockAttesterSlashingLimit is returned when the block exceeds
+	// the attester slashing limit.
+	ErrExceedsBlockAttesterSlashingLimit = errors.New(
+		"block exceeds attester slashing limit")
+
+	// ErrProposerSlashingNotSlashable is returned when the headers of a
+	// proposer slashing do not conflict.
+	ErrProposerSlashingNotSlashable = errors.New(
+		"proposer slashing headers are not slashable")
+
+	// ErrAttesterSlashingNotSlashable is returned when the attestations of an
+	// attester slashing do not conflict.
+	ErrAttesterSlashingNotSlashable = errors.New(
+		"attester slashing attestations are not slashable")
+
+	// ErrValidatorNotSlashable is returned when a slashing targets a validator
+	// that cannot be slashed.
+	ErrValidatorNotSlashable = errors.New("validator is not slashable")
+
+	// ErrNoSlashableIndices is returned when an attester slashing does not
+	// result in any validator being slashed.
+	ErrNoSlashableIndices = errors.New(
+		"attester slashing does not slash any validator")
+
+	// ErrExceedsBlockVoluntaryExitLimit is returned when the block exceeds
+	// the voluntary exit limit.
+	ErrExceedsBlockVoluntaryExitLimit = errors.New(
+		"block exceeds voluntary exit limit")
+
+	// ErrValidatorNotActive is returned when a voluntary exit targets a
+	// validator that is not active.
+	ErrValidatorNotActive = errors.New("validator is not active")
+
+	// ErrValidatorAlreadyExited is returned when a voluntary exit targets a
+	// validator that has already initiated its exit.
+	ErrValidatorAlreadyExited = errors.New("validator has already exited")
+
+	// ErrVoluntaryExitTooEarly is returned when a voluntary exit is included
+	// before its epoch, or before the validator has been active for the
+	// shard committee period.
+	ErrVoluntaryExitTooEarly = errors.New("voluntary exit is too early")
+
+	// ErrExceedsBlockBLSToExecutionChangeLimit is returned when the block
+	// exceeds the BLS to execution change limit.
+	ErrExceedsBlockBLSToExecutionChangeLimit = errors.New(
+		"block exceeds bls to execution change limit",
+	)
+
+	// ErrWithdrawalCredentialsMismatch is returned when a BLS to execution
+	// change does not match the withdrawal credentials of the validator.
+	ErrWithdrawalCredentialsMismatch = errors.New(
+		"withdrawal credentials mismatch",
+	)
+
 	// ErrExceedsBlockBlobLimit is returned when the block exceeds the blob
 	// limit.
 	ErrExceedsBlockBlobLimit = errors.New("block exceeds blob limit")
@@ -77,4 +149,19 @@ var (
 
 	// ErrXorInvalid is returned when the XOR operation is invalid.
 	ErrXorInvalid = errors.New("xor invalid")
+
+	// ErrExceedsPayloadDepositRequestLimit is returned when the execution
+	// payload exceeds the deposit request limit.
+	ErrExceedsPayloadDepositRequestLimit = errors.New(
+		"payload exceeds deposit request limit",
+	)
+
+	// ErrExceedsPayloadWithdrawalRequestLimit is returned when the execution
+	// payload exceeds the withdrawal request limit.
+	ErrExceedsPayloadWithdrawalRequestLimit = errors.New(
+		"payload exceeds withdrawal request limit")
+
+	// ErrNoGenesisValidators is returned when the genesis deposits do not
+	// activate any validator.
+	ErrNoGenesisValidators = errors.New("no genesis validators activated")
 )
@@ -0,0 +1,439 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+var errValidatorNotFound = errors.New("validator not found")
+
+type (
+	// testBlobSidecars is a stub for the blob sidecars of a block.
+	testBlobSidecars struct{}
+
+	// testStateProcessor is the state processor instantiated with the
+	// concrete types used by the node.
+	testStateProcessor = StateProcessor[
+		*types.AttesterSlashing, *types.SignedBLSToExecutionChange,
+		*types.BeaconBlock, *types.BeaconBlockBody,
+		*types.BeaconBlockHeader, *testBeaconState, testBlobSidecars,
+		*transition.Context, *types.Deposit, *types.Eth1Data,
+		*types.ExecutionPayload, *types.ExecutionPayloadHeader,
+		*types.Fork, *types.ForkData, *types.ProposerSlashing,
+		*types.Validator, *types.SignedVoluntaryExit,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+)
+
+func (testBlobSidecars) Len() int { return 0 }
+
+// testBeaconState is an in-memory beacon state for testing. Methods that are
+// not implemented panic through the embedded nil interface.
+type testBeaconState struct {
+	BeaconState[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork,
+		*types.Validator, *engineprimitives.Withdrawal,
+	]
+
+	slot             math.Slot
+	fork             *types.Fork
+	validators       []*types.Validator
+	balances         []math.Gwei
+	slashings        []math.Gwei
+	eth1Data         *types.Eth1Data
+	eth1DepositIndex uint64
+
+	genesisValidatorsRoot common.Root
+
+	participation     []uint64
+	inactivityScores  []uint64
+	cometBFTAddresses [][]byte
+
+	depositRequestsStartIndex uint64
+
+	expectedWithdrawals          []*engineprimitives.Withdrawal
+	nextWithdrawalIndex          uint64
+	nextWithdrawalValidatorIndex math.ValidatorIndex
+}
+
+// newTestBeaconState creates a state at the given slot with one active
+// validator per balance, each with the maximum effective balance.
+func newTestBeaconState(
+	cs primitives.ChainSpec,
+	slot math.Slot,
+	balances ...math.Gwei,
+) *testBeaconState {
+	st := &testBeaconState{
+		slot:      slot,
+		balances:  balances,
+		slashings: make([]math.Gwei, cs.EpochsPerSlashingsVector()),
+
+		depositRequestsStartIndex: constants.UnsetDepositRequestsStartIndex,
+
+		participation:    make([]uint64, len(balances)),
+		inactivityScores: make([]uint64, len(balances)),
+	}
+	for i := range balances {
+		st.cometBFTAddresses = append(
+			st.cometBFTAddresses, []byte{0xc0, byte(i)},
+		)
+		st.validators = append(st.validators, &types.Validator{
+			Pubkey:                     crypto.BLSPubkey{byte(i), byte(i >> 8)},
+			EffectiveBalance:           math.Gwei(cs.MaxEffectiveBalance()),
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  math.Epoch(constants.FarFutureEpoch),
+			WithdrawableEpoch:          math.Epoch(constants.FarFutureEpoch),
+		})
+	}
+	return st
+}
+
+func (s *testBeaconState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *testBeaconState) GetFork() (*types.Fork, error) {
+	return s.fork, nil
+}
+
+func (s *testBeaconState) SetFork(fork *types.Fork) error {
+	s.fork = fork
+	return nil
+}
+
+func (s *testBeaconState) SetSlot(slot math.Slot) error {
+	s.slot = slot
+	return nil
+}
+
+func (s *testBeaconState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return s.genesisValidatorsRoot, nil
+}
+
+func (s *testBeaconState) SetGenesisValidatorsRoot(root common.Root) error {
+	s.genesisValidatorsRoot = root
+	return nil
+}
+
+func (s *testBeaconState) SetLatestBlockHeader(*types.BeaconBlockHeader) error {
+	return nil
+}
+
+func (s *testBeaconState) SetLatestExecutionPayloadHeader(
+	*types.ExecutionPayloadHeader,
+) error {
+	return nil
+}
+
+func (s *testBeaconState) Save() {}
+
+func (s *testBeaconState) GetValidators() ([]*types.Validator, error) {
+	return s.validators, nil
+}
+
+func (s *testBeaconState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *testBeaconState) GetValidatorsByEffectiveBalance() (
+	[]*types.Validator, error,
+) {
+	vals := slices.Clone(s.validators)
+	slices.SortStableFunc(vals, func(a, b *types.Validator) int {
+		return cmp.Compare(a.EffectiveBalance, b.EffectiveBalance)
+	})
+	return vals, nil
+}
+
+func (s *testBeaconState) ValidatorByIndex(
+	idx math.ValidatorIndex,
+) (*types.Validator, error) {
+	if uint64(idx) >= uint64(len(s.validators)) {
+		return nil, errValidatorNotFound
+	}
+	return s.validators[idx], nil
+}
+
+func (s *testBeaconState) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	for i, val := range s.validators {
+		if val.Pubkey == pubkey {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errValidatorNotFound
+}
+
+func (s *testBeaconState) ValidatorIndexByCometBFTAddress(
+	address []byte,
+) (math.ValidatorIndex, error) {
+	for i, a := range s.cometBFTAddresses {
+		if a != nil && bytes.Equal(a, address) {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errValidatorNotFound
+}
+
+func (s *testBeaconState) GetEpochParticipationAtIndex(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	return s.participation[idx], nil
+}
+
+func (s *testBeaconState) SetEpochParticipationAtIndex(
+	idx math.ValidatorIndex,
+	participation uint64,
+) error {
+	s.participation[idx] = participation
+	return nil
+}
+
+func (s *testBeaconState) GetInactivityScoreAtIndex(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	return s.inactivityScores[idx], nil
+}
+
+func (s *testBeaconState) SetInactivityScoreAtIndex(
+	idx math.ValidatorIndex,
+	score uint64,
+) error {
+	s.inactivityScores[idx] = score
+	return nil
+}
+
+func (s *testBeaconState) AddValidator(val *types.Validator) error {
+	s.validators = append(s.validators, val)
+	s.balances = append(s.balances, 0)
+	s.participation = append(s.participation, 0)
+	s.inactivityScores = append(s.inactivityScores, 0)
+	s.cometBFTAddresses = append(s.cometBFTAddresses, nil)
+	return nil
+}
+
+func (s *testBeaconState) UpdateValidatorAtIndex(
+	idx math.ValidatorIndex,
+	val *types.Validator,
+) error {
+	if uint64(idx) >= uint64(len(s.validators)) {
+		return errValidatorNotFound
+	}
+	s.validators[idx] = val
+	return nil
+}
+
+func (s *testBeaconState) GetBalance(
+	idx math.ValidatorIndex,
+) (math.Gwei, error) {
+	return s.balances[idx], nil
+}
+
+func (s *testBeaconState) IncreaseBalance(
+	idx math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	s.balances[idx] += delta
+	return nil
+}
+
+func (s *testBeaconState) DecreaseBalance(
+	idx math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	s.balances[idx] -= min(s.balances[idx], delta)
+	return nil
+}
+
+func (s *testBeaconState) GetTotalActiveBalances(
+	slotsPerEpoch uint64,
+) (math.Gwei, error) {
+	var total math.Gwei
+	epoch := math.Epoch(uint64(s.slot) / slotsPerEpoch)
+	for _, val := range s.validators {
+		if val.IsActive(epoch) {
+			total += val.GetEffectiveBalance()
+		}
+	}
+	return total, nil
+}
+
+func (s *testBeaconState) GetSlashingAtIndex(index uint64) (math.Gwei, error) {
+	return s.slashings[index], nil
+}
+
+func (s *testBeaconState) UpdateSlashingAtIndex(
+	index uint64,
+	amount math.Gwei,
+) error {
+	s.slashings[index] = amount
+	return nil
+}
+
+func (s *testBeaconState) GetTotalSlashing() (math.Gwei, error) {
+	var total math.Gwei
+	for _, amount := range s.slashings {
+		total += amount
+	}
+	return total, nil
+}
+
+func (s *testBeaconState) SetTotalSlashing(math.Gwei) error {
+	return nil
+}
+
+func (s *testBeaconState) GetEth1Data() (*types.Eth1Data, error) {
+	return s.eth1Data, nil
+}
+
+func (s *testBeaconState) SetEth1Data(eth1Data *types.Eth1Data) error {
+	s.eth1Data = eth1Data
+	return nil
+}
+
+func (s *testBeaconState) GetEth1DepositIndex() (uint64, error) {
+	return s.eth1DepositIndex, nil
+}
+
+func (s *testBeaconState) SetEth1DepositIndex(index uint64) error {
+	s.eth1DepositIndex = index
+	return nil
+}
+
+func (s *testBeaconState) GetDepositRequestsStartIndex() (uint64, error) {
+	return s.depositRequestsStartIndex, nil
+}
+
+func (s *testBeaconState) SetDepositRequestsStartIndex(index uint64) error {
+	s.depositRequestsStartIndex = index
+	return nil
+}
+
+func (s *testBeaconState) ExpectedWithdrawals() (
+	[]*engineprimitives.Withdrawal, error,
+) {
+	return s.expectedWithdrawals, nil
+}
+
+func (s *testBeaconState) GetNextWithdrawalIndex() (uint64, error) {
+	return s.nextWithdrawalIndex, nil
+}
+
+func (s *testBeaconState) SetNextWithdrawalIndex(index uint64) error {
+	s.nextWithdrawalIndex = index
+	return nil
+}
+
+func (s *testBeaconState) GetNextWithdrawalValidatorIndex() (
+	math.ValidatorIndex, error,
+) {
+	return s.nextWithdrawalValidatorIndex, nil
+}
+
+func (s *testBeaconState) SetNextWithdrawalValidatorIndex(
+	index math.ValidatorIndex,
+) error {
+	s.nextWithdrawalValidatorIndex = index
+	return nil
+}
+
+// newTestBlock creates a block carrying the given eth1 data and deposits.
+func newTestBlock(
+	eth1Data *types.Eth1Data,
+	deposits ...*types.Deposit,
+) *types.BeaconBlock {
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockDeneb{
+			Body: &types.BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					Eth1Data: eth1Data,
+					Deposits: deposits,
+				},
+			},
+		},
+	}
+}
+
+// newTestChainSpec returns a chain spec with the values used by the tests.
+//
+//nolint:mnd // test values.
+func newTestChainSpec(
+	modify ...func(*chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]),
+) primitives.ChainSpec {
+	data := chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		MinDepositAmount:                 uint64(1e9),
+		MaxEffectiveBalance:              uint64(32e9),
+		EjectionBalance:                  uint64(16e9),
+		EffectiveBalanceIncrement:        uint64(1e9),
+		HysteresisQuotient:               4,
+		HysteresisDownwardMultiplier:     1,
+		HysteresisUpwardMultiplier:       5,
+		SlotsPerEpoch:                    32,
+		MaxSeedLookahead:                 1,
+		MinValidatorWithdrawabilityDelay: 2,
+		ShardCommitteePeriod:             4,
+		MinPerEpochChurnLimit:            2,
+		ChurnLimitQuotient:               1 << 16,
+		EpochsPerSlashingsVector:         8,
+		ProportionalSlashingMultiplier:   1,
+		MinSlashingPenaltyQuotient:       128,
+		WhistleblowerRewardQuotient:      512,
+		ProposerRewardQuotient:           8,
+		MaxDepositsPerBlock:              16,
+		MaxProposerSlashingsPerBlock:     16,
+		MaxAttesterSlashingsPerBlock:     2,
+		MaxVoluntaryExitsPerBlock:        16,
+		MaxBLSToExecutionChangesPerBlock: 16,
+		MaxWithdrawalsPerPayload:         2,
+		MaxValidatorsPerWithdrawalsSweep: 3,
+		MaxValidatorSetSize:              4,
+		ForkSchedule: []chain.ScheduledFork[math.Epoch]{
+			{Version: version.Electra, Epoch: 0},
+		},
+	}
+	for _, fn := range modify {
+		fn(&data)
+	}
+	return chain.NewChainSpec(data)
+}
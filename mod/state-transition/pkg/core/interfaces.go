@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
 // BeaconState is the interface for the beacon state. It
@@ -43,6 +44,8 @@ type BeaconState[
 	Save()
 	Context() context.Context
 	HashTreeRoot() ([32]byte, error)
+	GetMarshallable() (ssz.Marshallable, error)
+	GetFork() (ForkT, error)
 	ReadOnlyBeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ValidatorT, WithdrawalT,
@@ -72,10 +75,13 @@ type ReadOnlyBeaconState[
 	GetTotalActiveBalances(uint64) (math.Gwei, error)
 	GetValidators() ([]ValidatorT, error)
 	GetTotalSlashing() (math.Gwei, error)
+	GetSlashingAtIndex(uint64) (math.Gwei, error)
 	GetNextWithdrawalIndex() (uint64, error)
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 	GetTotalValidators() (uint64, error)
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	GetEpochParticipationAtIndex(math.ValidatorIndex) (uint64, error)
+	GetInactivityScoreAtIndex(math.ValidatorIndex) (uint64, error)
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
@@ -119,6 +125,8 @@ type WriteOnlyBeaconState[
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 	RemoveValidatorAtIndex(math.ValidatorIndex) error
 	SetTotalSlashing(math.Gwei) error
+	SetEpochParticipationAtIndex(math.ValidatorIndex, uint64) error
+	SetInactivityScoreAtIndex(math.ValidatorIndex, uint64) error
 }
 
 // WriteOnlyStateRoots defines a struct which only has write access to state
@@ -170,6 +178,7 @@ type ReadOnlyValidators[ValidatorT any] interface {
 type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(Eth1DataT) error
 	SetEth1DepositIndex(uint64) error
+	SetDepositRequestsStartIndex(uint64) error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
 	) error
@@ -179,6 +188,7 @@ type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (Eth1DataT, error)
 	GetEth1DepositIndex() (uint64, error)
+	GetDepositRequestsStartIndex() (uint64, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
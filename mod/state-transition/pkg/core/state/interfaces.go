@@ -52,6 +52,10 @@ type KVStore[
 	SetEth1DepositIndex(
 		index uint64,
 	) error
+	GetDepositRequestsStartIndex() (uint64, error)
+	SetDepositRequestsStartIndex(
+		index uint64,
+	) error
 	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	SetBalance(idx math.ValidatorIndex, balance math.Gwei) error
 	Copy() KVStoreT
@@ -79,6 +83,12 @@ type KVStore[
 	GetSlashings() ([]uint64, error)
 	SetSlashingAtIndex(index uint64, amount math.Gwei) error
 	GetSlashingAtIndex(index uint64) (math.Gwei, error)
+	GetEpochParticipations() ([]uint64, error)
+	GetEpochParticipationAtIndex(idx math.ValidatorIndex) (uint64, error)
+	SetEpochParticipationAtIndex(idx math.ValidatorIndex, count uint64) error
+	GetInactivityScores() ([]uint64, error)
+	GetInactivityScoreAtIndex(idx math.ValidatorIndex) (uint64, error)
+	SetInactivityScoreAtIndex(idx math.ValidatorIndex, score uint64) error
 	GetTotalValidators() (uint64, error)
 	GetTotalActiveBalances(uint64) (math.Gwei, error)
 	ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error)
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
 // StateDB is the underlying struct behind the BeaconState interface.
@@ -222,7 +223,10 @@ func (s *StateDB[
 		return nil, err
 	}
 
-	// Iterate through indicies to find the next validators to withdraw.
+	// Sweep through the validators, starting at the next withdrawal
+	// validator index, to find the next withdrawals.
+	maxWithdrawals := s.cs.MaxWithdrawalsPerPayload()
+	maxEffectiveBalance := math.Gwei(s.cs.MaxEffectiveBalance())
 	for range min(
 		s.cs.MaxValidatorsPerWithdrawalsSweep(), totalValidators,
 	) {
@@ -236,37 +240,40 @@ func (s *StateDB[
 			return nil, err
 		}
 
-		withdrawalAddress, err = validator.
-			GetWithdrawalCredentials().ToExecutionAddress()
-		if err != nil {
-			return nil, err
-		}
-
-		// These fields are the same for both partial and full withdrawals.
-		withdrawal := &engineprimitives.Withdrawal{
-			Index:     math.U64(withdrawalIndex),
-			Validator: validatorIndex,
-			Address:   withdrawalAddress,
-		}
-
 		// Set the amount of the withdrawal depending on the balance of the
-		// validator.
-		if validator.IsFullyWithdrawable(balance, epoch) {
-			withdrawal.Amount = balance
-		} else if validator.IsPartiallyWithdrawable(
-			balance, math.Gwei(s.cs.MaxEffectiveBalance()),
-		) {
-			withdrawal.Amount = balance - math.Gwei(s.cs.MaxEffectiveBalance())
+		// validator. Validators that are neither fully nor partially
+		// withdrawable, which includes validators without eth1 withdrawal
+		// credentials, are skipped.
+		var amount math.Gwei
+		switch {
+		case validator.IsFullyWithdrawable(balance, epoch):
+			amount = balance
+		case validator.IsPartiallyWithdrawable(balance, maxEffectiveBalance):
+			amount = balance - maxEffectiveBalance
 		}
-		withdrawals = append(withdrawals, withdrawal)
 
-		// Increment the withdrawal index to process the next withdrawal.
-		withdrawalIndex++
-
-		// Cap the number of withdrawals to the maximum allowed per payload.
-		//#nosec:G701 // won't overflow in practice.
-		if len(withdrawals) == int(s.cs.MaxWithdrawalsPerPayload()) {
-			break
+		if amount > 0 {
+			withdrawalAddress, err = validator.
+				GetWithdrawalCredentials().ToExecutionAddress()
+			if err != nil {
+				return nil, err
+			}
+
+			withdrawals = append(withdrawals, &engineprimitives.Withdrawal{
+				Index:     math.U64(withdrawalIndex),
+				Validator: validatorIndex,
+				Address:   withdrawalAddress,
+				Amount:    amount,
+			})
+
+			// Increment the withdrawal index to process the next withdrawal.
+			withdrawalIndex++
+
+			// Cap the number of withdrawals to the maximum allowed per
+			// payload.
+			if uint64(len(withdrawals)) == maxWithdrawals {
+				break
+			}
 		}
 
 		// Increment the validator index to process the next validator.
@@ -278,39 +285,53 @@ func (s *StateDB[
 	return withdrawals, nil
 }
 
-// Store is the interface for the beacon store.
+// HashTreeRoot returns the hash tree root of the beacon state.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) HashTreeRoot() ([32]byte, error) {
+	st, err := s.GetMarshallable()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return st.HashTreeRoot()
+}
+
+// GetMarshallable returns the beacon state as the SSZ container of the fork
+// active at its slot.
 //
 //nolint:funlen,gocognit // todo fix somehow
 func (s *StateDB[
 	BeaconStateT, KVStoreT, ForkT,
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ValidatorT, WithdrawalCredentialsT,
-]) HashTreeRoot() ([32]byte, error) {
+]) GetMarshallable() (ssz.Marshallable, error) {
 	slot, err := s.GetSlot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	fork, err := s.GetFork()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	genesisValidatorsRoot, err := s.GetGenesisValidatorsRoot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	latestBlockHeader, err := s.GetLatestBlockHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	blockRoots := make([]primitives.Root, s.cs.SlotsPerHistoricalRoot())
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		blockRoots[i], err = s.GetBlockRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
@@ -318,61 +339,76 @@ func (s *StateDB[
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		stateRoots[i], err = s.StateRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	latestExecutionPayloadHeader, err := s.GetLatestExecutionPayloadHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1Data, err := s.GetEth1Data()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1DepositIndex, err := s.GetEth1DepositIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
+	}
+
+	depositRequestsStartIndex, err := s.GetDepositRequestsStartIndex()
+	if err != nil {
+		return nil, err
 	}
 
 	validators, err := s.GetValidators()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	balances, err := s.GetBalances()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	randaoMixes := make([]primitives.Bytes32, s.cs.EpochsPerHistoricalVector())
 	for i := range s.cs.EpochsPerHistoricalVector() {
 		randaoMixes[i], err = s.GetRandaoMixAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	nextWithdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	nextWithdrawalValidatorIndex, err := s.GetNextWithdrawalValidatorIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	slashings, err := s.GetSlashings()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	totalSlashings, err := s.GetTotalSlashing()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
+	}
+
+	epochParticipation, err := s.GetEpochParticipations()
+	if err != nil {
+		return nil, err
+	}
+
+	inactivityScores, err := s.GetInactivityScores()
+	if err != nil {
+		return nil, err
 	}
 
 	// TODO: Properly move BeaconState into full generics.
@@ -392,6 +428,7 @@ func (s *StateDB[
 		stateRoots,
 		eth1Data,
 		eth1DepositIndex,
+		depositRequestsStartIndex,
 		latestExecutionPayloadHeader,
 		validators,
 		balances,
@@ -400,9 +437,11 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		epochParticipation,
+		inactivityScores,
 	)
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
-	return st.HashTreeRoot()
+	return st, nil
 }
@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	maxEB          = math.Gwei(32e9)
+	farFutureEpoch = math.Epoch(constants.FarFutureEpoch)
+)
+
+// testKVStore is an in-memory store for testing. Methods that are not
+// implemented panic through the embedded nil interface.
+type testKVStore struct {
+	KVStore[
+		*testKVStore, *types.Fork, *types.BeaconBlockHeader,
+		*types.Eth1Data, *types.ExecutionPayloadHeader, *types.Validator,
+	]
+
+	slot                         math.Slot
+	validators                   []*types.Validator
+	balances                     []math.Gwei
+	nextWithdrawalIndex          uint64
+	nextWithdrawalValidatorIndex math.ValidatorIndex
+}
+
+func (s *testKVStore) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *testKVStore) GetNextWithdrawalIndex() (uint64, error) {
+	return s.nextWithdrawalIndex, nil
+}
+
+func (s *testKVStore) GetNextWithdrawalValidatorIndex() (
+	math.ValidatorIndex, error,
+) {
+	return s.nextWithdrawalValidatorIndex, nil
+}
+
+func (s *testKVStore) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *testKVStore) ValidatorByIndex(
+	idx math.ValidatorIndex,
+) (*types.Validator, error) {
+	if uint64(idx) >= uint64(len(s.validators)) {
+		return nil, errors.New("validator not found")
+	}
+	return s.validators[idx], nil
+}
+
+func (s *testKVStore) GetBalance(idx math.ValidatorIndex) (math.Gwei, error) {
+	return s.balances[idx], nil
+}
+
+// testValidator is a validator used to set up the withdrawal tests.
+type testValidator struct {
+	eth1Credentials   bool
+	effectiveBalance  math.Gwei
+	balance           math.Gwei
+	withdrawableEpoch math.Epoch
+}
+
+// withdrawalAddress returns the execution address of the validator at the
+// given index.
+func withdrawalAddress(idx int) common.ExecutionAddress {
+	return common.ExecutionAddress{byte(idx + 1)}
+}
+
+//nolint:mnd // test values.
+func newTestStateDB(
+	slot math.Slot,
+	nextWithdrawalIndex uint64,
+	nextWithdrawalValidatorIndex math.ValidatorIndex,
+	maxValidatorsPerWithdrawalsSweep uint64,
+	vals ...testValidator,
+) *StateDB[
+	any, *testKVStore, *types.Fork, *types.BeaconBlockHeader,
+	*types.Eth1Data, *types.ExecutionPayloadHeader, *types.Validator,
+	types.WithdrawalCredentials,
+] {
+	kv := &testKVStore{
+		slot:                         slot,
+		nextWithdrawalIndex:          nextWithdrawalIndex,
+		nextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
+	}
+	for i, val := range vals {
+		credentials := types.WithdrawalCredentials{}
+		if val.eth1Credentials {
+			credentials = types.NewCredentialsFromExecutionAddress(
+				withdrawalAddress(i),
+			)
+		}
+		kv.validators = append(kv.validators, &types.Validator{
+			WithdrawalCredentials: credentials,
+			EffectiveBalance:      val.effectiveBalance,
+			ExitEpoch:             farFutureEpoch,
+			WithdrawableEpoch:     val.withdrawableEpoch,
+		})
+		kv.balances = append(kv.balances, val.balance)
+	}
+
+	return &StateDB[
+		any, *testKVStore, *types.Fork, *types.BeaconBlockHeader,
+		*types.Eth1Data, *types.ExecutionPayloadHeader, *types.Validator,
+		types.WithdrawalCredentials,
+	]{
+		KVStore: kv,
+		cs: chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			MaxEffectiveBalance:              uint64(maxEB),
+			SlotsPerEpoch:                    32,
+			MaxWithdrawalsPerPayload:         4,
+			MaxValidatorsPerWithdrawalsSweep: maxValidatorsPerWithdrawalsSweep,
+		}),
+	}
+}
+
+//nolint:maintidx // table driven test.
+func TestExpectedWithdrawals(t *testing.T) {
+	var (
+		// active is a validator with eth1 credentials and no excess balance.
+		active = testValidator{
+			eth1Credentials:   true,
+			effectiveBalance:  maxEB,
+			balance:           maxEB,
+			withdrawableEpoch: farFutureEpoch,
+		}
+		// excess is a validator with eth1 credentials and an excess balance
+		// of 1 gwei.
+		excess = testValidator{
+			eth1Credentials:   true,
+			effectiveBalance:  maxEB,
+			balance:           maxEB + 1,
+			withdrawableEpoch: farFutureEpoch,
+		}
+		// exited is a validator with eth1 credentials that is withdrawable
+		// from epoch 1.
+		exited = testValidator{
+			eth1Credentials:   true,
+			effectiveBalance:  maxEB,
+			balance:           maxEB,
+			withdrawableEpoch: 1,
+		}
+	)
+
+	withdrawal := func(
+		index uint64, validatorIndex int, amount math.Gwei,
+	) *engineprimitives.Withdrawal {
+		return &engineprimitives.Withdrawal{
+			Index:     math.U64(index),
+			Validator: math.ValidatorIndex(validatorIndex),
+			Address:   withdrawalAddress(validatorIndex),
+			Amount:    amount,
+		}
+	}
+
+	tests := []struct {
+		name                         string
+		slot                         math.Slot
+		nextWithdrawalIndex          uint64
+		nextWithdrawalValidatorIndex math.ValidatorIndex
+		sweep                        uint64
+		vals                         []testValidator
+		expected                     []*engineprimitives.Withdrawal
+	}{
+		{
+			name:     "no validators",
+			sweep:    8,
+			expected: []*engineprimitives.Withdrawal{},
+		},
+		{
+			name:     "no withdrawable validators",
+			sweep:    8,
+			vals:     []testValidator{active, active, exited},
+			expected: []*engineprimitives.Withdrawal{},
+		},
+		{
+			name:  "partial withdrawal of the excess balance",
+			sweep: 8,
+			vals: []testValidator{
+				active,
+				{
+					eth1Credentials:   true,
+					effectiveBalance:  maxEB,
+					balance:           maxEB + 5e8,
+					withdrawableEpoch: farFutureEpoch,
+				},
+			},
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(0, 1, 5e8),
+			},
+		},
+		{
+			name:  "no partial withdrawal below the max effective balance",
+			sweep: 8,
+			vals: []testValidator{
+				{
+					eth1Credentials:   true,
+					effectiveBalance:  maxEB - 1e9,
+					balance:           maxEB + 1,
+					withdrawableEpoch: farFutureEpoch,
+				},
+			},
+			expected: []*engineprimitives.Withdrawal{},
+		},
+		{
+			name:  "full withdrawal of a withdrawable validator",
+			slot:  32,
+			sweep: 8,
+			vals: []testValidator{
+				active,
+				exited,
+				{
+					eth1Credentials:   true,
+					effectiveBalance:  0,
+					balance:           3,
+					withdrawableEpoch: 0,
+				},
+			},
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(0, 1, maxEB),
+				withdrawal(1, 2, 3),
+			},
+		},
+		{
+			name:  "full withdrawal takes precedence over partial",
+			slot:  32,
+			sweep: 8,
+			vals: []testValidator{
+				{
+					eth1Credentials:   true,
+					effectiveBalance:  maxEB,
+					balance:           maxEB + 7,
+					withdrawableEpoch: 1,
+				},
+			},
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(0, 0, maxEB+7),
+			},
+		},
+		{
+			name:  "no full withdrawal of an empty balance",
+			slot:  32,
+			sweep: 8,
+			vals: []testValidator{
+				{
+					eth1Credentials:   true,
+					balance:           0,
+					withdrawableEpoch: 1,
+				},
+			},
+			expected: []*engineprimitives.Withdrawal{},
+		},
+		{
+			name:  "no withdrawals without eth1 credentials",
+			slot:  32,
+			sweep: 8,
+			vals: []testValidator{
+				{
+					effectiveBalance:  maxEB,
+					balance:           maxEB + 1,
+					withdrawableEpoch: farFutureEpoch,
+				},
+				{
+					effectiveBalance:  maxEB,
+					balance:           maxEB,
+					withdrawableEpoch: 1,
+				},
+				excess,
+			},
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(0, 2, 1),
+			},
+		},
+		{
+			name:                         "sweep starts at the next indices",
+			nextWithdrawalIndex:          10,
+			nextWithdrawalValidatorIndex: 2,
+			sweep:                        8,
+			vals: []testValidator{
+				excess, excess, active, excess,
+			},
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(10, 3, 1),
+				withdrawal(11, 0, 1),
+				withdrawal(12, 1, 1),
+			},
+		},
+		{
+			name:  "sweep is capped at the max withdrawals per payload",
+			sweep: 8,
+			vals: []testValidator{
+				excess, excess, active, excess, excess, excess,
+			},
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(0, 0, 1),
+				withdrawal(1, 1, 1),
+				withdrawal(2, 3, 1),
+				withdrawal(3, 4, 1),
+			},
+		},
+		{
+			name:                         "sweep is bounded by the sweep size",
+			nextWithdrawalValidatorIndex: 1,
+			sweep:                        2,
+			vals: []testValidator{
+				excess, active, excess, excess,
+			},
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(0, 2, 1),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newTestStateDB(
+				tt.slot, tt.nextWithdrawalIndex,
+				tt.nextWithdrawalValidatorIndex, tt.sweep, tt.vals...,
+			)
+			withdrawals, err := st.ExpectedWithdrawals()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, withdrawals)
+		})
+	}
+}
@@ -21,10 +21,12 @@
 package core
 
 import (
+	"sync"
+
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -33,13 +35,21 @@ import (
 // StateProcessor is a basic Processor, which takes care of the
 // main state transition for the beacon chain.
 type StateProcessor[
+	AttesterSlashingT AttesterSlashing[ForkDataT],
+	BLSToExecutionChangeT BLSToExecutionChange[
+		ForkDataT, WithdrawalCredentialsT,
+	],
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		AttesterSlashingT, BLSToExecutionChangeT, DepositT,
+		BeaconBlockBodyT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockBodyT,
+		DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -49,10 +59,11 @@ type StateProcessor[
 	],
 	BlobSidecarsT BlobSidecars,
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(primitives.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		GetDepositRoot() common.Root
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
@@ -63,9 +74,11 @@ type StateProcessor[
 		New(primitives.Version, primitives.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing[ForkDataT],
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
+	VoluntaryExitT VoluntaryExit[ForkDataT],
 	WithdrawalT Withdrawal[WithdrawalT],
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
 	// cs is the chain specification for the beacon chain.
 	cs primitives.ChainSpec
@@ -75,19 +88,32 @@ type StateProcessor[
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	]
+	// logger is used to log information and errors.
+	logger log.Logger[any]
+	// exitQueueMu protects exitQueue.
+	exitQueueMu sync.Mutex
+	// exitQueue is the exit queue of the state in which an exit was last
+	// initiated.
+	exitQueue *exitQueue
 }
 
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
+	AttesterSlashingT AttesterSlashing[ForkDataT],
+	BLSToExecutionChangeT BLSToExecutionChange[
+		ForkDataT, WithdrawalCredentialsT,
+	],
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		AttesterSlashingT, BLSToExecutionChangeT, DepositT,
+		BeaconBlockBodyT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
+		AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockBodyT,
+		DepositT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT,
-		WithdrawalT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -96,10 +122,11 @@ func NewStateProcessor[
 	],
 	BlobSidecarsT BlobSidecars,
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(primitives.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		GetDepositRoot() common.Root
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
@@ -109,40 +136,46 @@ func NewStateProcessor[
 		New(primitives.Version, primitives.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing[ForkDataT],
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
+	VoluntaryExitT VoluntaryExit[ForkDataT],
 	WithdrawalT Withdrawal[WithdrawalT],
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT WithdrawalCredentials,
 ](
 	cs primitives.ChainSpec,
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	signer crypto.BLSSigner,
+	logger log.Logger[any],
 ) *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ] {
 	return &StateProcessor[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-		BeaconStateT, BlobSidecarsT, ContextT,
+		AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+		BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 		DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		ForkT, ForkDataT, ValidatorT, WithdrawalT,
-		WithdrawalCredentialsT,
+		ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+		WithdrawalT, WithdrawalCredentialsT,
 	]{
 		cs:              cs,
 		executionEngine: executionEngine,
 		signer:          signer,
+		logger:          logger,
 	}
 }
 
 // Transition is the main function for processing a state transition.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) Transition(
 	ctx ContextT,
 	st BeaconStateT,
@@ -170,10 +203,11 @@ func (sp *StateProcessor[
 }
 
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.U64,
 ) ([]*transition.ValidatorUpdate, error) {
@@ -211,6 +245,13 @@ func (sp *StateProcessor[
 		if err = st.SetSlot(stateSlot + 1); err != nil {
 			return nil, err
 		}
+
+		// Upgrade the state if a fork is activated at the new epoch.
+		if uint64(stateSlot+1)%sp.cs.SlotsPerEpoch() == 0 {
+			if err = sp.processForkUpgrade(st); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return validatorUpdates, nil
@@ -218,10 +259,11 @@ func (sp *StateProcessor[
 
 // ProcessSlot is run when a slot is missed.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processSlot(
 	st BeaconStateT,
 ) error {
@@ -278,10 +320,11 @@ func (sp *StateProcessor[
 // ProcessBlock processes the block, it optionally verifies the
 // state root.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) ProcessBlock(
 	ctx ContextT,
 	st BeaconStateT,
@@ -306,10 +349,15 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessProposerSlashings
-	// phase0.ProcessAttesterSlashings
+	// process the proposer slashings.
+	if err := sp.processProposerSlashings(st, blk); err != nil {
+		return err
+	}
+
+	// process the attester slashings.
+	if err := sp.processAttesterSlashings(st, blk); err != nil {
+		return err
+	}
 
 	// process the randao reveal.
 	if err := sp.processRandaoReveal(
@@ -318,15 +366,43 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessEth1Vote
+	// credit the validators that signed the last commit.
+	if err := sp.processCommitParticipation(
+		st, ctx.GetParticipants(),
+	); err != nil {
+		return err
+	}
+
+	// process the eth1 data.
+	if err := sp.processEth1Data(st, blk); err != nil {
+		return err
+	}
 
 	// process the deposits and ensure they match the local state.
 	if err := sp.processOperations(st, blk); err != nil {
 		return err
 	}
 
+	// process the voluntary exits.
+	if err := sp.processVoluntaryExits(st, blk); err != nil {
+		return err
+	}
+
+	// process the bls to execution changes.
+	if err := sp.processBLSToExecutionChanges(st, blk); err != nil {
+		return err
+	}
+
+	// process the deposit requests of the execution payload.
+	if err := sp.processDepositRequests(st, blk); err != nil {
+		return err
+	}
+
+	// process the withdrawal requests of the execution payload.
+	if err := sp.processWithdrawalRequests(st, blk); err != nil {
+		return err
+	}
+
 	// If we are skipping validate, we can skip calculating the state
 	// root to save compute.
 	if ctx.GetSkipValidateResult() {
@@ -349,30 +425,61 @@ func (sp *StateProcessor[
 
 // processEpoch processes the epoch and ensures it matches the local state.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processEpoch(
 	st BeaconStateT,
 ) ([]*transition.ValidatorUpdate, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	// Capture the validator set of the current epoch before the registry is
+	// modified, so that only the changes to it are sent to CometBFT.
+	epoch := sp.cs.SlotToEpoch(slot)
+	prevSet, err := sp.getValidatorSet(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sp.processInactivityUpdates(st); err != nil {
+		return nil, err
+	} else if err = sp.processRewardsAndPenalties(st); err != nil {
+		return nil, err
+	} else if err = sp.processRegistryUpdates(st); err != nil {
+		return nil, err
+	} else if err = sp.processSlashings(st); err != nil {
+		return nil, err
+	} else if err = sp.processEffectiveBalanceUpdates(st); err != nil {
 		return nil, err
 	} else if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
+	} else if err = sp.processParticipationUpdates(st); err != nil {
+		return nil, err
 	}
-	return sp.processSyncCommitteeUpdates(st)
+
+	// The validator set updates apply to the upcoming epoch.
+	nextSet, err := sp.getValidatorSet(st, epoch+1)
+	if err != nil {
+		return nil, err
+	}
+	return processSyncCommitteeUpdates(prevSet, nextSet), nil
 }
 
 // processBlockHeader processes the header and ensures it matches the local
 // state.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processBlockHeader(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -452,88 +559,3 @@ func (sp *StateProcessor[
 	}
 	return nil
 }
-
-// getAttestationDeltas as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_attestation_deltas
-//
-//nolint:lll
-func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
-	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
-]) getAttestationDeltas(
-	st BeaconStateT,
-) ([]math.Gwei, []math.Gwei, error) {
-	// TODO: implement this function forreal
-	validators, err := st.GetValidators()
-	if err != nil {
-		return nil, nil, err
-	}
-	placeholder := make([]math.Gwei, len(validators))
-	return placeholder, placeholder, nil
-}
-
-// processRewardsAndPenalties as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#process_rewards_and_penalties
-//
-//nolint:lll
-func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
-	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
-]) processRewardsAndPenalties(
-	st BeaconStateT,
-) error {
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-
-	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
-		return nil
-	}
-
-	rewards, penalties, err := sp.getAttestationDeltas(st)
-	if err != nil {
-		return err
-	}
-
-	validators, err := st.GetValidators()
-	if err != nil {
-		return err
-	}
-
-	if len(validators) != len(rewards) {
-		return errors.Wrapf(
-			ErrRewardsLengthMismatch, "expected: %d, got: %d",
-			len(validators), len(rewards),
-		)
-	} else if len(validators) != len(penalties) {
-		return errors.Wrapf(
-			ErrPenaltiesLengthMismatch, "expected: %d, got: %d",
-			len(validators), len(penalties),
-		)
-	}
-
-	for i := range validators {
-		// Increase the balance of the validator.
-		if err = st.IncreaseBalance(
-			math.ValidatorIndex(i),
-			rewards[i],
-		); err != nil {
-			return err
-		}
-
-		// Decrease the balance of the validator.
-		if err = st.DecreaseBalance(
-			math.ValidatorIndex(i),
-			penalties[i],
-		); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
@@ -21,32 +21,107 @@
 package core
 
 import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
+// getValidatorSet returns the CometBFT validator set at the given epoch.
+// Validators that are active at the epoch and have a non-zero effective
+// balance are ranked by effective balance in descending order, with ties
+// broken by validator index, and the set is capped at the maximum validator
+// set size.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
-]) processSyncCommitteeUpdates(
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) getValidatorSet(
 	st BeaconStateT,
+	epoch math.Epoch,
 ) ([]*transition.ValidatorUpdate, error) {
-	vals, err := st.GetValidatorsByEffectiveBalance()
+	vals, err := st.GetValidators()
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a list of validator updates.
-	//
-	// TODO: This is a trivial implementation that is to improved upon later.
-	updates := make([]*transition.ValidatorUpdate, 0)
-	for _, val := range vals {
-		updates = append(updates, &transition.ValidatorUpdate{
-			Pubkey:           val.GetPubkey(),
-			EffectiveBalance: val.GetEffectiveBalance(),
+	// The validators are returned in index order, so the index of a
+	// candidate is its position in the registry.
+	type candidate struct {
+		index math.ValidatorIndex
+		val   ValidatorT
+	}
+	candidates := make([]candidate, 0, len(vals))
+	for i, val := range vals {
+		if val.IsActive(epoch) && val.GetEffectiveBalance() > 0 {
+			candidates = append(candidates, candidate{
+				index: math.ValidatorIndex(i),
+				val:   val,
+			})
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if c := cmp.Compare(
+			b.val.GetEffectiveBalance(), a.val.GetEffectiveBalance(),
+		); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.index, b.index)
+	})
+
+	// Only the highest ranked validators make it into the set.
+	maxSize := sp.cs.MaxValidatorSetSize()
+	if uint64(len(candidates)) > maxSize {
+		candidates = candidates[:maxSize]
+	}
+
+	set := make([]*transition.ValidatorUpdate, 0, len(candidates))
+	for _, c := range candidates {
+		set = append(set, &transition.ValidatorUpdate{
+			Pubkey:           c.val.GetPubkey(),
+			EffectiveBalance: c.val.GetEffectiveBalance(),
 		})
 	}
+	return set, nil
+}
+
+// processSyncCommitteeUpdates returns the validator set updates that turn the
+// previous CometBFT validator set into the next one. Validators whose voting
+// power is unchanged are omitted and validators that dropped out of the set
+// are removed with a zero voting power.
+func processSyncCommitteeUpdates(
+	prevSet, nextSet []*transition.ValidatorUpdate,
+) []*transition.ValidatorUpdate {
+	prevPower := make(map[crypto.BLSPubkey]math.Gwei, len(prevSet))
+	for _, val := range prevSet {
+		prevPower[val.Pubkey] = val.EffectiveBalance
+	}
 
-	return updates, nil
+	updates := make([]*transition.ValidatorUpdate, 0)
+	for _, val := range nextSet {
+		if power, ok := prevPower[val.Pubkey]; ok {
+			delete(prevPower, val.Pubkey)
+			if power == val.EffectiveBalance {
+				continue
+			}
+		}
+		updates = append(updates, val)
+	}
+
+	// Any validator left over is no longer part of the set. These are
+	// appended in the order of the previous set to remain deterministic.
+	for _, val := range prevSet {
+		if _, ok := prevPower[val.Pubkey]; ok {
+			updates = append(updates, &transition.ValidatorUpdate{
+				Pubkey:           val.Pubkey,
+				EffectiveBalance: 0,
+			})
+		}
+	}
+	return updates
 }
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetValidatorSet(t *testing.T) {
+	var (
+		cs    = newTestChainSpec()
+		maxEB = math.Gwei(cs.MaxEffectiveBalance())
+		sp    = &testStateProcessor{cs: cs}
+		st    = newTestBeaconState(
+			cs, 0, maxEB, maxEB, maxEB, maxEB, maxEB, maxEB, maxEB,
+		)
+	)
+
+	// Validator 1 exits at epoch 5 and validator 6 is still pending
+	// activation. Validators 0 and 5 have a lower effective balance and
+	// validator 0 ranks after validator 5 by effective balance even though
+	// its index is lower.
+	st.validators[1].ExitEpoch = 5
+	st.validators[6].ActivationEpoch = farFutureEpoch
+	st.validators[0].EffectiveBalance = maxEB - 2e9
+	st.validators[5].EffectiveBalance = maxEB - 1e9
+
+	// Validators 2, 3 and 4 tie on effective balance and are ranked by
+	// index, then validator 5 fills the last slot and validator 0 is cut.
+	set, err := sp.getValidatorSet(st, 5)
+	require.NoError(t, err)
+	require.Equal(t, []*transition.ValidatorUpdate{
+		{Pubkey: st.validators[2].Pubkey, EffectiveBalance: maxEB},
+		{Pubkey: st.validators[3].Pubkey, EffectiveBalance: maxEB},
+		{Pubkey: st.validators[4].Pubkey, EffectiveBalance: maxEB},
+		{Pubkey: st.validators[5].Pubkey, EffectiveBalance: maxEB - 1e9},
+	}, set)
+
+	// At genesis validator 1 is still active and takes the place of
+	// validator 5.
+	set, err = sp.getValidatorSet(st, math.Epoch(constants.GenesisEpoch))
+	require.NoError(t, err)
+	require.Equal(t, []*transition.ValidatorUpdate{
+		{Pubkey: st.validators[1].Pubkey, EffectiveBalance: maxEB},
+		{Pubkey: st.validators[2].Pubkey, EffectiveBalance: maxEB},
+		{Pubkey: st.validators[3].Pubkey, EffectiveBalance: maxEB},
+		{Pubkey: st.validators[4].Pubkey, EffectiveBalance: maxEB},
+	}, set)
+}
+
+func TestProcessSyncCommitteeUpdates(t *testing.T) {
+	var (
+		a = crypto.BLSPubkey{0x01}
+		b = crypto.BLSPubkey{0x02}
+		c = crypto.BLSPubkey{0x03}
+		d = crypto.BLSPubkey{0x04}
+	)
+	prevSet := []*transition.ValidatorUpdate{
+		{Pubkey: a, EffectiveBalance: 32e9},
+		{Pubkey: b, EffectiveBalance: 32e9},
+		{Pubkey: c, EffectiveBalance: 31e9},
+	}
+	nextSet := []*transition.ValidatorUpdate{
+		{Pubkey: d, EffectiveBalance: 32e9},
+		{Pubkey: a, EffectiveBalance: 32e9},
+		{Pubkey: c, EffectiveBalance: 30e9},
+	}
+
+	// Validator a is unchanged, validator c changed its power, validator d
+	// joined and validator b dropped out of the set.
+	require.Equal(t, []*transition.ValidatorUpdate{
+		{Pubkey: d, EffectiveBalance: 32e9},
+		{Pubkey: c, EffectiveBalance: 30e9},
+		{Pubkey: b, EffectiveBalance: 0},
+	}, processSyncCommitteeUpdates(prevSet, nextSet))
+
+	// An unchanged set produces no updates.
+	require.Empty(t, processSyncCommitteeUpdates(nextSet, nextSet))
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processForkUpgrade upgrades the state to the fork that is activated at the
+// epoch of the state, if any. It is run on the first slot of every epoch,
+// after the slot of the state has been advanced. Fork specific changes to
+// the state are applied here as part of the upgrade, and the digest of the
+// new fork is logged so that operators can verify the upgrade.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processForkUpgrade(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// The genesis fork is set when the state is initialized, so only forks
+	// that are activated after genesis upgrade the state.
+	epoch := sp.cs.SlotToEpoch(slot)
+	previous, active := sp.cs.ForkAtEpoch(epoch)
+	if active.Epoch != epoch || previous == active {
+		return nil
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	var (
+		fork     ForkT
+		forkData ForkDataT
+	)
+	digest, err := forkData.New(
+		version.FromUint32[primitives.Version](active.Version),
+		genesisValidatorsRoot,
+	).ComputeForkDigest()
+	if err != nil {
+		return err
+	}
+
+	if err = st.SetFork(fork.New(
+		version.FromUint32[primitives.Version](previous.Version),
+		version.FromUint32[primitives.Version](active.Version),
+		active.Epoch,
+	)); err != nil {
+		return err
+	}
+
+	sp.logger.Info(
+		"upgraded state to new fork 🍴",
+		"version", active.Version,
+		"epoch", active.Epoch,
+		"fork_digest", digest,
+	)
+	return nil
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	denebVersion   = version.FromUint32[primitives.Version](version.Deneb)
+	electraVersion = version.FromUint32[primitives.Version](version.Electra)
+)
+
+// withElectraForkEpoch schedules the Electra fork at the given epoch.
+func withElectraForkEpoch(epoch math.Epoch) func(*chain.SpecData[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+]) {
+	return func(data *chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]) {
+		data.ForkSchedule = []chain.ScheduledFork[math.Epoch]{
+			{Version: version.Deneb, Epoch: 0},
+			{Version: version.Electra, Epoch: epoch},
+		}
+	}
+}
+
+func TestProcessForkUpgrade_CrossesForkBoundary(t *testing.T) {
+	var (
+		cs = newTestChainSpec(withElectraForkEpoch(2))
+		sp = &testStateProcessor{cs: cs, logger: noop.NewLogger()}
+		st = newTestBeaconState(cs, 0)
+	)
+	genesisFork := &types.Fork{
+		PreviousVersion: denebVersion,
+		CurrentVersion:  denebVersion,
+		Epoch:           0,
+	}
+	electraFork := &types.Fork{
+		PreviousVersion: denebVersion,
+		CurrentVersion:  electraVersion,
+		Epoch:           2,
+	}
+	st.fork = genesisFork
+
+	// The first slot of every epoch up to and past the fork.
+	for epoch, expected := range []*types.Fork{
+		genesisFork, genesisFork, electraFork, electraFork,
+	} {
+		st.slot = math.Slot(epoch * 32)
+		require.NoError(t, sp.processForkUpgrade(st))
+		require.Equal(t, expected, st.fork, "epoch %d", epoch)
+	}
+}
+
+func TestProcessVoluntaryExit_AcrossForkBoundary(t *testing.T) {
+	tests := []struct {
+		name    string
+		epoch   math.Epoch
+		version primitives.Version
+	}{
+		{
+			name:    "exit signed before the fork",
+			epoch:   9,
+			version: denebVersion,
+		},
+		{
+			name:    "exit signed after the fork",
+			epoch:   10,
+			version: electraVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				cs     = newTestChainSpec(withElectraForkEpoch(10))
+				maxEB  = math.Gwei(cs.MaxEffectiveBalance())
+				signer = &mocks.BLSSigner{}
+				sp     = &testStateProcessor{cs: cs, signer: signer}
+				// The state is at epoch 10, the first epoch of Electra.
+				st   = newTestBeaconState(cs, math.Slot(10*32), maxEB)
+				exit = &types.SignedVoluntaryExit{
+					Message: &types.VoluntaryExit{
+						Epoch:          tt.epoch,
+						ValidatorIndex: 0,
+					},
+				}
+			)
+
+			// The exit is signed over the domain of the fork that is
+			// active at the epoch of the exit.
+			domain, err := types.NewForkData(
+				tt.version, common.Root{},
+			).ComputeDomain(cs.DomainTypeVoluntaryExit())
+			require.NoError(t, err)
+			signingRoot, err := ssz.ComputeSigningRoot(exit.Message, domain)
+			require.NoError(t, err)
+
+			signer.On(
+				"VerifySignature", st.validators[0].Pubkey,
+				signingRoot[:], mock.Anything,
+			).Return(nil)
+
+			require.NoError(t, sp.processVoluntaryExit(st, exit))
+			signer.AssertExpectations(t)
+		})
+	}
+}
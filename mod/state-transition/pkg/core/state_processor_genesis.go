@@ -26,6 +26,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -35,10 +37,11 @@ import (
 //
 //nolint:gocognit,funlen // todo fix.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -65,12 +68,18 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// Genesis deposits are not made to the deposit contract, whose deposit
+	// count and deposit tree start empty. The first deposit processed by
+	// the state is hence the first deposit made to the contract.
 	if err := st.SetEth1DepositIndex(0); err != nil {
 		return nil, err
 	}
 
 	if err := st.SetEth1Data(eth1Data.New(
-		bytes.B32(common.ZeroHash),
+		merkle.MixinLength(
+			primitives.Root(zero.Hashes[constants.DepositContractTreeDepth]),
+			0,
+		),
 		0,
 		executionPayloadHeader.GetBlockHash(),
 	)); err != nil {
@@ -105,20 +114,40 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// Genesis deposits are part of the genesis and carry no Merkle proof.
 	for _, deposit := range deposits {
-		// TODO: process deposits into eth1 data.
-		if err = sp.processDeposit(st, deposit); err != nil {
+		if err = sp.applyDeposit(st, deposit); err != nil {
 			return nil, err
 		}
 	}
 
-	// TODO: process activations.
+	// Process the genesis activations.
 	var validators []ValidatorT
 	validators, err = st.GetValidators()
 	if err != nil {
 		return nil, err
 	}
 
+	var activations int
+	for i, val := range validators {
+		if val.GetEffectiveBalance() != math.Gwei(sp.cs.MaxEffectiveBalance()) {
+			continue
+		}
+		val.SetActivationEligibilityEpoch(math.Epoch(constants.GenesisEpoch))
+		val.SetActivationEpoch(math.Epoch(constants.GenesisEpoch))
+		if err = st.UpdateValidatorAtIndex(
+			math.ValidatorIndex(i), val,
+		); err != nil {
+			return nil, err
+		}
+		activations++
+	}
+
+	// CometBFT cannot start a chain with an empty validator set.
+	if activations == 0 {
+		return nil, ErrNoGenesisValidators
+	}
+
 	var validatorsRoot primitives.Root
 	validatorsRoot, err = ssz.MerkleizeListComposite[
 		common.ChainSpec, math.U64, [32]byte,
@@ -162,8 +191,9 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// The genesis validator set is sent to CometBFT in full.
 	var updates []*transition.ValidatorUpdate
-	updates, err = sp.processSyncCommitteeUpdates(st)
+	updates, err = sp.getValidatorSet(st, math.Epoch(constants.GenesisEpoch))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializePreminedBeaconStateFromEth1_ContractDeposits(t *testing.T) {
+	var (
+		cs     = newTestChainSpec()
+		signer = &mocks.BLSSigner{}
+		sp     = &testStateProcessor{cs: cs, signer: signer}
+		st     = newTestBeaconState(cs, 0)
+	)
+	signer.On(
+		"VerifySignature", mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	genesisDeposits := make([]*types.Deposit, 3)
+	for i := range genesisDeposits {
+		genesisDeposits[i] = &types.Deposit{
+			Pubkey: crypto.BLSPubkey{0x01, byte(i)},
+			Amount: math.Gwei(cs.MaxEffectiveBalance()),
+			Index:  uint64(i),
+		}
+	}
+
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st, genesisDeposits,
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				BlockHash: common.ExecutionHash{0x01},
+			},
+		},
+		version.FromUint32[primitives.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	require.Len(t, st.validators, len(genesisDeposits))
+
+	// The genesis deposits are not part of the deposit contract, hence the
+	// state expects the first deposit made to the contract next.
+	require.Zero(t, st.eth1DepositIndex)
+	require.Zero(t, st.eth1Data.DepositCount)
+
+	// The first deposit made to the contract has index 0 and is proven
+	// against the deposit root of the contract.
+	dep := &types.Deposit{
+		Pubkey: crypto.BLSPubkey{0x02},
+		Amount: math.Gwei(cs.MaxEffectiveBalance()),
+		Index:  0,
+	}
+	leaf, err := dep.GetDepositDataRoot()
+	require.NoError(t, err)
+	tree, err := merkle.NewTreeFromLeavesWithDepth[common.Root, common.Root](
+		[]common.Root{leaf}, constants.DepositContractTreeDepth,
+	)
+	require.NoError(t, err)
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	proof, err := tree.MerkleProofWithMixin(0)
+	require.NoError(t, err)
+	var branch [33]common.Root
+	for i := range proof {
+		branch[i] = proof[i]
+	}
+	dep.SetProof(branch)
+
+	eth1Data := &types.Eth1Data{
+		DepositRoot:  root,
+		DepositCount: 1,
+		BlockHash:    common.ExecutionHash{0x02},
+	}
+	blk := newTestBlock(eth1Data, dep)
+	require.NoError(t, sp.processEth1Data(st, blk))
+	require.NoError(t, sp.processOperations(st, blk))
+	require.Equal(t, uint64(1), st.eth1DepositIndex)
+	require.Len(t, st.validators, len(genesisDeposits)+1)
+}
+
+func TestInitializePreminedBeaconStateFromEth1_NoValidators(t *testing.T) {
+	var (
+		cs     = newTestChainSpec()
+		signer = &mocks.BLSSigner{}
+		sp     = &testStateProcessor{cs: cs, signer: signer}
+		st     = newTestBeaconState(cs, 0)
+	)
+	signer.On(
+		"VerifySignature", mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	// The deposit is too small for the validator to be activated at genesis.
+	_, err := sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{{
+			Pubkey: crypto.BLSPubkey{0x01},
+			Amount: math.Gwei(cs.MaxEffectiveBalance() / 2),
+		}},
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				BlockHash: common.ExecutionHash{0x01},
+			},
+		},
+		version.FromUint32[primitives.Version](version.Deneb),
+	)
+	require.ErrorIs(t, err, ErrNoGenesisValidators)
+}
@@ -0,0 +1,400 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processRegistryUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#registry-updates
+//
+// Since CometBFT provides single slot finality, the current epoch is used in
+// place of the finalized checkpoint epoch when dequeuing activations.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processRegistryUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	// Process the activation eligibility and ejections.
+	activationQueue := make([]math.ValidatorIndex, 0)
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if val.IsEligibleForActivationQueue(
+			math.Gwei(sp.cs.MaxEffectiveBalance()),
+		) {
+			val.SetActivationEligibilityEpoch(epoch + 1)
+			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+				return err
+			}
+		}
+
+		if val.IsActive(epoch) && val.GetEffectiveBalance() <= math.Gwei(
+			sp.cs.EjectionBalance(),
+		) {
+			if err = sp.initiateValidatorExit(st, idx); err != nil {
+				return err
+			}
+		}
+
+		if val.IsEligibleForActivation(epoch) {
+			activationQueue = append(activationQueue, idx)
+		}
+	}
+
+	// Queue the validators eligible for activation, ordered by the epoch in
+	// which they became eligible and then by their index.
+	slices.SortStableFunc(activationQueue, func(a, b math.ValidatorIndex) int {
+		return cmp.Compare(
+			validators[a].GetActivationEligibilityEpoch(),
+			validators[b].GetActivationEligibilityEpoch(),
+		)
+	})
+
+	churnLimit, err := sp.getValidatorChurnLimit(st)
+	if err != nil {
+		return err
+	}
+
+	// Dequeue the validators for activation up to the churn limit.
+	activationEpoch := sp.computeActivationExitEpoch(epoch)
+	for _, idx := range activationQueue[:min(
+		uint64(len(activationQueue)), churnLimit,
+	)] {
+		val := validators[idx]
+		val.SetActivationEpoch(activationEpoch)
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processVoluntaryExits processes the voluntary exits of the block.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processVoluntaryExits(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	exits := blk.GetBody().GetVoluntaryExits()
+	if uint64(len(exits)) > sp.cs.MaxVoluntaryExitsPerBlock() {
+		return errors.Wrapf(ErrExceedsBlockVoluntaryExitLimit,
+			"expected: %d, got: %d",
+			sp.cs.MaxVoluntaryExitsPerBlock(), len(exits),
+		)
+	}
+
+	for _, exit := range exits {
+		if err := sp.processVoluntaryExit(st, exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntary-exits
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	val, err := st.ValidatorByIndex(exit.GetValidatorIndex())
+	if err != nil {
+		return err
+	}
+
+	// Verify the validator is active and has not already initiated its exit.
+	switch {
+	case !val.IsActive(epoch):
+		return errors.Wrapf(
+			ErrValidatorNotActive, "index: %d", exit.GetValidatorIndex(),
+		)
+	case val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch):
+		return errors.Wrapf(
+			ErrValidatorAlreadyExited, "index: %d", exit.GetValidatorIndex(),
+		)
+	case epoch < exit.GetEpoch():
+		return errors.Wrapf(
+			ErrVoluntaryExitTooEarly, "current epoch: %d, exit epoch: %d",
+			epoch, exit.GetEpoch(),
+		)
+	case epoch < val.GetActivationEpoch()+math.Epoch(
+		sp.cs.ShardCommitteePeriod(),
+	):
+		return errors.Wrapf(
+			ErrVoluntaryExitTooEarly, "current epoch: %d, activation epoch: %d",
+			epoch, val.GetActivationEpoch(),
+		)
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	// Verify the validator signed the exit.
+	var fd ForkDataT
+	if err = exit.VerifySignature(
+		fd.New(
+			version.FromUint32[primitives.Version](
+				sp.cs.ActiveForkVersionForEpoch(exit.GetEpoch()),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeVoluntaryExit(),
+		val.GetPubkey(),
+		sp.signer.VerifySignature,
+	); err != nil {
+		return err
+	}
+
+	return sp.initiateValidatorExit(st, exit.GetValidatorIndex())
+}
+
+// initiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Return if the validator has already initiated its exit.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	sp.exitQueueMu.Lock()
+	defer sp.exitQueueMu.Unlock()
+	q, err := sp.getExitQueue(st)
+	if err != nil {
+		return err
+	}
+
+	exitQueueEpoch, exitQueueChurn := q.exitEpoch, q.churn
+	if exitQueueChurn >= q.churnLimit {
+		exitQueueEpoch++
+		exitQueueChurn = 0
+	}
+
+	// Set the validator exit and withdrawable epochs.
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(
+		exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return err
+	}
+	q.exitEpoch, q.churn = exitQueueEpoch, exitQueueChurn+1
+	return nil
+}
+
+// exitQueue is the exit queue of a beacon state during an epoch. The exit
+// epochs of the validators only change when an exit is initiated and the
+// churn limit only changes between epochs, so the exit queue is computed
+// once per epoch and kept up to date as exits are initiated, instead of
+// rescanning the validator set for every exit.
+type exitQueue struct {
+	// st is the beacon state the exit queue belongs to.
+	st any
+	// epoch is the epoch the exit queue was computed in.
+	epoch math.Epoch
+	// exitEpoch is the latest exit epoch that has been scheduled.
+	exitEpoch math.Epoch
+	// churn is the number of validators exiting in exitEpoch.
+	churn uint64
+	// churnLimit is the validator churn limit of the epoch.
+	churnLimit uint64
+}
+
+// getExitQueue returns the exit queue of the given state, computing it if
+// it is not cached for the current epoch of the state. The caller must hold
+// exitQueueMu.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) getExitQueue(
+	st BeaconStateT,
+) (*exitQueue, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+	if q := sp.exitQueue; q != nil && q.st == any(st) && q.epoch == epoch {
+		return q, nil
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	// The exit queue epoch is the latest of the exit epochs that have
+	// already been scheduled, and no earlier than the first epoch an exit
+	// initiated now can take effect in.
+	q := &exitQueue{
+		st:        st,
+		epoch:     epoch,
+		exitEpoch: sp.computeActivationExitEpoch(epoch),
+	}
+	var activeValidators uint64
+	for _, v := range validators {
+		if v.IsActive(epoch) {
+			activeValidators++
+		}
+
+		switch exitEpoch := v.GetExitEpoch(); {
+		case exitEpoch == math.Epoch(constants.FarFutureEpoch):
+		case exitEpoch > q.exitEpoch:
+			q.exitEpoch, q.churn = exitEpoch, 1
+		case exitEpoch == q.exitEpoch:
+			q.churn++
+		}
+	}
+	q.churnLimit = sp.computeValidatorChurnLimit(activeValidators)
+
+	sp.exitQueue = q
+	return q, nil
+}
+
+// getValidatorChurnLimit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) getValidatorChurnLimit(
+	st BeaconStateT,
+) (uint64, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return 0, err
+	}
+
+	var activeValidators uint64
+	for _, val := range validators {
+		if val.IsActive(epoch) {
+			activeValidators++
+		}
+	}
+
+	return sp.computeValidatorChurnLimit(activeValidators), nil
+}
+
+// computeValidatorChurnLimit returns the validator churn limit for the given
+// number of active validators.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) computeValidatorChurnLimit(
+	activeValidators uint64,
+) uint64 {
+	return max(
+		sp.cs.MinPerEpochChurnLimit(),
+		activeValidators/sp.cs.ChurnLimitQuotient(),
+	)
+}
+
+// computeActivationExitEpoch as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_activation_exit_epoch
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) computeActivationExitEpoch(
+	epoch math.Epoch,
+) math.Epoch {
+	return epoch + 1 + math.Epoch(sp.cs.MaxSeedLookahead())
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const farFutureEpoch = math.Epoch(constants.FarFutureEpoch)
+
+func TestProcessRegistryUpdates(t *testing.T) {
+	var (
+		cs    = newTestChainSpec()
+		maxEB = math.Gwei(cs.MaxEffectiveBalance())
+		sp    = &testStateProcessor{cs: cs}
+		// The state is at epoch 2.
+		st = newTestBeaconState(
+			cs, math.Slot(2*32), maxEB, maxEB, maxEB, maxEB, maxEB, maxEB,
+		)
+	)
+
+	// Validator 0 has just deposited.
+	st.validators[0].ActivationEligibilityEpoch = farFutureEpoch
+	st.validators[0].ActivationEpoch = farFutureEpoch
+	// Validators 1 to 3 are waiting in the activation queue.
+	for idx, eligibilityEpoch := range map[int]math.Epoch{1: 2, 2: 1, 3: 1} {
+		st.validators[idx].ActivationEligibilityEpoch = eligibilityEpoch
+		st.validators[idx].ActivationEpoch = farFutureEpoch
+	}
+	// Validator 4 is active with an effective balance below the ejection
+	// balance.
+	st.validators[4].EffectiveBalance = math.Gwei(cs.EjectionBalance())
+
+	require.NoError(t, sp.processRegistryUpdates(st))
+
+	// Validator 0 joins the activation queue.
+	require.Equal(t, math.Epoch(3), st.validators[0].ActivationEligibilityEpoch)
+	require.Equal(t, farFutureEpoch, st.validators[0].ActivationEpoch)
+
+	// Only two validators are active, so the churn limit is the minimum of
+	// 2 and the validators that became eligible first are activated.
+	require.Equal(t, farFutureEpoch, st.validators[1].ActivationEpoch)
+	require.Equal(t, math.Epoch(4), st.validators[2].ActivationEpoch)
+	require.Equal(t, math.Epoch(4), st.validators[3].ActivationEpoch)
+
+	// Validator 4 is ejected.
+	require.Equal(t, math.Epoch(4), st.validators[4].ExitEpoch)
+	require.Equal(t, math.Epoch(6), st.validators[4].WithdrawableEpoch)
+
+	// Validator 5 is untouched.
+	require.Equal(t, farFutureEpoch, st.validators[5].ExitEpoch)
+}
+
+func TestInitiateValidatorExit(t *testing.T) {
+	var (
+		cs    = newTestChainSpec()
+		maxEB = math.Gwei(cs.MaxEffectiveBalance())
+		sp    = &testStateProcessor{cs: cs}
+		st    = newTestBeaconState(cs, 0, maxEB, maxEB, maxEB, maxEB)
+	)
+
+	for idx := range 3 {
+		require.NoError(
+			t, sp.initiateValidatorExit(st, math.ValidatorIndex(idx)),
+		)
+	}
+
+	// The churn limit is 2, so the third exit is pushed to the next epoch.
+	for idx, exitEpoch := range []math.Epoch{2, 2, 3} {
+		require.Equal(t, exitEpoch, st.validators[idx].ExitEpoch)
+		require.Equal(t, exitEpoch+2, st.validators[idx].WithdrawableEpoch)
+	}
+	require.Equal(t, farFutureEpoch, st.validators[3].ExitEpoch)
+
+	// Initiating the exit of an exited validator is a no-op.
+	st.slot = math.Slot(10 * 32)
+	require.NoError(t, sp.initiateValidatorExit(st, 0))
+	require.Equal(t, math.Epoch(2), st.validators[0].ExitEpoch)
+}
+
+func TestInitiateValidatorExit_ExitQueue(t *testing.T) {
+	var (
+		cs    = newTestChainSpec()
+		maxEB = math.Gwei(cs.MaxEffectiveBalance())
+		sp    = &testStateProcessor{cs: cs}
+		st    = newTestBeaconState(cs, 0, maxEB, maxEB, maxEB, maxEB)
+		other = newTestBeaconState(cs, 0, maxEB, maxEB, maxEB, maxEB)
+	)
+
+	// Fill the exit queue of the first epoch exits can take effect in.
+	require.NoError(t, sp.initiateValidatorExit(st, 0))
+	require.NoError(t, sp.initiateValidatorExit(st, 1))
+
+	// The exit queue of another state is independent.
+	require.NoError(t, sp.initiateValidatorExit(other, 0))
+	require.Equal(t, math.Epoch(2), other.validators[0].ExitEpoch)
+
+	// The exit queue of the first state is still full.
+	require.NoError(t, sp.initiateValidatorExit(st, 2))
+	require.Equal(t, math.Epoch(3), st.validators[2].ExitEpoch)
+
+	// In a later epoch, the exit queue is recomputed from the state.
+	st.slot = math.Slot(5 * 32)
+	require.NoError(t, sp.initiateValidatorExit(st, 3))
+	require.Equal(t, math.Epoch(7), st.validators[3].ExitEpoch)
+}
+
+func TestProcessVoluntaryExit(t *testing.T) {
+	errInvalidSignature := errors.New("invalid signature")
+
+	tests := []struct {
+		name        string
+		malleate    func(*testBeaconState, *types.SignedVoluntaryExit)
+		sigErr      error
+		expectedErr error
+	}{
+		{
+			name:     "valid voluntary exit",
+			malleate: func(*testBeaconState, *types.SignedVoluntaryExit) {},
+		},
+		{
+			name: "validator is not active",
+			malleate: func(st *testBeaconState, _ *types.SignedVoluntaryExit) {
+				st.validators[1].ActivationEpoch = farFutureEpoch
+			},
+			expectedErr: ErrValidatorNotActive,
+		},
+		{
+			name: "validator has already exited",
+			malleate: func(st *testBeaconState, _ *types.SignedVoluntaryExit) {
+				st.validators[1].ExitEpoch = 20
+			},
+			expectedErr: ErrValidatorAlreadyExited,
+		},
+		{
+			name: "exit epoch is in the future",
+			malleate: func(_ *testBeaconState, e *types.SignedVoluntaryExit) {
+				e.Message.Epoch = 11
+			},
+			expectedErr: ErrVoluntaryExitTooEarly,
+		},
+		{
+			name: "validator has not been active long enough",
+			malleate: func(st *testBeaconState, _ *types.SignedVoluntaryExit) {
+				st.validators[1].ActivationEpoch = 7
+			},
+			expectedErr: ErrVoluntaryExitTooEarly,
+		},
+		{
+			name:        "invalid signature",
+			malleate:    func(*testBeaconState, *types.SignedVoluntaryExit) {},
+			sigErr:      errInvalidSignature,
+			expectedErr: errInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				cs     = newTestChainSpec()
+				maxEB  = math.Gwei(cs.MaxEffectiveBalance())
+				signer = &mocks.BLSSigner{}
+				sp     = &testStateProcessor{cs: cs, signer: signer}
+				// The state is at epoch 10.
+				st   = newTestBeaconState(cs, math.Slot(10*32), maxEB, maxEB)
+				exit = &types.SignedVoluntaryExit{
+					Message: &types.VoluntaryExit{
+						Epoch:          10,
+						ValidatorIndex: 1,
+					},
+				}
+			)
+			signer.On(
+				"VerifySignature", st.validators[1].Pubkey,
+				mock.Anything, mock.Anything,
+			).Return(tt.sigErr)
+			tt.malleate(st, exit)
+
+			err := sp.processVoluntaryExit(st, exit)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.NotEqual(
+					t, math.Epoch(12), st.validators[1].ExitEpoch,
+				)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, math.Epoch(12), st.validators[1].ExitEpoch)
+			require.Equal(
+				t, math.Epoch(14), st.validators[1].WithdrawableEpoch,
+			)
+		})
+	}
+}
+
+func TestProcessVoluntaryExits_ExceedsLimit(t *testing.T) {
+	var (
+		sp    = &testStateProcessor{cs: newTestChainSpec()}
+		st    = newTestBeaconState(sp.cs, 0)
+		exits = make(
+			[]*types.SignedVoluntaryExit, sp.cs.MaxVoluntaryExitsPerBlock()+1,
+		)
+		blk = newTestBlock(&types.Eth1Data{})
+	)
+	blk.GetBody().SetVoluntaryExits(exits)
+
+	require.ErrorIs(
+		t, sp.processVoluntaryExits(st, blk),
+		ErrExceedsBlockVoluntaryExitLimit,
+	)
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// processDepositRequests processes the deposit requests made to the deposit
+// contract in the execution payload of the block (EIP-6110). Before the
+// deposit requests fork epoch, deposits are processed from the deposit
+// contract logs instead and the requests are ignored.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processDepositRequests(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	if sp.cs.SlotToEpoch(blk.GetSlot()) < sp.cs.DepositRequestsForkEpoch() {
+		return nil
+	}
+
+	requests := blk.GetBody().GetExecutionPayload().GetDepositRequests()
+	if uint64(len(requests)) > constants.MaxDepositRequestsPerPayload {
+		return errors.Wrapf(ErrExceedsPayloadDepositRequestLimit,
+			"expected: %d, got: %d",
+			constants.MaxDepositRequestsPerPayload, len(requests),
+		)
+	}
+
+	for _, request := range requests {
+		if err := sp.processDepositRequest(st, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processDepositRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_deposit_request
+//
+// The index of the first request is recorded so that deposits made before it
+// are still processed from the deposit contract logs, while requests for
+// deposits that were already processed from the logs are skipped. A deposit
+// for a new validator with an invalid signature is ignored, as the execution
+// layer cannot verify it.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processDepositRequest(
+	st BeaconStateT,
+	request *engineprimitives.DepositRequest,
+) error {
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex {
+		if err = st.SetDepositRequestsStartIndex(
+			uint64(request.GetIndex()),
+		); err != nil {
+			return err
+		}
+	}
+
+	// Deposits that were already processed from the deposit contract logs
+	// are not applied twice.
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	if uint64(request.GetIndex()) < depositIndex {
+		return nil
+	}
+
+	var dep DepositT
+	dep = dep.New(
+		request.GetPubkey(),
+		WithdrawalCredentialsT(request.GetWithdrawalCredentials()),
+		request.GetAmount(),
+		request.GetSignature(),
+		uint64(request.GetIndex()),
+	)
+
+	if _, err = st.ValidatorIndexByPubkey(dep.GetPubkey()); err == nil {
+		return sp.applyDeposit(st, dep)
+	}
+	if err = sp.verifyDepositSignature(st, dep); err != nil {
+		//nolint:nilerr // invalid deposits are ignored.
+		return nil
+	}
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// processWithdrawalRequests processes the withdrawal requests made by
+// withdrawal addresses in the execution payload of the block (EIP-7002).
+// Payloads before Electra do not carry any withdrawal requests.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processWithdrawalRequests(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	requests := blk.GetBody().GetExecutionPayload().GetWithdrawalRequests()
+	if uint64(len(requests)) > constants.MaxWithdrawalRequestsPerPayload {
+		return errors.Wrapf(ErrExceedsPayloadWithdrawalRequestLimit,
+			"expected: %d, got: %d",
+			constants.MaxWithdrawalRequestsPerPayload, len(requests),
+		)
+	}
+
+	for _, request := range requests {
+		if err := sp.processWithdrawalRequest(st, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processWithdrawalRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+//
+// The request is made by the execution layer, which cannot validate it, so
+// requests that do not apply are ignored rather than invalidating the block.
+// Only full exits are supported, as partial withdrawals require compounding
+// withdrawal credentials.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	request *engineprimitives.WithdrawalRequest,
+) error {
+	if !request.IsFullExit() {
+		return nil
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(request.GetValidatorPubkey())
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Verify the request was made by the withdrawal address of the
+	// validator.
+	address, err := val.GetWithdrawalCredentials().ToExecutionAddress()
+	if err != nil || address != request.GetSourceAddress() {
+		//nolint:nilerr // requests from other addresses are ignored.
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	// Verify the validator is active, has not already initiated its exit and
+	// has been active long enough to exit.
+	if !val.IsActive(epoch) ||
+		val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) ||
+		epoch < val.GetActivationEpoch()+math.Epoch(
+			sp.cs.ShardCommitteePeriod(),
+		) {
+		return nil
+	}
+
+	return sp.initiateValidatorExit(st, idx)
+}
@@ -0,0 +1,340 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestElectraBlock creates an Electra block whose execution payload
+// carries the given withdrawal requests.
+func newTestElectraBlock(
+	requests ...*engineprimitives.WithdrawalRequest,
+) *types.BeaconBlock {
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockElectra{
+			Body: &types.BeaconBlockBodyElectra{
+				ExecutionPayload: &types.ExecutableDataElectra{
+					WithdrawalRequests: requests,
+				},
+			},
+		},
+	}
+}
+
+func TestProcessWithdrawalRequests(t *testing.T) {
+	address := common.ExecutionAddress{0xaa}
+	tests := []struct {
+		name string
+		// modify prepares validator 0 and the request that targets it.
+		modify    func(*types.Validator, *engineprimitives.WithdrawalRequest)
+		exitEpoch math.Epoch
+	}{
+		{
+			name: "full exit",
+			modify: func(
+				*types.Validator, *engineprimitives.WithdrawalRequest,
+			) {
+			},
+			// The state is at epoch 10, so the exit is scheduled for the
+			// epoch after the seed lookahead.
+			exitEpoch: 12,
+		},
+		{
+			name: "partial withdrawal",
+			modify: func(
+				_ *types.Validator, req *engineprimitives.WithdrawalRequest,
+			) {
+				req.Amount = math.Gwei(1e9)
+			},
+			exitEpoch: farFutureEpoch,
+		},
+		{
+			name: "unknown validator",
+			modify: func(
+				_ *types.Validator, req *engineprimitives.WithdrawalRequest,
+			) {
+				req.ValidatorPubkey = crypto.BLSPubkey{0xff}
+			},
+			exitEpoch: farFutureEpoch,
+		},
+		{
+			name: "different source address",
+			modify: func(
+				_ *types.Validator, req *engineprimitives.WithdrawalRequest,
+			) {
+				req.SourceAddress = common.ExecutionAddress{0xbb}
+			},
+			exitEpoch: farFutureEpoch,
+		},
+		{
+			name: "bls withdrawal credentials",
+			modify: func(
+				val *types.Validator, _ *engineprimitives.WithdrawalRequest,
+			) {
+				val.WithdrawalCredentials = types.
+					NewCredentialsFromBLSPubkey(val.Pubkey)
+			},
+			exitEpoch: farFutureEpoch,
+		},
+		{
+			name: "not active long enough",
+			modify: func(
+				val *types.Validator, _ *engineprimitives.WithdrawalRequest,
+			) {
+				val.ActivationEpoch = 8
+			},
+			exitEpoch: farFutureEpoch,
+		},
+		{
+			name: "already exiting",
+			modify: func(
+				val *types.Validator, _ *engineprimitives.WithdrawalRequest,
+			) {
+				val.ExitEpoch = 20
+			},
+			exitEpoch: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				cs    = newTestChainSpec()
+				maxEB = math.Gwei(cs.MaxEffectiveBalance())
+				sp    = &testStateProcessor{cs: cs}
+				st    = newTestBeaconState(cs, math.Slot(10*32), maxEB, maxEB)
+				val   = st.validators[0]
+				req   = &engineprimitives.WithdrawalRequest{
+					SourceAddress:   address,
+					ValidatorPubkey: val.Pubkey,
+					Amount:          math.Gwei(constants.FullExitRequestAmount),
+				}
+			)
+			val.WithdrawalCredentials = types.
+				NewCredentialsFromExecutionAddress(address)
+			tt.modify(val, req)
+
+			require.NoError(
+				t, sp.processWithdrawalRequests(st, newTestElectraBlock(req)),
+			)
+			require.Equal(t, tt.exitEpoch, st.validators[0].ExitEpoch)
+
+			// The other validator is never affected.
+			require.Equal(t, farFutureEpoch, st.validators[1].ExitEpoch)
+		})
+	}
+}
+
+func TestProcessWithdrawalRequests_Deneb(t *testing.T) {
+	var (
+		cs = newTestChainSpec()
+		sp = &testStateProcessor{cs: cs}
+		st = newTestBeaconState(cs, 0, math.Gwei(cs.MaxEffectiveBalance()))
+	)
+
+	// Deneb payloads do not carry any withdrawal requests.
+	require.NoError(t, sp.processWithdrawalRequests(st, newTestBlock(nil)))
+	require.Equal(t, farFutureEpoch, st.validators[0].ExitEpoch)
+}
+
+func TestProcessWithdrawalRequests_ExceedsLimit(t *testing.T) {
+	var (
+		cs       = newTestChainSpec()
+		sp       = &testStateProcessor{cs: cs}
+		st       = newTestBeaconState(cs, 0)
+		requests = make(
+			[]*engineprimitives.WithdrawalRequest,
+			constants.MaxWithdrawalRequestsPerPayload+1,
+		)
+	)
+	for i := range requests {
+		requests[i] = &engineprimitives.WithdrawalRequest{}
+	}
+
+	err := sp.processWithdrawalRequests(st, newTestElectraBlock(requests...))
+	require.ErrorIs(t, err, ErrExceedsPayloadWithdrawalRequestLimit)
+}
+
+// withDepositRequestsForkEpoch processes deposit requests from the given
+// epoch.
+func withDepositRequestsForkEpoch(epoch math.Epoch) func(*chain.SpecData[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+]) {
+	return func(data *chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]) {
+		data.DepositRequestsForkEpoch = epoch
+	}
+}
+
+// newTestDepositRequestsBlock creates an Electra block at the given slot
+// whose execution payload carries the given deposit requests.
+func newTestDepositRequestsBlock(
+	slot math.Slot,
+	requests ...*engineprimitives.DepositRequest,
+) *types.BeaconBlock {
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockElectra{
+			BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{Slot: uint64(slot)},
+			Body: &types.BeaconBlockBodyElectra{
+				ExecutionPayload: &types.ExecutableDataElectra{
+					DepositRequests: requests,
+				},
+			},
+		},
+	}
+}
+
+func TestProcessDepositRequests(t *testing.T) {
+	var (
+		errInvalidSignature = errors.New("invalid signature")
+		newPubkey           = crypto.BLSPubkey{0xaa}
+		amount              = math.Gwei(32e9)
+	)
+
+	tests := []struct {
+		name      string
+		forkEpoch math.Epoch
+		pubkey    crypto.BLSPubkey
+		index     math.U64
+		sigErr    error
+		// numValidators is the expected number of validators.
+		numValidators int
+		// balance is the expected balance of the deposit's validator.
+		balance    math.Gwei
+		startIndex uint64
+	}{
+		{
+			name:          "before the fork epoch",
+			forkEpoch:     11,
+			pubkey:        newPubkey,
+			index:         5,
+			numValidators: 1,
+			startIndex:    constants.UnsetDepositRequestsStartIndex,
+		},
+		{
+			name:          "new validator",
+			forkEpoch:     10,
+			pubkey:        newPubkey,
+			index:         5,
+			numValidators: 2,
+			balance:       amount,
+			startIndex:    5,
+		},
+		{
+			name:          "new validator with invalid signature",
+			forkEpoch:     10,
+			pubkey:        newPubkey,
+			index:         5,
+			sigErr:        errInvalidSignature,
+			numValidators: 1,
+			startIndex:    5,
+		},
+		{
+			name:      "top up",
+			forkEpoch: 10,
+			// The pubkey of the existing validator.
+			pubkey:        crypto.BLSPubkey{},
+			index:         5,
+			numValidators: 1,
+			balance:       2 * amount,
+			startIndex:    5,
+		},
+		{
+			name:          "already processed from the logs",
+			forkEpoch:     10,
+			pubkey:        newPubkey,
+			index:         2,
+			numValidators: 1,
+			startIndex:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				cs = newTestChainSpec(
+					withDepositRequestsForkEpoch(tt.forkEpoch),
+				)
+				signer = &mocks.BLSSigner{}
+				sp     = &testStateProcessor{cs: cs, signer: signer}
+				slot   = math.Slot(10 * cs.SlotsPerEpoch())
+				st     = newTestBeaconState(cs, slot, amount)
+			)
+			st.fork = &types.Fork{}
+			st.eth1DepositIndex = 3
+			signer.On(
+				"VerifySignature", tt.pubkey, mock.Anything, mock.Anything,
+			).Return(tt.sigErr)
+
+			blk := newTestDepositRequestsBlock(
+				slot, &engineprimitives.DepositRequest{
+					Pubkey: tt.pubkey,
+					Amount: amount,
+					Index:  tt.index,
+				},
+			)
+			require.NoError(t, sp.processDepositRequests(st, blk))
+			require.Len(t, st.validators, tt.numValidators)
+			require.Equal(t, tt.startIndex, st.depositRequestsStartIndex)
+
+			// Deposits made to the contract are never processed from the
+			// deposit requests.
+			require.Equal(t, uint64(3), st.eth1DepositIndex)
+
+			if idx, err := st.ValidatorIndexByPubkey(tt.pubkey); err == nil {
+				require.Equal(t, tt.balance, st.balances[idx])
+			}
+		})
+	}
+}
+
+func TestProcessDepositRequests_ExceedsLimit(t *testing.T) {
+	var (
+		sp       = &testStateProcessor{cs: newTestChainSpec()}
+		st       = newTestBeaconState(sp.cs, 0)
+		requests = make(
+			[]*engineprimitives.DepositRequest,
+			constants.MaxDepositRequestsPerPayload+1,
+		)
+	)
+	for i := range requests {
+		requests[i] = &engineprimitives.DepositRequest{}
+	}
+
+	err := sp.processDepositRequests(
+		st, newTestDepositRequestsBlock(0, requests...),
+	)
+	require.ErrorIs(t, err, ErrExceedsPayloadDepositRequestLimit)
+}
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// processCommitParticipation credits every validator that signed the last
+// CometBFT commit with one unit of participation for the current epoch.
+// Participants that do not map to a validator in the state are skipped, as
+// a mismatch between the CometBFT and beacon validator sets must not halt
+// the chain.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processCommitParticipation(
+	st BeaconStateT,
+	participants [][]byte,
+) error {
+	var (
+		idx           math.ValidatorIndex
+		participation uint64
+		err           error
+	)
+
+	for _, address := range participants {
+		if idx, err = st.ValidatorIndexByCometBFTAddress(
+			address,
+		); err != nil {
+			sp.logger.Warn(
+				"skipping unknown commit participant",
+				"address", common.DisplayBytes(address),
+				"error", err,
+			)
+			continue
+		}
+
+		if participation, err = st.GetEpochParticipationAtIndex(
+			idx,
+		); err != nil {
+			return err
+		} else if err = st.SetEpochParticipationAtIndex(
+			idx, participation+1,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processInactivityUpdates as defined in the Ethereum 2.0 specification,
+// with a validator considered inactive for an epoch if it did not sign a
+// single commit during that epoch.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#inactivity-scores
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processInactivityUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
+		return nil
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var participation, score uint64
+	for i, val := range validators {
+		if !sp.isEligibleForRewards(val) {
+			continue
+		}
+
+		idx := math.ValidatorIndex(i)
+		if participation, err = st.GetEpochParticipationAtIndex(
+			idx,
+		); err != nil {
+			return err
+		}
+
+		// Any participation during the epoch resets the score, whereas a
+		// fully missed epoch increases it.
+		score = 0
+		if participation == 0 {
+			if score, err = st.GetInactivityScoreAtIndex(idx); err != nil {
+				return err
+			}
+			score++
+		}
+
+		if err = st.SetInactivityScoreAtIndex(idx, score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getAttestationDeltas as defined in the Ethereum 2.0 specification, with
+// the signatures on the CometBFT commits standing in for attestations.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_attestation_deltas
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) getAttestationDeltas(
+	st BeaconStateT,
+) ([]math.Gwei, []math.Gwei, error) {
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards := make([]math.Gwei, len(validators))
+	penalties := make([]math.Gwei, len(validators))
+
+	// Compute the total balance of the validators taking part in consensus.
+	var totalBalance math.Gwei
+	for _, val := range validators {
+		if sp.isEligibleForRewards(val) {
+			totalBalance += val.GetEffectiveBalance()
+		}
+	}
+
+	if totalBalance == 0 {
+		return rewards, penalties, nil
+	}
+
+	var (
+		participation, score uint64
+		slotsPerEpoch        = sp.cs.SlotsPerEpoch()
+		sqrtTotalBalance     = totalBalance.ISqrt()
+	)
+	for i, val := range validators {
+		if !sp.isEligibleForRewards(val) {
+			continue
+		}
+
+		idx := math.ValidatorIndex(i)
+		if participation, err = st.GetEpochParticipationAtIndex(
+			idx,
+		); err != nil {
+			return nil, nil, err
+		}
+		participation = min(participation, slotsPerEpoch)
+
+		// Reward the validator for the commits it signed and penalize it
+		// for the ones it missed.
+		baseReward := val.GetEffectiveBalance() *
+			math.Gwei(sp.cs.BaseRewardFactor()) / sqrtTotalBalance
+		rewards[i] = baseReward *
+			math.Gwei(participation) / math.Gwei(slotsPerEpoch)
+		penalties[i] = baseReward *
+			math.Gwei(slotsPerEpoch-participation) / math.Gwei(slotsPerEpoch)
+
+		// Leak the balance of validators that have been inactive for too
+		// long.
+		if score, err = st.GetInactivityScoreAtIndex(idx); err != nil {
+			return nil, nil, err
+		} else if score > sp.cs.MinEpochsToInactivityPenalty() {
+			penalties[i] += val.GetEffectiveBalance() *
+				math.Gwei(score) / math.Gwei(sp.cs.InactivityPenaltyQuotient())
+		}
+	}
+
+	return rewards, penalties, nil
+}
+
+// processRewardsAndPenalties as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#process_rewards_and_penalties
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processRewardsAndPenalties(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
+		return nil
+	}
+
+	rewards, penalties, err := sp.getAttestationDeltas(st)
+	if err != nil {
+		return err
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	if len(validators) != len(rewards) {
+		return errors.Wrapf(
+			ErrRewardsLengthMismatch, "expected: %d, got: %d",
+			len(validators), len(rewards),
+		)
+	} else if len(validators) != len(penalties) {
+		return errors.Wrapf(
+			ErrPenaltiesLengthMismatch, "expected: %d, got: %d",
+			len(validators), len(penalties),
+		)
+	}
+
+	for i := range validators {
+		// Increase the balance of the validator.
+		if err = st.IncreaseBalance(
+			math.ValidatorIndex(i),
+			rewards[i],
+		); err != nil {
+			return err
+		}
+
+		// Decrease the balance of the validator.
+		if err = st.DecreaseBalance(
+			math.ValidatorIndex(i),
+			penalties[i],
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processParticipationUpdates resets the commit participation of every
+// validator for the upcoming epoch.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processParticipationUpdates(
+	st BeaconStateT,
+) error {
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	for i := range totalValidators {
+		if err = st.SetEpochParticipationAtIndex(
+			math.ValidatorIndex(i), 0,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isEligibleForRewards returns true if the validator takes part in
+// consensus and is thus subject to rewards and penalties.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) isEligibleForRewards(
+	val ValidatorT,
+) bool {
+	return !val.IsSlashed() && val.GetEffectiveBalance() > 0
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRewardsChainSpec returns a chain spec with the reward and
+// inactivity leak parameters set.
+//
+//nolint:mnd // test values.
+func newTestRewardsChainSpec() primitives.ChainSpec {
+	return newTestChainSpec(func(data *chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]) {
+		data.BaseRewardFactor = 64
+		data.MinEpochsToInactivityPenalty = 4
+		data.InactivityPenaltyQuotient = 1 << 24
+	})
+}
+
+func TestProcessCommitParticipation(t *testing.T) {
+	tests := []struct {
+		name          string
+		participants  [][]byte
+		participation []uint64
+	}{
+		{name: "no participants", participation: []uint64{0, 0}},
+		{
+			name:          "known participants",
+			participants:  [][]byte{{0xc0, 0}, {0xc0, 1}, {0xc0, 0}},
+			participation: []uint64{2, 1},
+		},
+		{
+			name:          "unknown participant is skipped",
+			participants:  [][]byte{{0xff}, {0xc0, 1}},
+			participation: []uint64{0, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sp = &testStateProcessor{
+					cs:     newTestRewardsChainSpec(),
+					logger: noop.NewLogger(),
+				}
+				st = newTestBeaconState(sp.cs, 0, 32e9, 32e9)
+			)
+			require.NoError(t, sp.processCommitParticipation(
+				st, tt.participants,
+			))
+			require.Equal(t, tt.participation, st.participation)
+		})
+	}
+}
+
+func TestProcessInactivityUpdates(t *testing.T) {
+	tests := []struct {
+		name          string
+		slot          math.Slot
+		participation uint64
+		slashed       bool
+		score         uint64
+	}{
+		{
+			name:          "participation resets the score",
+			slot:          32,
+			participation: 1,
+			score:         0,
+		},
+		{name: "missed epoch increases the score", slot: 32, score: 4},
+		{
+			name:    "slashed validator is not updated",
+			slot:    32,
+			slashed: true,
+			score:   3,
+		},
+		{name: "genesis epoch is skipped", slot: 31, score: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sp = &testStateProcessor{cs: newTestRewardsChainSpec()}
+				st = newTestBeaconState(sp.cs, tt.slot, 32e9)
+			)
+			st.validators[0].Slashed = tt.slashed
+			st.participation[0] = tt.participation
+			st.inactivityScores[0] = 3
+
+			require.NoError(t, sp.processInactivityUpdates(st))
+			require.Equal(t, tt.score, st.inactivityScores[0])
+		})
+	}
+}
+
+func TestGetAttestationDeltas(t *testing.T) {
+	// The base reward of a validator with the maximum effective balance,
+	// out of two such validators, with a base reward factor of 64.
+	const baseReward = math.Gwei(8095437)
+
+	tests := []struct {
+		name          string
+		participation uint64
+		score         uint64
+		slashed       bool
+		reward        math.Gwei
+		penalty       math.Gwei
+	}{
+		{
+			name:          "full participation",
+			participation: 32,
+			reward:        baseReward,
+		},
+		{
+			name:          "participation is capped at slots per epoch",
+			participation: 40,
+			reward:        baseReward,
+		},
+		{
+			name:          "partial participation",
+			participation: 16,
+			reward:        baseReward * 16 / 32,
+			penalty:       baseReward * 16 / 32,
+		},
+		{name: "no participation", penalty: baseReward},
+		{
+			name:    "inactivity score at the threshold does not leak",
+			score:   4,
+			penalty: baseReward,
+		},
+		{
+			name:    "inactivity leak",
+			score:   5,
+			penalty: baseReward + math.Gwei(32e9)*5/(1<<24),
+		},
+		{name: "slashed validator", slashed: true, score: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sp = &testStateProcessor{cs: newTestRewardsChainSpec()}
+				st = newTestBeaconState(sp.cs, 32, 32e9, 32e9)
+			)
+			st.validators[0].Slashed = tt.slashed
+			st.participation[0] = tt.participation
+			st.inactivityScores[0] = tt.score
+
+			rewards, penalties, err := sp.getAttestationDeltas(st)
+			require.NoError(t, err)
+			require.Equal(t, tt.reward, rewards[0])
+			require.Equal(t, tt.penalty, penalties[0])
+		})
+	}
+}
@@ -21,7 +21,11 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // processSlashingsReset as defined in the Ethereum 2.0 specification.
@@ -29,10 +33,11 @@ import (
 //
 //nolint:lll
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processSlashingsReset(
 	st BeaconStateT,
 ) error {
@@ -46,50 +51,293 @@ func (sp *StateProcessor[
 	return st.UpdateSlashingAtIndex(index, 0)
 }
 
+// processProposerSlashings processes the proposer slashings of the block.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processProposerSlashings(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	slashings := blk.GetBody().GetProposerSlashings()
+	if uint64(len(slashings)) > sp.cs.MaxProposerSlashingsPerBlock() {
+		return errors.Wrapf(ErrExceedsBlockProposerSlashingLimit,
+			"expected: %d, got: %d",
+			sp.cs.MaxProposerSlashingsPerBlock(), len(slashings),
+		)
+	}
+
+	for _, ps := range slashings {
+		if err := sp.processProposerSlashing(
+			st, ps, blk.GetProposerIndex(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processProposerSlashing as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processProposerSlashing(
-	_ BeaconStateT,
-	// ps ProposerSlashing,
+	st BeaconStateT,
+	ps ProposerSlashingT,
+	proposerIndex math.ValidatorIndex,
+) error {
+	if !ps.IsSlashable() {
+		return errors.Wrapf(
+			ErrProposerSlashingNotSlashable, "slot: %d, proposer: %d",
+			ps.GetSlot(), ps.GetProposerIndex(),
+		)
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	val, err := st.ValidatorByIndex(ps.GetProposerIndex())
+	if err != nil {
+		return err
+	} else if !val.IsSlashable(sp.cs.SlotToEpoch(slot)) {
+		return errors.Wrapf(
+			ErrValidatorNotSlashable, "index: %d", ps.GetProposerIndex(),
+		)
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	// Verify the proposer signed both of the headers.
+	var fd ForkDataT
+	if err = ps.VerifySignatures(
+		fd.New(
+			version.FromUint32[primitives.Version](
+				sp.cs.ActiveForkVersionForEpoch(
+					sp.cs.SlotToEpoch(ps.GetSlot()),
+				),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeProposer(),
+		val.GetPubkey(),
+		sp.signer.VerifySignature,
+	); err != nil {
+		return err
+	}
+
+	// The proposer of the block is the whistleblower.
+	return sp.slashValidator(
+		st, ps.GetProposerIndex(), proposerIndex, proposerIndex,
+	)
+}
+
+// processAttesterSlashings processes the attester slashings of the block.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processAttesterSlashings(
+	st BeaconStateT,
+	blk BeaconBlockT,
 ) error {
+	slashings := blk.GetBody().GetAttesterSlashings()
+	if uint64(len(slashings)) > sp.cs.MaxAttesterSlashingsPerBlock() {
+		return errors.Wrapf(ErrExceedsBlockAttesterSlashingLimit,
+			"expected: %d, got: %d",
+			sp.cs.MaxAttesterSlashingsPerBlock(), len(slashings),
+		)
+	}
+
+	for _, as := range slashings {
+		if err := sp.processAttesterSlashing(
+			st, as, blk.GetProposerIndex(),
+		); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // processAttesterSlashing as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#attester-slashings
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processAttesterSlashing(
-	_ BeaconStateT,
-	// as AttesterSlashing,
+	st BeaconStateT,
+	as AttesterSlashingT,
+	proposerIndex math.ValidatorIndex,
 ) error {
+	if !as.IsSlashable() {
+		return ErrAttesterSlashingNotSlashable
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	// Verify both of the attestations are valid indexed attestations.
+	var fd ForkDataT
+	if err = as.VerifyAttestations(
+		sp.cs.DomainTypeAttester(),
+		func(epoch math.Epoch) ForkDataT {
+			return fd.New(
+				version.FromUint32[primitives.Version](
+					sp.cs.ActiveForkVersionForEpoch(epoch),
+				), genesisValidatorsRoot,
+			)
+		},
+		func(index math.ValidatorIndex) (crypto.BLSPubkey, error) {
+			val, valErr := st.ValidatorByIndex(index)
+			if valErr != nil {
+				return crypto.BLSPubkey{}, valErr
+			}
+			return val.GetPubkey(), nil
+		},
+		sp.signer.VerifyAggregateSignature,
+	); err != nil {
+		return err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	// Slash every slashable validator that signed both of the attestations.
+	var slashedAny bool
+	for _, index := range as.GetSlashableIndices() {
+		var val ValidatorT
+		if val, err = st.ValidatorByIndex(index); err != nil {
+			return err
+		} else if !val.IsSlashable(epoch) {
+			continue
+		}
+
+		// The proposer of the block is the whistleblower.
+		if err = sp.slashValidator(
+			st, index, proposerIndex, proposerIndex,
+		); err != nil {
+			return err
+		}
+		slashedAny = true
+	}
+
+	if !slashedAny {
+		return ErrNoSlashableIndices
+	}
 	return nil
 }
 
+// slashValidator as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) slashValidator(
+	st BeaconStateT,
+	slashedIndex math.ValidatorIndex,
+	proposerIndex math.ValidatorIndex,
+	whistleblowerIndex math.ValidatorIndex,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	if err = sp.initiateValidatorExit(st, slashedIndex); err != nil {
+		return err
+	}
+
+	val, err := st.ValidatorByIndex(slashedIndex)
+	if err != nil {
+		return err
+	}
+
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(slashedIndex, val); err != nil {
+		return err
+	}
+
+	// Record the slashed balance for the proportional slashing penalty.
+	effectiveBalance := val.GetEffectiveBalance()
+	index := uint64(epoch) % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	} else if err = st.UpdateSlashingAtIndex(
+		index, slashing+effectiveBalance,
+	); err != nil {
+		return err
+	}
+
+	// Apply the initial slashing penalty.
+	if err = st.DecreaseBalance(
+		slashedIndex,
+		effectiveBalance/math.Gwei(sp.cs.MinSlashingPenaltyQuotient()),
+	); err != nil {
+		return err
+	}
+
+	// Reward the proposer and the whistleblower.
+	whistleblowerReward := effectiveBalance /
+		math.Gwei(sp.cs.WhistleblowerRewardQuotient())
+	proposerReward := whistleblowerReward /
+		math.Gwei(sp.cs.ProposerRewardQuotient())
+	if err = st.IncreaseBalance(proposerIndex, proposerReward); err != nil {
+		return err
+	}
+	return st.IncreaseBalance(
+		whistleblowerIndex, whistleblowerReward-proposerReward,
+	)
+}
+
 // processSlashings as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings
 //
-// processSlashings processes the slashings and ensures they match the local
-// state.
+// processSlashings applies the correlated slashing penalty to the validators
+// that were slashed EpochsPerSlashingsVector / 2 epochs ago, proportional to
+// the total balance slashed within the slashings vector.
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processSlashings(
 	st BeaconStateT,
 ) error {
@@ -98,6 +346,12 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	// The total active balance is floored at the effective balance increment
+	// to avoid dividing by zero, as in `get_total_balance`.
+	totalBalance = max(
+		totalBalance, math.Gwei(sp.cs.EffectiveBalanceIncrement()),
+	)
+
 	totalSlashings, err := st.GetTotalSlashing()
 	if err != nil {
 		return err
@@ -120,7 +374,8 @@ func (sp *StateProcessor[
 	}
 
 	//nolint:mnd // this is in the spec
-	slashableEpoch := (uint64(sp.cs.SlotToEpoch(slot)) + sp.cs.EpochsPerSlashingsVector()) / 2
+	slashableEpoch := uint64(sp.cs.SlotToEpoch(slot)) +
+		sp.cs.EpochsPerSlashingsVector()/2
 
 	// Iterate through the validators and slash if needed.
 	for _, val := range vals {
@@ -138,14 +393,13 @@ func (sp *StateProcessor[
 	return nil
 }
 
-// processSlash handles the logic for slashing a validator.
-//
-//nolint:unused // will be used later
+// processSlash applies the correlated slashing penalty to a validator.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processSlash(
 	st BeaconStateT,
 	val ValidatorT,
@@ -0,0 +1,443 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSlashings(t *testing.T) {
+	const (
+		numValidators = 10
+		// slot is the first slot of epoch 4, so validators slashed at
+		// epoch 4 - EpochsPerSlashingsVector / 2 are penalized.
+		slot           = math.Slot(4 * 32)
+		slashableEpoch = math.Epoch(8)
+	)
+
+	tests := []struct {
+		name       string
+		multiplier uint64
+		// slashed maps validator indices to their withdrawable epoch.
+		slashed map[math.ValidatorIndex]math.Epoch
+		// unslashed lists validators that are not slashed, but whose
+		// withdrawable epoch falls in the slashable window.
+		unslashed []math.ValidatorIndex
+		slashings map[uint64]math.Gwei
+		penalties map[math.ValidatorIndex]math.Gwei
+	}{
+		{
+			name:       "single validator slashed",
+			multiplier: 1,
+			slashed: map[math.ValidatorIndex]math.Epoch{
+				1: slashableEpoch,
+			},
+			slashings: map[uint64]math.Gwei{0: 32e9},
+			// 32 * 32 / 320 = 3.2, rounded down to the increment.
+			penalties: map[math.ValidatorIndex]math.Gwei{1: 3e9},
+		},
+		{
+			name:       "multiple validators slashed in the same window",
+			multiplier: 1,
+			slashed: map[math.ValidatorIndex]math.Epoch{
+				1: slashableEpoch,
+				2: slashableEpoch,
+				3: slashableEpoch,
+			},
+			slashings: map[uint64]math.Gwei{0: 32e9, 7: 64e9},
+			// 32 * 96 / 320 = 9.6, rounded down to the increment.
+			penalties: map[math.ValidatorIndex]math.Gwei{
+				1: 9e9, 2: 9e9, 3: 9e9,
+			},
+		},
+		{
+			name:       "validators outside of the window are not penalized",
+			multiplier: 1,
+			slashed: map[math.ValidatorIndex]math.Epoch{
+				1: slashableEpoch,
+				2: slashableEpoch + 1,
+				3: slashableEpoch - 1,
+			},
+			unslashed: []math.ValidatorIndex{4},
+			slashings: map[uint64]math.Gwei{0: 32e9, 1: 32e9},
+			// 32 * 64 / 320 = 6.4, rounded down to the increment.
+			penalties: map[math.ValidatorIndex]math.Gwei{1: 6e9},
+		},
+		{
+			name:       "proportional slashing multiplier",
+			multiplier: 3,
+			slashed: map[math.ValidatorIndex]math.Epoch{
+				1: slashableEpoch,
+				2: slashableEpoch,
+			},
+			slashings: map[uint64]math.Gwei{0: 64e9},
+			// 32 * 192 / 320 = 19.2, rounded down to the increment.
+			penalties: map[math.ValidatorIndex]math.Gwei{
+				1: 19e9, 2: 19e9,
+			},
+		},
+		{
+			name:       "penalty is capped by the total active balance",
+			multiplier: 3,
+			slashed: map[math.ValidatorIndex]math.Epoch{
+				1: slashableEpoch,
+				2: slashableEpoch,
+				3: slashableEpoch,
+				4: slashableEpoch,
+			},
+			slashings: map[uint64]math.Gwei{0: 128e9},
+			penalties: map[math.ValidatorIndex]math.Gwei{
+				1: 32e9, 2: 32e9, 3: 32e9, 4: 32e9,
+			},
+		},
+		{
+			name:       "no slashings",
+			multiplier: 1,
+			slashed: map[math.ValidatorIndex]math.Epoch{
+				1: slashableEpoch,
+			},
+			penalties: map[math.ValidatorIndex]math.Gwei{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := newTestChainSpec(func(data *chain.SpecData[
+				common.DomainType, math.Epoch,
+				common.ExecutionAddress, math.Slot, any,
+			]) {
+				data.ProportionalSlashingMultiplier = tt.multiplier
+			})
+			balances := make([]math.Gwei, numValidators)
+			for i := range balances {
+				balances[i] = math.Gwei(cs.MaxEffectiveBalance())
+			}
+			st := newTestBeaconState(cs, slot, balances...)
+			for idx, withdrawableEpoch := range tt.slashed {
+				st.validators[idx].Slashed = true
+				st.validators[idx].WithdrawableEpoch = withdrawableEpoch
+			}
+			for _, idx := range tt.unslashed {
+				st.validators[idx].WithdrawableEpoch = slashableEpoch
+			}
+			for index, amount := range tt.slashings {
+				st.slashings[index] = amount
+			}
+
+			sp := &testStateProcessor{cs: cs}
+			require.NoError(t, sp.processSlashings(st))
+
+			for i, balance := range st.balances {
+				expected := math.Gwei(cs.MaxEffectiveBalance()) -
+					tt.penalties[math.ValidatorIndex(i)]
+				require.Equal(t, expected, balance, "validator %d", i)
+			}
+		})
+	}
+}
+
+func TestSlashValidator_CorrelatedPenalty(t *testing.T) {
+	var (
+		cs       = newTestChainSpec()
+		maxEB    = math.Gwei(cs.MaxEffectiveBalance())
+		proposer = math.ValidatorIndex(0)
+		slashed  = []math.ValidatorIndex{1, 2}
+		balances = make([]math.Gwei, 10)
+	)
+	for i := range balances {
+		balances[i] = maxEB
+	}
+
+	// Slash two validators in the same block at epoch 4.
+	st := newTestBeaconState(cs, math.Slot(4*32), balances...)
+	sp := &testStateProcessor{cs: cs}
+	for _, idx := range slashed {
+		require.NoError(t, sp.slashValidator(st, idx, proposer, proposer))
+	}
+
+	// The slashed validators are marked as such and pay the initial penalty.
+	initialPenalty := maxEB / math.Gwei(cs.MinSlashingPenaltyQuotient())
+	for _, idx := range slashed {
+		require.True(t, st.validators[idx].IsSlashed())
+		require.Equal(t, math.Epoch(6), st.validators[idx].ExitEpoch)
+		require.Equal(t, math.Epoch(12), st.validators[idx].WithdrawableEpoch)
+		require.Equal(t, maxEB-initialPenalty, st.balances[idx])
+	}
+
+	// The proposer is also the whistleblower, so it receives the full reward.
+	whistleblowerReward := maxEB / math.Gwei(cs.WhistleblowerRewardQuotient())
+	require.Equal(t,
+		maxEB+math.Gwei(len(slashed))*whistleblowerReward,
+		st.balances[proposer],
+	)
+
+	// The slashed balance is recorded in the slashings vector.
+	totalSlashing, err := st.GetTotalSlashing()
+	require.NoError(t, err)
+	require.Equal(t, 2*maxEB, totalSlashing)
+	require.Equal(t, 2*maxEB, st.slashings[4])
+
+	// Nothing happens before the slashable window is reached.
+	st.slot = math.Slot(7 * 32)
+	require.NoError(t, sp.processSlashings(st))
+	for _, idx := range slashed {
+		require.Equal(t, maxEB-initialPenalty, st.balances[idx])
+	}
+
+	// Halfway through the slashings vector the correlated penalty applies.
+	// The slashed validators have exited by then, leaving 8 active ones:
+	// 32 * 64 / 256 = 8.
+	st.slot = math.Slot(8 * 32)
+	require.NoError(t, sp.processSlashings(st))
+	for _, idx := range slashed {
+		require.Equal(t, maxEB-initialPenalty-8e9, st.balances[idx])
+	}
+
+	// Slashing an already slashed validator is not possible.
+	require.False(t, st.validators[slashed[0]].IsSlashable(8))
+}
+
+// newTestSlashingBlock creates a block proposed by validator 0 carrying the
+// given slashings.
+func newTestSlashingBlock(
+	proposerSlashings []*types.ProposerSlashing,
+	attesterSlashings []*types.AttesterSlashing,
+) *types.BeaconBlock {
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockDeneb{
+			Body: &types.BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					ProposerSlashings: proposerSlashings,
+					AttesterSlashings: attesterSlashings,
+				},
+			},
+		},
+	}
+}
+
+func TestProcessProposerSlashings(t *testing.T) {
+	errInvalidSignature := errors.New("invalid signature")
+	newHeader := func(
+		slot, proposer uint64, bodyRoot common.Root,
+	) *types.SignedBeaconBlockHeader {
+		return &types.SignedBeaconBlockHeader{
+			Header: &types.BeaconBlockHeader{
+				BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+					Slot:          slot,
+					ProposerIndex: proposer,
+				},
+				BodyRoot: bodyRoot,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		slashing    *types.ProposerSlashing
+		slashed     bool
+		sigErr      error
+		expectedErr error
+	}{
+		{
+			name: "valid proposer slashing",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: newHeader(3, 1, common.Root{0x01}),
+				SignedHeader2: newHeader(3, 1, common.Root{0x02}),
+			},
+		},
+		{
+			name: "identical headers",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: newHeader(3, 1, common.Root{0x01}),
+				SignedHeader2: newHeader(3, 1, common.Root{0x01}),
+			},
+			expectedErr: ErrProposerSlashingNotSlashable,
+		},
+		{
+			name: "different slots",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: newHeader(3, 1, common.Root{0x01}),
+				SignedHeader2: newHeader(4, 1, common.Root{0x02}),
+			},
+			expectedErr: ErrProposerSlashingNotSlashable,
+		},
+		{
+			name: "different proposers",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: newHeader(3, 1, common.Root{0x01}),
+				SignedHeader2: newHeader(3, 2, common.Root{0x02}),
+			},
+			expectedErr: ErrProposerSlashingNotSlashable,
+		},
+		{
+			name: "validator is not slashable",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: newHeader(3, 1, common.Root{0x01}),
+				SignedHeader2: newHeader(3, 1, common.Root{0x02}),
+			},
+			slashed:     true,
+			expectedErr: ErrValidatorNotSlashable,
+		},
+		{
+			name: "invalid signature",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: newHeader(3, 1, common.Root{0x01}),
+				SignedHeader2: newHeader(3, 1, common.Root{0x02}),
+			},
+			sigErr:      errInvalidSignature,
+			expectedErr: errInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				cs     = newTestChainSpec()
+				maxEB  = math.Gwei(cs.MaxEffectiveBalance())
+				signer = &mocks.BLSSigner{}
+				sp     = &testStateProcessor{cs: cs, signer: signer}
+				st     = newTestBeaconState(cs, 3, maxEB, maxEB, maxEB)
+			)
+			st.validators[1].Slashed = tt.slashed
+			signer.On(
+				"VerifySignature", mock.Anything, mock.Anything, mock.Anything,
+			).Return(tt.sigErr).Maybe()
+
+			err := sp.processProposerSlashings(
+				st, newTestSlashingBlock(
+					[]*types.ProposerSlashing{tt.slashing}, nil,
+				),
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Equal(t, maxEB, st.balances[1])
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, st.validators[1].Slashed)
+			require.Less(t, st.balances[1], maxEB)
+			require.Greater(t, st.balances[0], maxEB)
+		})
+	}
+}
+
+func TestProcessAttesterSlashings(t *testing.T) {
+	errInvalidSignature := errors.New("invalid signature")
+	newAttestation := func(
+		root common.Root, indices ...uint64,
+	) *types.IndexedAttestation {
+		return &types.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &types.AttestationData{
+				Slot:            3,
+				BeaconBlockRoot: root,
+				Source:          &types.Checkpoint{},
+				Target:          &types.Checkpoint{},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		slashing    *types.AttesterSlashing
+		slashed     bool
+		sigErr      error
+		expectedErr error
+	}{
+		{
+			name: "valid double vote",
+			slashing: &types.AttesterSlashing{
+				Attestation1: newAttestation(common.Root{0x01}, 1, 2),
+				Attestation2: newAttestation(common.Root{0x02}, 2, 3),
+			},
+		},
+		{
+			name: "attestations are not slashable",
+			slashing: &types.AttesterSlashing{
+				Attestation1: newAttestation(common.Root{0x01}, 1, 2),
+				Attestation2: newAttestation(common.Root{0x01}, 2, 3),
+			},
+			expectedErr: ErrAttesterSlashingNotSlashable,
+		},
+		{
+			name: "validator is not slashable",
+			slashing: &types.AttesterSlashing{
+				Attestation1: newAttestation(common.Root{0x01}, 1, 2),
+				Attestation2: newAttestation(common.Root{0x02}, 2, 3),
+			},
+			slashed:     true,
+			expectedErr: ErrNoSlashableIndices,
+		},
+		{
+			name: "invalid signature",
+			slashing: &types.AttesterSlashing{
+				Attestation1: newAttestation(common.Root{0x01}, 1, 2),
+				Attestation2: newAttestation(common.Root{0x02}, 2, 3),
+			},
+			sigErr:      errInvalidSignature,
+			expectedErr: errInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				cs     = newTestChainSpec()
+				maxEB  = math.Gwei(cs.MaxEffectiveBalance())
+				signer = &mocks.BLSSigner{}
+				sp     = &testStateProcessor{cs: cs, signer: signer}
+				st     = newTestBeaconState(cs, 3, maxEB, maxEB, maxEB, maxEB)
+			)
+			st.validators[2].Slashed = tt.slashed
+			signer.On(
+				"VerifyAggregateSignature",
+				mock.Anything, mock.Anything, mock.Anything,
+			).Return(tt.sigErr).Maybe()
+
+			err := sp.processAttesterSlashings(
+				st, newTestSlashingBlock(
+					nil, []*types.AttesterSlashing{tt.slashing},
+				),
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Equal(t, maxEB, st.balances[2])
+				return
+			}
+			require.NoError(t, err)
+
+			// Only the validator that signed both attestations is slashed.
+			require.False(t, st.validators[1].Slashed)
+			require.True(t, st.validators[2].Slashed)
+			require.False(t, st.validators[3].Slashed)
+			require.Less(t, st.balances[2], maxEB)
+		})
+	}
+}
@@ -23,18 +23,52 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
 
+// processEth1Data applies the eth1 data of the block to the beacon state.
+// As blocks are final as soon as they are committed, the eth1 data proposed
+// in a block is applied directly instead of being voted on over a period.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processEth1Data(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	eth1Data := blk.GetBody().GetEth1Data()
+	current, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+
+	// The deposit contract is append-only, hence the deposit count can
+	// never go backwards.
+	if eth1Data.GetDepositCount() < current.GetDepositCount() {
+		return errors.Wrapf(
+			ErrEth1DataDepositCountDecreased,
+			"current: %d, got: %d",
+			current.GetDepositCount(), eth1Data.GetDepositCount(),
+		)
+	}
+
+	return st.SetEth1Data(eth1Data)
+}
+
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processOperations(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -50,25 +84,53 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
-	depositCount := min(
-		sp.cs.MaxDepositsPerBlock(),
-		uint64(eth1Data.GetDepositCount())-index,
-	)
-	_ = depositCount
-	// TODO: Update eth1data count and check this.
-	// if uint64(len(deposits)) != depositCount {
-	// 	return errors.New("deposit count mismatch")
-	// }
+	limit, err := sp.eth1DepositIndexLimit(st, eth1Data)
+	if err != nil {
+		return err
+	}
+	var depositCount uint64
+	if index < limit {
+		depositCount = min(sp.cs.MaxDepositsPerBlock(), limit-index)
+	}
+	if uint64(len(deposits)) != depositCount {
+		return errors.Wrapf(
+			ErrDepositCountMismatch,
+			"expected: %d, got: %d", depositCount, len(deposits),
+		)
+	}
 	return sp.processDeposits(st, deposits)
 }
 
+// eth1DepositIndexLimit returns the index up to which deposits are
+// processed from the deposit contract logs. Once deposit requests are
+// processed from the execution payload, the logs are only used to catch up
+// with the deposits made before the first deposit request.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) eth1DepositIndexLimit(
+	st BeaconStateT,
+	eth1Data Eth1DataT,
+) (uint64, error) {
+	limit := uint64(eth1Data.GetDepositCount())
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return 0, err
+	}
+	return min(limit, startIndex), nil
+}
+
 // ProcessDeposits processes the deposits and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processDeposits(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -84,30 +146,39 @@ func (sp *StateProcessor[
 
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processDeposit(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
-	// TODO: fill this in properly
-	// if !sp.isValidMerkleBranch(
-	// 	leaf,
-	// 	dep.Credentials,
-	// 	32 + 1,
-	// 	dep.Index,
-	// 	st.root,
-	// ) {
-	// 	return errors.New("invalid merkle branch")
-	// }
-
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
 		return err
 	}
 
+	// Deposits must be processed in the order they were made to the
+	// deposit contract.
+	if dep.GetIndex() != depositIndex {
+		return errors.Wrapf(
+			ErrDepositIndexMismatch,
+			"expected: %d, got: %d", depositIndex, dep.GetIndex(),
+		)
+	}
+
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+
+	// Verify the Merkle branch of the deposit against the deposit root.
+	if err = dep.VerifyMerkleProof(eth1Data.GetDepositRoot()); err != nil {
+		return err
+	}
+
 	if err = st.SetEth1DepositIndex(
 		depositIndex + 1,
 	); err != nil {
@@ -119,27 +190,20 @@ func (sp *StateProcessor[
 
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) applyDeposit(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
-	// If the validator already exists, we update the balance.
+	// If the validator already exists, we update the balance. The effective
+	// balance is only updated once per epoch in processEffectiveBalanceUpdates.
 	if err == nil {
-		var val ValidatorT
-		val, err = st.ValidatorByIndex(idx)
-		if err != nil {
-			return err
-		}
-
-		// TODO: Modify balance here and then effective balance once per epoch.
-		val.SetEffectiveBalance(min(val.GetEffectiveBalance()+dep.GetAmount(),
-			math.Gwei(sp.cs.MaxEffectiveBalance())))
-		return st.UpdateValidatorAtIndex(idx, val)
+		return st.IncreaseBalance(idx, dep.GetAmount())
 	}
 
 	// If the validator does not exist, we add the validator.
@@ -149,13 +213,34 @@ func (sp *StateProcessor[
 
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
+) error {
+	if err := sp.verifyDepositSignature(st, dep); err != nil {
+		return err
+	}
+
+	// Add the validator to the registry.
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// verifyDepositSignature verifies the signature of the deposit message
+// against the fork version of the current epoch.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) verifyDepositSignature(
+	st BeaconStateT,
+	dep DepositT,
 ) error {
 	var (
 		genesisValidatorsRoot primitives.Root
@@ -179,7 +264,7 @@ func (sp *StateProcessor[
 
 	// Verify that the message was signed correctly.
 	var d ForkDataT
-	if err = dep.VerifySignature(
+	return dep.VerifySignature(
 		d.New(
 			version.FromUint32[primitives.Version](
 				sp.cs.ActiveForkVersionForEpoch(epoch),
@@ -187,20 +272,16 @@ func (sp *StateProcessor[
 		),
 		sp.cs.DomainTypeDeposit(),
 		sp.signer.VerifySignature,
-	); err != nil {
-		return err
-	}
-
-	// Add the validator to the registry.
-	return sp.addValidatorToRegistry(st, dep)
+	)
 }
 
 // addValidatorToRegistry adds a validator to the registry.
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) addValidatorToRegistry(
 	st BeaconStateT,
 	dep DepositT,
@@ -226,15 +307,69 @@ func (sp *StateProcessor[
 	return st.IncreaseBalance(idx, dep.GetAmount())
 }
 
+// processEffectiveBalanceUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processEffectiveBalanceUpdates(
+	st BeaconStateT,
+) error {
+	var (
+		balance                   math.Gwei
+		effectiveBalanceIncrement = sp.cs.EffectiveBalanceIncrement()
+		hysteresisIncrement       = effectiveBalanceIncrement /
+			sp.cs.HysteresisQuotient()
+		downwardThreshold = math.Gwei(
+			hysteresisIncrement * sp.cs.HysteresisDownwardMultiplier(),
+		)
+		upwardThreshold = math.Gwei(
+			hysteresisIncrement * sp.cs.HysteresisUpwardMultiplier(),
+		)
+	)
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	// Update the effective balance of every validator whose balance has
+	// moved far enough away from its effective balance.
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if balance, err = st.GetBalance(idx); err != nil {
+			return err
+		}
+
+		if balance+downwardThreshold < val.GetEffectiveBalance() ||
+			val.GetEffectiveBalance()+upwardThreshold < balance {
+			val.SetEffectiveBalance(min(
+				balance-balance%math.Gwei(effectiveBalanceIncrement),
+				math.Gwei(sp.cs.MaxEffectiveBalance()),
+			))
+			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // processWithdrawals as per the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-process_withdrawals
 //
 //nolint:lll
 func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) processWithdrawals(
 	st BeaconStateT,
 	body BeaconBlockBodyT,
@@ -292,15 +427,16 @@ func (sp *StateProcessor[
 	totalValidators, err := st.GetTotalValidators()
 	if err != nil {
 		return err
+	} else if totalValidators == 0 {
+		return nil
 	}
 
 	// Update the next validator index to start the next withdrawal sweep
 	//#nosec:G701 // won't overflow in practice.
 	if numWithdrawals == int(sp.cs.MaxWithdrawalsPerPayload()) {
 		// Next sweep starts after the latest withdrawal's validator index
-		nextValidatorIndex =
-			(expectedWithdrawals[len(expectedWithdrawals)-1].GetIndex() + 1) %
-				math.U64(totalValidators)
+		nextValidatorIndex = (expectedWithdrawals[numWithdrawals-1].
+			GetValidatorIndex() + 1) % math.ValidatorIndex(totalValidators)
 	} else {
 		// Advance sweep by the max length of the sweep if there was not
 		// a full set of withdrawals
@@ -315,3 +451,86 @@ func (sp *StateProcessor[
 
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// processBLSToExecutionChanges processes the BLS to execution changes of the
+// block.
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processBLSToExecutionChanges(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	changes := blk.GetBody().GetBLSToExecutionChanges()
+	if uint64(len(changes)) > sp.cs.MaxBLSToExecutionChangesPerBlock() {
+		return errors.Wrapf(ErrExceedsBlockBLSToExecutionChangeLimit,
+			"expected: %d, got: %d",
+			sp.cs.MaxBLSToExecutionChangesPerBlock(), len(changes),
+		)
+	}
+
+	for _, change := range changes {
+		if err := sp.processBLSToExecutionChange(st, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processBLSToExecutionChange as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-process_bls_to_execution_change
+//
+//nolint:lll
+func (sp *StateProcessor[
+	AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
+]) processBLSToExecutionChange(
+	st BeaconStateT,
+	change BLSToExecutionChangeT,
+) error {
+	idx := change.GetValidatorIndex()
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Verify the validator has BLS credentials committing to the key that
+	// signed the change.
+	if val.GetWithdrawalCredentials() !=
+		change.GetFromWithdrawalCredentials() {
+		return errors.Wrapf(
+			ErrWithdrawalCredentialsMismatch, "index: %d", idx,
+		)
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	// The change is signed over the genesis fork version so that it remains
+	// valid across forks.
+	var fd ForkDataT
+	if err = change.VerifySignature(
+		fd.New(
+			version.FromUint32[primitives.Version](
+				sp.cs.ActiveForkVersionForEpoch(
+					math.Epoch(constants.GenesisEpoch),
+				),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeBLSToExecutionChange(),
+		sp.signer.VerifySignature,
+	); err != nil {
+		return err
+	}
+
+	val.SetWithdrawalCredentials(change.GetToWithdrawalCredentials())
+	return st.UpdateValidatorAtIndex(idx, val)
+}
@@ -0,0 +1,489 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessEth1Data(t *testing.T) {
+	current := &types.Eth1Data{
+		DepositRoot:  common.Root{0x01},
+		DepositCount: 5,
+		BlockHash:    common.ExecutionHash{0x01},
+	}
+
+	tests := []struct {
+		name         string
+		depositCount uint64
+		expectedErr  error
+	}{
+		{name: "deposit count increases", depositCount: 7},
+		{name: "deposit count is unchanged", depositCount: 5},
+		{
+			name:         "deposit count decreases",
+			depositCount: 4,
+			expectedErr:  ErrEth1DataDepositCountDecreased,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sp = &testStateProcessor{cs: newTestChainSpec()}
+				st = newTestBeaconState(sp.cs, 0)
+			)
+			st.eth1Data = current
+
+			eth1Data := &types.Eth1Data{
+				DepositRoot:  common.Root{0x02},
+				DepositCount: tt.depositCount,
+				BlockHash:    common.ExecutionHash{0x02},
+			}
+			err := sp.processEth1Data(st, newTestBlock(eth1Data))
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Equal(t, current, st.eth1Data)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, eth1Data, st.eth1Data)
+		})
+	}
+}
+
+func TestProcessOperations_Deposits(t *testing.T) {
+	eth1Data := &types.Eth1Data{DepositCount: 5}
+
+	tests := []struct {
+		name        string
+		deposits    []*types.Deposit
+		expectedErr error
+	}{
+		{
+			name:        "missing deposits",
+			expectedErr: ErrDepositCountMismatch,
+		},
+		{
+			name: "out of order deposits",
+			deposits: []*types.Deposit{
+				{Amount: math.Gwei(32e9), Index: 4},
+				{Amount: math.Gwei(32e9), Index: 3},
+			},
+			expectedErr: ErrDepositIndexMismatch,
+		},
+		{
+			name: "deposits without valid proofs",
+			deposits: []*types.Deposit{
+				{Amount: math.Gwei(32e9), Index: 3},
+				{Amount: math.Gwei(32e9), Index: 4},
+			},
+			expectedErr: types.ErrInvalidDepositProof,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sp = &testStateProcessor{cs: newTestChainSpec()}
+				st = newTestBeaconState(sp.cs, 0)
+			)
+			st.eth1Data = eth1Data
+			st.eth1DepositIndex = 3
+
+			err := sp.processOperations(
+				st, newTestBlock(eth1Data, tt.deposits...),
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, uint64(3), st.eth1DepositIndex)
+		})
+	}
+}
+
+func TestProcessOperations_DepositRequestsStartIndex(t *testing.T) {
+	eth1Data := &types.Eth1Data{DepositCount: 5}
+
+	tests := []struct {
+		name        string
+		startIndex  uint64
+		expectedErr error
+	}{
+		{
+			name:       "logs caught up with the deposit requests",
+			startIndex: 3,
+		},
+		{
+			name:        "deposits before the first deposit request",
+			startIndex:  4,
+			expectedErr: ErrDepositCountMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sp = &testStateProcessor{cs: newTestChainSpec()}
+				st = newTestBeaconState(sp.cs, 0)
+			)
+			st.eth1Data = eth1Data
+			st.eth1DepositIndex = 3
+			st.depositRequestsStartIndex = tt.startIndex
+
+			err := sp.processOperations(st, newTestBlock(eth1Data))
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, uint64(3), st.eth1DepositIndex)
+		})
+	}
+}
+
+func TestApplyDeposit_TopUp(t *testing.T) {
+	var (
+		sp = &testStateProcessor{cs: newTestChainSpec()}
+		st = newTestBeaconState(sp.cs, 0, math.Gwei(32e9))
+	)
+	st.validators[0].EffectiveBalance = math.Gwei(20e9)
+	st.balances[0] = math.Gwei(20e9)
+
+	require.NoError(t, sp.applyDeposit(st, &types.Deposit{
+		Pubkey: st.validators[0].Pubkey,
+		Amount: math.Gwei(5e9),
+	}))
+
+	// Only the balance changes, the effective balance is left for the
+	// epoch transition.
+	require.Equal(t, math.Gwei(25e9), st.balances[0])
+	require.Equal(t, math.Gwei(20e9), st.validators[0].EffectiveBalance)
+}
+
+func TestProcessEffectiveBalanceUpdates(t *testing.T) {
+	tests := []struct {
+		name                     string
+		effectiveBalance         math.Gwei
+		balance                  math.Gwei
+		expectedEffectiveBalance math.Gwei
+	}{
+		{
+			name:                     "balance equals effective balance",
+			effectiveBalance:         math.Gwei(32e9),
+			balance:                  math.Gwei(32e9),
+			expectedEffectiveBalance: math.Gwei(32e9),
+		},
+		{
+			name:                     "decrease within downward threshold",
+			effectiveBalance:         math.Gwei(32e9),
+			balance:                  math.Gwei(31.76e9),
+			expectedEffectiveBalance: math.Gwei(32e9),
+		},
+		{
+			name:                     "decrease beyond downward threshold",
+			effectiveBalance:         math.Gwei(32e9),
+			balance:                  math.Gwei(31.7e9),
+			expectedEffectiveBalance: math.Gwei(31e9),
+		},
+		{
+			name:                     "increase within upward threshold",
+			effectiveBalance:         math.Gwei(20e9),
+			balance:                  math.Gwei(21.2e9),
+			expectedEffectiveBalance: math.Gwei(20e9),
+		},
+		{
+			name:                     "increase beyond upward threshold",
+			effectiveBalance:         math.Gwei(20e9),
+			balance:                  math.Gwei(21.3e9),
+			expectedEffectiveBalance: math.Gwei(21e9),
+		},
+		{
+			name:                     "increase capped at max effective balance",
+			effectiveBalance:         math.Gwei(30e9),
+			balance:                  math.Gwei(40e9),
+			expectedEffectiveBalance: math.Gwei(32e9),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sp = &testStateProcessor{cs: newTestChainSpec()}
+				st = newTestBeaconState(sp.cs, 0, tt.balance)
+			)
+			st.validators[0].EffectiveBalance = tt.effectiveBalance
+
+			require.NoError(t, sp.processEffectiveBalanceUpdates(st))
+			require.Equal(
+				t, tt.expectedEffectiveBalance,
+				st.validators[0].EffectiveBalance,
+			)
+			require.Equal(t, tt.balance, st.balances[0])
+		})
+	}
+}
+
+func TestProcessWithdrawals(t *testing.T) {
+	var (
+		cs    = newTestChainSpec()
+		maxEB = math.Gwei(cs.MaxEffectiveBalance())
+		sp    = &testStateProcessor{cs: cs}
+	)
+
+	withdrawal := func(
+		index uint64, validatorIndex math.ValidatorIndex, amount math.Gwei,
+	) *engineprimitives.Withdrawal {
+		return &engineprimitives.Withdrawal{
+			Index:     math.U64(index),
+			Validator: validatorIndex,
+			Address:   common.ExecutionAddress{byte(validatorIndex)},
+			Amount:    amount,
+		}
+	}
+
+	newBody := func(
+		withdrawals ...*engineprimitives.Withdrawal,
+	) *types.BeaconBlockBody {
+		return &types.BeaconBlockBody{
+			RawBeaconBlockBody: &types.BeaconBlockBodyDeneb{
+				ExecutionPayload: &types.ExecutableDataDeneb{
+					Withdrawals: withdrawals,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                       string
+		expected                   []*engineprimitives.Withdrawal
+		payload                    []*engineprimitives.Withdrawal
+		expectedErr                bool
+		expectedBalances           []math.Gwei
+		expectedWithdrawalIndex    uint64
+		expectedWithdrawalValIndex math.ValidatorIndex
+	}{
+		{
+			// With a full payload the next sweep starts after the
+			// validator of the last withdrawal.
+			name: "full payload",
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(7, 2, 1e9), withdrawal(8, 3, maxEB+1e9),
+			},
+			payload: []*engineprimitives.Withdrawal{
+				withdrawal(7, 2, 1e9), withdrawal(8, 3, maxEB+1e9),
+			},
+			expectedBalances: []math.Gwei{
+				maxEB, maxEB, maxEB, 0, maxEB,
+			},
+			expectedWithdrawalIndex:    9,
+			expectedWithdrawalValIndex: 4,
+		},
+		{
+			// Otherwise the sweep advances by the max sweep size.
+			name: "partial payload",
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(7, 3, 1e9),
+			},
+			payload: []*engineprimitives.Withdrawal{
+				withdrawal(7, 3, 1e9),
+			},
+			expectedBalances: []math.Gwei{
+				maxEB, maxEB, maxEB + 1e9, maxEB, maxEB,
+			},
+			expectedWithdrawalIndex:    8,
+			expectedWithdrawalValIndex: 0,
+		},
+		{
+			name: "no withdrawals",
+			expectedBalances: []math.Gwei{
+				maxEB, maxEB, maxEB + 1e9, maxEB + 1e9, maxEB,
+			},
+			expectedWithdrawalIndex:    7,
+			expectedWithdrawalValIndex: 0,
+		},
+		{
+			name: "payload withdrawal mismatch",
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(7, 2, 1e9),
+			},
+			payload: []*engineprimitives.Withdrawal{
+				withdrawal(7, 2, 2e9),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "payload withdrawal count mismatch",
+			expected: []*engineprimitives.Withdrawal{
+				withdrawal(7, 2, 1e9),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newTestBeaconState(
+				cs, 0, maxEB, maxEB, maxEB+1e9, maxEB+1e9, maxEB,
+			)
+			st.expectedWithdrawals = tt.expected
+			st.nextWithdrawalIndex = 7
+			st.nextWithdrawalValidatorIndex = 2
+
+			err := sp.processWithdrawals(st, newBody(tt.payload...))
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedBalances, st.balances)
+			require.Equal(
+				t, tt.expectedWithdrawalIndex, st.nextWithdrawalIndex,
+			)
+			require.Equal(
+				t, tt.expectedWithdrawalValIndex,
+				st.nextWithdrawalValidatorIndex,
+			)
+		})
+	}
+}
+
+func TestProcessBLSToExecutionChange(t *testing.T) {
+	var (
+		errInvalidSignature = errors.New("invalid signature")
+		blsPubkey           = crypto.BLSPubkey{0xaa}
+		address             = common.ExecutionAddress{0xbb}
+	)
+
+	tests := []struct {
+		name        string
+		malleate    func(*testBeaconState, *types.SignedBLSToExecutionChange)
+		sigErr      error
+		expectedErr error
+	}{
+		{
+			name: "valid change",
+			malleate: func(
+				*testBeaconState, *types.SignedBLSToExecutionChange,
+			) {
+			},
+		},
+		{
+			name: "validator has eth1 credentials",
+			malleate: func(
+				st *testBeaconState, _ *types.SignedBLSToExecutionChange,
+			) {
+				st.validators[1].WithdrawalCredentials = types.
+					NewCredentialsFromExecutionAddress(address)
+			},
+			expectedErr: ErrWithdrawalCredentialsMismatch,
+		},
+		{
+			name: "bls key does not match the credentials",
+			malleate: func(
+				_ *testBeaconState, c *types.SignedBLSToExecutionChange,
+			) {
+				c.Message.FromBLSPubkey = crypto.BLSPubkey{0xcc}
+			},
+			expectedErr: ErrWithdrawalCredentialsMismatch,
+		},
+		{
+			name: "unknown validator",
+			malleate: func(
+				_ *testBeaconState, c *types.SignedBLSToExecutionChange,
+			) {
+				c.Message.ValidatorIndex = 5
+			},
+			expectedErr: errValidatorNotFound,
+		},
+		{
+			name: "invalid signature",
+			malleate: func(
+				*testBeaconState, *types.SignedBLSToExecutionChange,
+			) {
+			},
+			sigErr:      errInvalidSignature,
+			expectedErr: errInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				cs     = newTestChainSpec()
+				maxEB  = math.Gwei(cs.MaxEffectiveBalance())
+				signer = &mocks.BLSSigner{}
+				sp     = &testStateProcessor{cs: cs, signer: signer}
+				st     = newTestBeaconState(cs, 0, maxEB, maxEB)
+				change = &types.SignedBLSToExecutionChange{
+					Message: &types.BLSToExecutionChange{
+						ValidatorIndex:     1,
+						FromBLSPubkey:      blsPubkey,
+						ToExecutionAddress: address,
+					},
+				}
+			)
+			st.validators[1].WithdrawalCredentials = types.
+				NewCredentialsFromBLSPubkey(blsPubkey)
+			signer.On(
+				"VerifySignature", blsPubkey, mock.Anything, mock.Anything,
+			).Return(tt.sigErr)
+			tt.malleate(st, change)
+			original := st.validators[1].WithdrawalCredentials
+
+			err := sp.processBLSToExecutionChange(st, change)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Equal(
+					t, original, st.validators[1].WithdrawalCredentials,
+				)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(
+				t, types.NewCredentialsFromExecutionAddress(address),
+				st.validators[1].WithdrawalCredentials,
+			)
+		})
+	}
+}
+
+func TestProcessBLSToExecutionChanges_ExceedsLimit(t *testing.T) {
+	var (
+		sp      = &testStateProcessor{cs: newTestChainSpec()}
+		st      = newTestBeaconState(sp.cs, 0)
+		changes = make(
+			[]*types.SignedBLSToExecutionChange,
+			sp.cs.MaxBLSToExecutionChangesPerBlock()+1,
+		)
+		blk = newTestBlock(&types.Eth1Data{})
+	)
+	blk.GetBody().SetBLSToExecutionChanges(changes)
+
+	require.ErrorIs(
+		t, sp.processBLSToExecutionChanges(st, blk),
+		ErrExceedsBlockBLSToExecutionChangeLimit,
+	)
+}
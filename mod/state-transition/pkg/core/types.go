@@ -49,17 +49,70 @@ type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 	Persist(math.Slot, BlobSidecarsT) error
 }
 
+// AttesterSlashing is the interface for an attester slashing.
+type AttesterSlashing[ForkDataT any] interface {
+	// IsSlashable returns true if the two attestations form either a double
+	// vote or a surround vote.
+	IsSlashable() bool
+	// GetSlashableIndices returns the sorted indices of the validators that
+	// signed both of the attestations.
+	GetSlashableIndices() []math.ValidatorIndex
+	// VerifyAttestations verifies that both of the attestations are valid
+	// indexed attestations.
+	VerifyAttestations(
+		domainType common.DomainType,
+		forkDataFn func(epoch math.Epoch) ForkDataT,
+		pubkeyFn func(index math.ValidatorIndex) (crypto.BLSPubkey, error),
+		aggregateVerificationFn func(
+			pubkeys []crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
+// BLSToExecutionChange is the interface for a signed BLS to execution change.
+type BLSToExecutionChange[
+	ForkDataT any,
+	WithdrawalCredentialsT ~[32]byte,
+] interface {
+	// GetValidatorIndex returns the index of the validator changing its
+	// credentials.
+	GetValidatorIndex() math.ValidatorIndex
+	// GetFromWithdrawalCredentials returns the BLS withdrawal credentials
+	// the validator must currently have.
+	GetFromWithdrawalCredentials() WithdrawalCredentialsT
+	// GetToWithdrawalCredentials returns the eth1 withdrawal credentials of
+	// the validator once the change is applied.
+	GetToWithdrawalCredentials() WithdrawalCredentialsT
+	// VerifySignature verifies the signature over the change.
+	VerifySignature(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
 // BeaconBlock represents a generic interface for a beacon block.
 type BeaconBlock[
+	AttesterSlashingT any,
+	BLSToExecutionChangeT any,
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		AttesterSlashingT, BLSToExecutionChangeT, BeaconBlockBodyT,
+		DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalsT,
 	],
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ProposerSlashingT any,
+	VoluntaryExitT any,
 	WithdrawalsT any,
 ] interface {
 	IsNil() bool
@@ -78,12 +131,17 @@ type BeaconBlock[
 // BeaconBlockBody represents a generic interface for the body of a beacon
 // block.
 type BeaconBlockBody[
+	AttesterSlashingT any,
+	BLSToExecutionChangeT any,
 	BeaconBlockBodyT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	ExecutionPayloadHeaderT interface{ GetBlockHash() common.ExecutionHash },
+	ProposerSlashingT any,
+	VoluntaryExitT any,
 	WithdrawalT any,
 ] interface {
 	// Empty returns an empty beacon block body.
@@ -92,8 +150,18 @@ type BeaconBlockBody[
 	GetRandaoReveal() crypto.BLSSignature
 	// GetExecutionPayload returns the execution payload.
 	GetExecutionPayload() ExecutionPayloadT
+	// GetProposerSlashings returns the list of proposer slashings.
+	GetProposerSlashings() []ProposerSlashingT
+	// GetAttesterSlashings returns the list of attester slashings.
+	GetAttesterSlashings() []AttesterSlashingT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetVoluntaryExits returns the list of voluntary exits.
+	GetVoluntaryExits() []VoluntaryExitT
+	// GetBLSToExecutionChanges returns the list of BLS to execution changes.
+	GetBLSToExecutionChanges() []BLSToExecutionChangeT
+	// GetEth1Data returns the eth1 data of the block body.
+	GetEth1Data() Eth1DataT
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() ([32]byte, error)
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -122,6 +190,9 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// GetParticipants returns the CometBFT addresses of the validators that
+	// signed the last commit.
+	GetParticipants() [][]byte
 
 	// Unwrap returns the underlying golang standard library context.
 	Unwrap() context.Context
@@ -129,9 +200,18 @@ type Context interface {
 
 // Deposit is the interface for a deposit.
 type Deposit[
+	DepositT any,
 	ForkDataT any,
 	WithdrawlCredentialsT ~[32]byte,
 ] interface {
+	// New creates a new deposit.
+	New(
+		pubkey crypto.BLSPubkey,
+		credentials WithdrawlCredentialsT,
+		amount math.Gwei,
+		signature crypto.BLSSignature,
+		index uint64,
+	) DepositT
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetIndex returns the index of the deposit.
@@ -151,6 +231,9 @@ type Deposit[
 			message []byte, signature crypto.BLSSignature,
 		) error,
 	) error
+	// VerifyMerkleProof verifies the deposit's Merkle proof against the
+	// given deposit root.
+	VerifyMerkleProof(depositRoot common.Root) error
 }
 
 type ExecutionPayload[
@@ -166,6 +249,8 @@ type ExecutionPayload[
 	GetBlockHash() common.ExecutionHash
 	GetPrevRandao() bytes.B32
 	GetWithdrawals() []WithdrawalT
+	GetDepositRequests() []*engineprimitives.DepositRequest
+	GetWithdrawalRequests() []*engineprimitives.WithdrawalRequest
 	GetFeeRecipient() common.ExecutionAddress
 	GetStateRoot() bytes.B32
 	GetReceiptsRoot() common.Root
@@ -220,6 +305,8 @@ type ExecutionEngine[
 type ForkData[ForkDataT any] interface {
 	// New creates a new fork data object.
 	New(primitives.Version, primitives.Root) ForkDataT
+	// ComputeForkDigest returns the fork digest of the fork data.
+	ComputeForkDigest() (common.ForkDigest, error)
 	// ComputeRandaoSigningRoot returns the signing root for the fork data.
 	ComputeRandaoSigningRoot(
 		domainType common.DomainType,
@@ -227,6 +314,26 @@ type ForkData[ForkDataT any] interface {
 	) (common.Root, error)
 }
 
+// ProposerSlashing is the interface for a proposer slashing.
+type ProposerSlashing[ForkDataT any] interface {
+	// GetSlot returns the slot of the conflicting headers.
+	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the offending proposer.
+	GetProposerIndex() math.ValidatorIndex
+	// IsSlashable returns true if the headers are conflicting.
+	IsSlashable() bool
+	// VerifySignatures verifies the signatures over both of the headers.
+	VerifySignatures(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
 // Validator represents an interface for a validator with generic type
 // ValidatorT.
 type Validator[
@@ -244,8 +351,27 @@ type Validator[
 	) ValidatorT
 	// IsSlashed returns true if the validator is slashed.
 	IsSlashed() bool
+	// IsSlashable returns true if the validator can be slashed at the given
+	// epoch.
+	IsSlashable(math.Epoch) bool
+	// SetSlashed sets the slashed status of the validator.
+	SetSlashed(bool)
+	// IsActive returns true if the validator is active at the given epoch.
+	IsActive(math.Epoch) bool
+	// IsEligibleForActivationQueue returns true if the validator can be
+	// placed in the activation queue.
+	IsEligibleForActivationQueue(maxEffectiveBalance math.Gwei) bool
+	// IsEligibleForActivation returns true if the validator can be activated
+	// given the finalized epoch.
+	IsEligibleForActivation(finalizedEpoch math.Epoch) bool
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// SetWithdrawalCredentials sets the withdrawal credentials of the
+	// validator.
+	SetWithdrawalCredentials(WithdrawalCredentialsT)
 	// GetEffectiveBalance returns the effective balance of the validator in
 	// Gwei.
 	GetEffectiveBalance() math.Gwei
@@ -253,6 +379,41 @@ type Validator[
 	SetEffectiveBalance(math.Gwei)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
+	// GetActivationEligibilityEpoch returns the epoch in which the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// SetActivationEligibilityEpoch sets the epoch in which the validator
+	// became eligible for activation.
+	SetActivationEligibilityEpoch(math.Epoch)
+	// GetActivationEpoch returns the epoch in which the validator activates.
+	GetActivationEpoch() math.Epoch
+	// SetActivationEpoch sets the epoch in which the validator activates.
+	SetActivationEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch in which the validator exits.
+	SetExitEpoch(math.Epoch)
+}
+
+// VoluntaryExit is the interface for a signed voluntary exit.
+type VoluntaryExit[ForkDataT any] interface {
+	// GetEpoch returns the earliest epoch at which the exit can be processed.
+	GetEpoch() math.Epoch
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// VerifySignature verifies the signature of the validator over the
+	// voluntary exit.
+	VerifySignature(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
 }
 
 // Withdrawal is the interface for a withdrawal.
@@ -268,3 +429,11 @@ type Withdrawal[WithdrawalT any] interface {
 	// GetAddress returns the address of the withdrawal.
 	GetAddress() common.ExecutionAddress
 }
+
+// WithdrawalCredentials represents an interface for withdrawal credentials.
+type WithdrawalCredentials interface {
+	~[32]byte
+	// ToExecutionAddress converts the withdrawal credentials to an execution
+	// address.
+	ToExecutionAddress() (common.ExecutionAddress, error)
+}
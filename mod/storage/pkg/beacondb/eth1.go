@@ -20,6 +20,12 @@
 
 package beacondb
 
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
 // GetLatestExecutionPayloadHeader retrieves the latest execution payload
 // header from the BeaconStore.
 func (kv *KVStore[
@@ -68,6 +74,28 @@ func (kv *KVStore[
 	return kv.eth1DepositIndex.Set(kv.ctx, index)
 }
 
+// GetDepositRequestsStartIndex retrieves the index of the first deposit
+// processed from an execution payload deposit request.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetDepositRequestsStartIndex() (uint64, error) {
+	index, err := kv.depositRequestsStartIndex.Get(kv.ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return constants.UnsetDepositRequestsStartIndex, nil
+	}
+	return index, err
+}
+
+// SetDepositRequestsStartIndex sets the index of the first deposit processed
+// from an execution payload deposit request.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) SetDepositRequestsStartIndex(
+	index uint64,
+) error {
+	return kv.depositRequestsStartIndex.Set(kv.ctx, index)
+}
+
 // GetEth1Data retrieves the eth1 data from the beacon state.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
@@ -44,6 +44,9 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	EpochParticipationPrefix
+	InactivityScoresPrefix
+	DepositRequestsStartIndexPrefix
 )
 
 //nolint:lll
@@ -71,4 +74,7 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	EpochParticipationPrefixHumanReadable               = "EpochParticipationPrefix"
+	InactivityScoresPrefixHumanReadable                 = "InactivityScoresPrefix"
+	DepositRequestsStartIndexPrefixHumanReadable        = "DepositRequestsStartIndexPrefix"
 )
@@ -66,6 +66,9 @@ type KVStore[
 	eth1Data sdkcollections.Item[Eth1DataT]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
+	// depositRequestsStartIndex is the index of the first deposit processed
+	// from the deposit requests of an execution payload.
+	depositRequestsStartIndex sdkcollections.Item[uint64]
 	// latestExecutionPayload stores the latest execution payload version.
 	latestExecutionPayloadVersion sdkcollections.Item[uint32]
 	// latestExecutionPayloadCodec is the codec for the latest execution
@@ -97,6 +100,12 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// Participation
+	// epochParticipation stores the number of commits signed by each
+	// validator in the current epoch.
+	epochParticipation sdkcollections.Map[uint64, uint64]
+	// inactivityScores stores the inactivity score of each validator.
+	inactivityScores sdkcollections.Map[uint64, uint64]
 }
 
 // Store creates a new instance of Store.
@@ -168,6 +177,14 @@ func New[
 			keys.Eth1DepositIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		depositRequestsStartIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.DepositRequestsStartIndexPrefix},
+			),
+			keys.DepositRequestsStartIndexPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
 		latestExecutionPayloadVersion: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -239,6 +256,20 @@ func New[
 			keys.TotalSlashingPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		epochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.EpochParticipationPrefix}),
+			keys.EpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		inactivityScores: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.InactivityScoresPrefix}),
+			keys.InactivityScoresPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 		latestBlockHeader: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
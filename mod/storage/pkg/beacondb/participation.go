@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetEpochParticipations returns the epoch participation of all validators.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetEpochParticipations() ([]uint64, error) {
+	return kv.getUint64Values(kv.epochParticipation)
+}
+
+// GetEpochParticipationAtIndex returns the number of commits signed by the
+// validator at the given index in the current epoch.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetEpochParticipationAtIndex(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	count, err := kv.epochParticipation.Get(kv.ctx, uint64(idx))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// SetEpochParticipationAtIndex sets the number of commits signed by the
+// validator at the given index in the current epoch.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) SetEpochParticipationAtIndex(
+	idx math.ValidatorIndex,
+	count uint64,
+) error {
+	return kv.epochParticipation.Set(kv.ctx, uint64(idx), count)
+}
+
+// GetInactivityScores returns the inactivity scores of all validators.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetInactivityScores() ([]uint64, error) {
+	return kv.getUint64Values(kv.inactivityScores)
+}
+
+// GetInactivityScoreAtIndex returns the inactivity score of the validator at
+// the given index.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetInactivityScoreAtIndex(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	score, err := kv.inactivityScores.Get(kv.ctx, uint64(idx))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return score, err
+}
+
+// SetInactivityScoreAtIndex sets the inactivity score of the validator at the
+// given index.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) SetInactivityScoreAtIndex(
+	idx math.ValidatorIndex,
+	score uint64,
+) error {
+	return kv.inactivityScores.Set(kv.ctx, uint64(idx), score)
+}
+
+// getUint64Values returns all the values of the given map, ordered by key.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) getUint64Values(
+	m collections.Map[uint64, uint64],
+) ([]uint64, error) {
+	iter, err := m.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		value  uint64
+		values []uint64
+	)
+	for ; iter.Valid(); iter.Next() {
+		if value, err = iter.Value(); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -44,7 +44,17 @@ func (kv *KVStore[
 	}
 
 	// Push onto the balances list.
-	return kv.balances.Set(kv.ctx, idx, uint64(val.GetEffectiveBalance()))
+	if err = kv.balances.Set(
+		kv.ctx, idx, uint64(val.GetEffectiveBalance()),
+	); err != nil {
+		return err
+	}
+
+	// Push onto the participation and inactivity lists.
+	if err = kv.epochParticipation.Set(kv.ctx, idx, 0); err != nil {
+		return err
+	}
+	return kv.inactivityScores.Set(kv.ctx, idx, 0)
 }
 
 // UpdateValidatorAtIndex updates a validator at a specific index.
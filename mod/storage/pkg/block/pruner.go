@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+)
+
+// BuildPruneRangeFn builds a function that returns the range of slots of
+// the blocks to prune when the given block is finalized, such that only the
+// blocks within the availability window are kept.
+func BuildPruneRangeFn[
+	BeaconBlockT pruner.BeaconBlock,
+	BlockEventT pruner.BlockEvent[BeaconBlockT],
+](cfg Config) func(BlockEventT) (uint64, uint64) {
+	return func(event BlockEventT) (uint64, uint64) {
+		window := cfg.AvailabilityWindow
+		slot := event.Data().GetSlot().Unwrap()
+		if window == 0 || slot < window {
+			return 0, 0
+		}
+		return 0, slot - window
+	}
+}
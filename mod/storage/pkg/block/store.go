@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	KeyBlockPrefix     = "block"
+	KeyBlockRootPrefix = "block_root"
+	KeySlotPrefix      = "slot"
+)
+
+// KVStore is a KV store based implementation of a store of finalized beacon
+// blocks. Blocks are keyed by slot, and an index of block roots to slots
+// allows looking them up by root.
+type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+	// blocks maps slots to the SSZ encoding of the block at the slot.
+	blocks sdkcollections.Map[uint64, []byte]
+	// roots maps slots to the root of the block at the slot.
+	roots sdkcollections.Map[uint64, []byte]
+	// slots maps block roots to the slot of the block.
+	slots sdkcollections.Map[[]byte, uint64]
+	// cs is the chain spec, used to decode blocks with the fork version
+	// active at their slot.
+	cs primitives.ChainSpec
+	mu sync.RWMutex
+}
+
+// NewStore creates a new block store.
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	kvsp store.KVStoreService,
+	cs primitives.ChainSpec,
+) *KVStore[BeaconBlockT] {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &KVStore[BeaconBlockT]{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(0)}),
+			KeyBlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		roots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(1)}),
+			KeyBlockRootPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		slots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(2)}),
+			KeySlotPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+		cs: cs,
+	}
+}
+
+// Set stores the block at its slot and indexes its root. A block previously
+// stored at the same slot is replaced.
+func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	slot := blk.GetSlot().Unwrap()
+	if err = kv.removeRoot(slot); err != nil {
+		return err
+	}
+	if err = kv.blocks.Set(context.TODO(), slot, bz); err != nil {
+		return err
+	}
+	if err = kv.roots.Set(context.TODO(), slot, root[:]); err != nil {
+		return err
+	}
+	return kv.slots.Set(context.TODO(), root[:], slot)
+}
+
+// GetBySlot returns the block at the given slot.
+func (kv *KVStore[BeaconBlockT]) GetBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.get(slot)
+}
+
+// GetByRoot returns the block with the given root.
+func (kv *KVStore[BeaconBlockT]) GetByRoot(
+	root common.Root,
+) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	slot, err := kv.slots.Get(context.TODO(), root[:])
+	if err != nil {
+		var blk BeaconBlockT
+		return blk, err
+	}
+	return kv.get(math.Slot(slot))
+}
+
+// GetSlotByRoot returns the slot of the block with the given root.
+func (kv *KVStore[BeaconBlockT]) GetSlotByRoot(
+	root common.Root,
+) (math.Slot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	slot, err := kv.slots.Get(context.TODO(), root[:])
+	if err != nil {
+		return 0, err
+	}
+	return math.Slot(slot), nil
+}
+
+// GetRootBySlot returns the root of the block at the given slot.
+func (kv *KVStore[BeaconBlockT]) GetRootBySlot(
+	slot math.Slot,
+) (common.Root, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	bz, err := kv.roots.Get(context.TODO(), slot.Unwrap())
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(bz), nil
+}
+
+// Prune removes the blocks of the [start, end) slots from the store.
+func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	// Only the slots with a block are visited, so that pruning a range that
+	// has already been pruned is cheap.
+	iter, err := kv.blocks.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return err
+	}
+	slots, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, slot := range slots {
+		if err = kv.removeRoot(slot); err != nil {
+			return err
+		}
+		if err = kv.blocks.Remove(context.TODO(), slot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// get decodes the block at the given slot with the fork version active at
+// the slot.
+func (kv *KVStore[BeaconBlockT]) get(slot math.Slot) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	bz, err := kv.blocks.Get(context.TODO(), slot.Unwrap())
+	if err != nil {
+		return blk, err
+	}
+	return blk.NewFromSSZ(bz, kv.cs.ActiveForkVersionForSlot(slot))
+}
+
+// removeRoot removes the root index of the block at the given slot, if any.
+func (kv *KVStore[BeaconBlockT]) removeRoot(slot uint64) error {
+	root, err := kv.roots.Get(context.TODO(), slot)
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+	if err = kv.slots.Remove(context.TODO(), root); err != nil {
+		return err
+	}
+	return kv.roots.Remove(context.TODO(), slot)
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a beacon block made of its slot only.
+type testBlock struct {
+	slot    math.Slot
+	version uint32
+}
+
+func (b *testBlock) GetSlot() math.Slot { return b.slot }
+
+func (b *testBlock) SizeSSZ() int { return 8 }
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return b.MarshalSSZTo(make([]byte, 0, b.SizeSSZ()))
+}
+
+func (b *testBlock) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buf, b.slot.Unwrap()), nil
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	b.slot = math.Slot(binary.LittleEndian.Uint64(bz))
+	return nil
+}
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	return common.Root{byte(b.slot), 0x01}, nil
+}
+
+func (*testBlock) NewFromSSZ(bz []byte, forkVersion uint32) (
+	*testBlock, error,
+) {
+	blk := &testBlock{version: forkVersion}
+	return blk, blk.UnmarshalSSZ(bz)
+}
+
+// testStoreService opens the same store regardless of the context.
+type testStoreService struct {
+	ctx context.Context
+	*colltest.StoreService
+}
+
+func (s testStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.StoreService.OpenKVStore(s.ctx)
+}
+
+func newTestStore() *block.KVStore[*testBlock] {
+	svc, ctx := colltest.MockStore()
+	return block.NewStore[*testBlock](
+		testStoreService{ctx: ctx, StoreService: svc},
+		chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch: 1,
+			ForkSchedule: []chain.ScheduledFork[math.Epoch]{
+				{Version: version.Deneb, Epoch: 0},
+				{Version: version.Electra, Epoch: 3},
+			},
+		}),
+	)
+}
+
+func TestKVStore(t *testing.T) {
+	kv := newTestStore()
+	for slot := range math.Slot(5) {
+		require.NoError(t, kv.Set(&testBlock{slot: slot}))
+	}
+
+	// Blocks are decoded with the fork version active at their slot.
+	blk, err := kv.GetBySlot(2)
+	require.NoError(t, err)
+	require.Equal(t, &testBlock{slot: 2, version: version.Deneb}, blk)
+	blk, err = kv.GetBySlot(4)
+	require.NoError(t, err)
+	require.Equal(t, &testBlock{slot: 4, version: version.Electra}, blk)
+
+	blk, err = kv.GetByRoot(common.Root{3, 0x01})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(3), blk.GetSlot())
+
+	slot, err := kv.GetSlotByRoot(common.Root{1, 0x01})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), slot)
+
+	root, err := kv.GetRootBySlot(1)
+	require.NoError(t, err)
+	require.Equal(t, common.Root{1, 0x01}, root)
+
+	_, err = kv.GetBySlot(5)
+	require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+}
+
+func TestKVStore_Prune(t *testing.T) {
+	kv := newTestStore()
+	for slot := range math.Slot(5) {
+		require.NoError(t, kv.Set(&testBlock{slot: slot}))
+	}
+
+	require.NoError(t, kv.Prune(0, 3))
+	// Pruning an already pruned range is a no-op.
+	require.NoError(t, kv.Prune(0, 3))
+
+	for slot := range math.Slot(3) {
+		_, err := kv.GetBySlot(slot)
+		require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+		_, err = kv.GetByRoot(common.Root{byte(slot), 0x01})
+		require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+	}
+	for slot := math.Slot(3); slot < 5; slot++ {
+		blk, err := kv.GetBySlot(slot)
+		require.NoError(t, err)
+		require.Equal(t, slot, blk.GetSlot())
+	}
+}
+
+func TestBuildPruneRangeFn(t *testing.T) {
+	event := func(slot math.Slot) *feed.Event[*testBlock] {
+		return feed.NewEvent(
+			context.Background(), events.BeaconBlockFinalized,
+			&testBlock{slot: slot},
+		)
+	}
+
+	pruneRangeFn := block.BuildPruneRangeFn[*testBlock, *feed.Event[*testBlock]](
+		block.Config{AvailabilityWindow: 10},
+	)
+	start, end := pruneRangeFn(event(5))
+	require.Zero(t, start)
+	require.Zero(t, end)
+	start, end = pruneRangeFn(event(25))
+	require.Zero(t, start)
+	require.Equal(t, uint64(15), end)
+
+	// Blocks are never pruned with an empty availability window.
+	pruneRangeFn = block.BuildPruneRangeFn[*testBlock, *feed.Event[*testBlock]](block.Config{})
+	_, end = pruneRangeFn(event(25))
+	require.Zero(t, end)
+}
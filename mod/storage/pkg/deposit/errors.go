@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrDepositCountOutOfRange is returned when the tree is asked for a
+	// root or proof at a deposit count it cannot represent.
+	ErrDepositCountOutOfRange = errors.New("deposit count out of range")
+
+	// ErrDepositIndexOutOfRange is returned when a proof is requested for a
+	// deposit that is either finalized or not part of the tree.
+	ErrDepositIndexOutOfRange = errors.New("deposit index out of range")
+
+	// ErrInvalidSnapshot is returned when a persisted snapshot of the
+	// deposit tree cannot be decoded.
+	ErrInvalidSnapshot = errors.New("invalid deposit tree snapshot")
+
+	// ErrInvalidEth1Block is returned when the persisted eth1 block cannot
+	// be decoded.
+	ErrInvalidEth1Block = errors.New("invalid eth1 block")
+)
@@ -22,11 +22,14 @@ package deposit
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -34,7 +37,24 @@ import (
 // Deposit is a struct that holds the deposit information.
 var _ pruner.Prunable = (*KVStore[Deposit])(nil)
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix        = "deposit"
+	KeyFinalizedCountPrefix = "finalized_count"
+	KeyFinalizedRootsPrefix = "finalized_roots"
+	KeyEth1BlockPrefix      = "eth1_block"
+	KeyCheckpointPrefix     = "checkpoint"
+	KeyEth1BlocksPrefix     = "eth1_blocks"
+)
+
+const (
+	// eth1BlockLength is the length of an encoded eth1 block, made of its
+	// number, the deposit count of the deposit contract and its hash.
+	eth1BlockLength = 8 + 8 + 32
+	// eth1BlockHistory is the number of execution blocks, counted back from
+	// the latest one, whose deposit contract state is kept to verify the
+	// eth1 data of incoming blocks.
+	eth1BlockHistory = 1024
+)
 
 type KVStoreProvider struct {
 	store.KVStoreWithBatch
@@ -46,10 +66,27 @@ func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
 }
 
 // KVStore is a simple KV store based implementation that assumes
-// the deposit indexes are tracked outside of the kv store.
+// the deposit indexes are tracked outside of the kv store. Alongside the
+// deposits it maintains the incremental Merkle tree of the deposit contract.
 type KVStore[DepositT Deposit] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// finalizedCount and finalizedRoots persist the snapshot of the
+	// finalized part of the deposit tree.
+	finalizedCount sdkcollections.Item[uint64]
+	finalizedRoots sdkcollections.Item[[]byte]
+	// eth1Block persists the latest execution block at which the deposit
+	// contract has been read.
+	eth1Block sdkcollections.Item[[]byte]
+	// checkpoint persists the last execution block whose deposit logs have
+	// been processed.
+	checkpoint sdkcollections.Item[uint64]
+	// eth1Blocks persists the recent execution blocks at which the deposit
+	// contract has been read, keyed by their number.
+	eth1Blocks sdkcollections.Map[uint64, []byte]
+	// tree is the deposit tree, lazily loaded from the snapshot and the
+	// stored deposits.
+	tree *Tree
+	mu   sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -63,6 +100,37 @@ func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		finalizedCount: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(1)}),
+			KeyFinalizedCountPrefix,
+			sdkcollections.Uint64Value,
+		),
+		finalizedRoots: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(2)}),
+			KeyFinalizedRootsPrefix,
+			sdkcollections.BytesValue,
+		),
+		eth1Block: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(3)}),
+			KeyEth1BlockPrefix,
+			sdkcollections.BytesValue,
+		),
+		checkpoint: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(4)}),
+			KeyCheckpointPrefix,
+			sdkcollections.Uint64Value,
+		),
+		eth1Blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(5)}),
+			KeyEth1BlocksPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
 	}
 }
 
@@ -89,23 +157,209 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
-// EnqueueDeposit pushes the deposit to the queue.
-func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
+// GetDepositsWithProofs returns up to N deposits starting from the given
+// index, each carrying its Merkle proof against the deposit tree made of
+// the first depositCount deposits. Deposits at or beyond depositCount are
+// not returned.
+func (kv *KVStore[DepositT]) GetDepositsWithProofs(
+	startIndex uint64,
+	numView uint64,
+	depositCount uint64,
+) ([]DepositT, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	tree, err := kv.getTree()
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := []DepositT{}
+	for i := range numView {
+		if startIndex+i >= depositCount {
+			break
+		}
+		deposit, err := kv.store.Get(context.TODO(), startIndex+i)
+		if errors.Is(err, sdkcollections.ErrNotFound) {
+			return deposits, nil
+		}
+		if err != nil {
+			return deposits, err
+		}
+		proof, err := tree.Proof(startIndex+i, depositCount)
+		if err != nil {
+			return deposits, err
+		}
+		deposit.SetProof(proof)
+		deposits = append(deposits, deposit)
+	}
+	return deposits, nil
+}
+
+// GetDepositRoot returns the root of the deposit tree made of the first
+// depositCount deposits.
+func (kv *KVStore[DepositT]) GetDepositRoot(
+	depositCount uint64,
+) (common.Root, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	tree, err := kv.getTree()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return tree.Root(depositCount)
+}
+
+// GetEth1Block returns the hash of the latest execution block at which the
+// deposit contract has been read, along with the deposit count of the
+// deposit contract at that block.
+func (kv *KVStore[DepositT]) GetEth1Block() (
+	common.ExecutionHash, uint64, error,
+) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	bz, err := kv.eth1Block.Get(context.TODO())
+	if err != nil {
+		return common.ExecutionHash{}, 0, err
+	}
+	if len(bz) != eth1BlockLength {
+		return common.ExecutionHash{}, 0, ErrInvalidEth1Block
+	}
+	return common.ExecutionHash(bz[16:]), binary.LittleEndian.Uint64(bz[8:16]), nil
+}
+
+// GetEth1BlockDepositCount returns the deposit count of the deposit
+// contract at the recent execution block with the given hash, or false if
+// the deposit contract has not been read at that block.
+func (kv *KVStore[DepositT]) GetEth1BlockDepositCount(
+	blockHash common.ExecutionHash,
+) (uint64, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.eth1Blocks.Iterate(
+		context.TODO(), new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		bz, valueErr := iter.Value()
+		if valueErr != nil {
+			return 0, false, valueErr
+		}
+		if len(bz) != eth1BlockLength {
+			return 0, false, ErrInvalidEth1Block
+		}
+		if common.ExecutionHash(bz[16:]) == blockHash {
+			return binary.LittleEndian.Uint64(bz[8:16]), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// SetEth1Block records the deposit count of the deposit contract at the
+// given execution block. Blocks older than the latest recorded one are only
+// added to the history of recent blocks.
+func (kv *KVStore[DepositT]) SetEth1Block(
+	blockNumber uint64,
+	blockHash common.ExecutionHash,
+	depositCount uint64,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	eth1Block := make([]byte, eth1BlockLength)
+	binary.LittleEndian.PutUint64(eth1Block[:8], blockNumber)
+	binary.LittleEndian.PutUint64(eth1Block[8:16], depositCount)
+	copy(eth1Block[16:], blockHash[:])
+	if err := kv.eth1Blocks.Set(
+		context.TODO(), blockNumber, eth1Block,
+	); err != nil {
+		return err
+	}
+
+	bz, err := kv.eth1Block.Get(context.TODO())
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+	case err != nil:
+		return err
+	case len(bz) == eth1BlockLength &&
+		binary.LittleEndian.Uint64(bz[:8]) > blockNumber:
+		return nil
+	}
+
+	// Drop the blocks that fell out of the history.
+	if blockNumber > eth1BlockHistory {
+		if err = kv.eth1Blocks.Clear(
+			context.TODO(),
+			new(sdkcollections.Range[uint64]).EndExclusive(
+				blockNumber-eth1BlockHistory,
+			),
+		); err != nil {
+			return err
+		}
+	}
+	return kv.eth1Block.Set(context.TODO(), eth1Block)
+}
+
+// GetCheckpoint returns the last execution block whose deposit logs have
+// been processed, or false if no block has been processed yet.
+func (kv *KVStore[DepositT]) GetCheckpoint() (uint64, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blockNumber, err := kv.checkpoint.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	return blockNumber, true, nil
+}
+
+// SetCheckpoint records the last execution block whose deposit logs have
+// been processed.
+func (kv *KVStore[DepositT]) SetCheckpoint(blockNumber uint64) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	return kv.setDeposit(deposit)
+	return kv.checkpoint.Set(context.TODO(), blockNumber)
+}
+
+// GetDepositCount returns the number of deposits of the deposit tree, which
+// are the stored deposits without any gaps.
+func (kv *KVStore[DepositT]) GetDepositCount() (uint64, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	tree, err := kv.getTree()
+	if err != nil {
+		return 0, err
+	}
+	return tree.Count(), nil
+}
+
+// EnqueueDeposit pushes the deposit to the queue.
+func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
+	return kv.EnqueueDeposits([]DepositT{deposit})
 }
 
 // EnqueueDeposits pushes multiple deposits to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
+	tree, err := kv.getTree()
+	if err != nil {
+		return err
+	}
+
 	for _, deposit := range deposits {
-		if err := kv.setDeposit(deposit); err != nil {
+		// Deposits that are already part of the tree are immutable.
+		if deposit.GetIndex() < tree.Count() {
+			continue
+		}
+		if err = kv.setDeposit(deposit); err != nil {
 			return err
 		}
 	}
-	return nil
+	return kv.extendTree()
 }
 
 // setDeposit sets the deposit in the store.
@@ -117,6 +371,13 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
+
+	// The deposits must be collapsed into the tree snapshot before they are
+	// removed, as the tree cannot be rebuilt without them.
+	if err := kv.finalizeTree(start + end); err != nil {
+		return err
+	}
+
 	for i := range end {
 		// This only errors if the key passed in cannot be encoded.
 		if err := kv.store.Remove(context.TODO(), start+i); err != nil {
@@ -125,3 +386,88 @@ func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	}
 	return nil
 }
+
+// getTree returns the deposit tree, loading it from the persisted snapshot
+// and the stored deposits if it has not been loaded yet.
+func (kv *KVStore[DepositT]) getTree() (*Tree, error) {
+	if kv.tree != nil {
+		return kv.tree, nil
+	}
+
+	count, err := kv.finalizedCount.Get(context.TODO())
+	if err != nil && !errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, err
+	}
+
+	bz, err := kv.finalizedRoots.Get(context.TODO())
+	if err != nil && !errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, err
+	}
+	if len(bz)%constants.RootLength != 0 {
+		return nil, ErrInvalidSnapshot
+	}
+
+	roots := make([]common.Root, len(bz)/constants.RootLength)
+	for i := range roots {
+		copy(roots[i][:], bz[i*constants.RootLength:])
+	}
+
+	if kv.tree, err = NewTree(roots, count); err != nil {
+		return nil, err
+	}
+	return kv.tree, kv.extendTree()
+}
+
+// extendTree pushes the stored deposits that directly follow the last
+// deposit of the tree, so that the tree never has any gaps.
+func (kv *KVStore[DepositT]) extendTree() error {
+	tree, err := kv.getTree()
+	if err != nil {
+		return err
+	}
+
+	for {
+		deposit, err := kv.store.Get(context.TODO(), tree.Count())
+		if errors.Is(err, sdkcollections.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		leaf, err := deposit.GetDepositDataRoot()
+		if err != nil {
+			return err
+		}
+		tree.Push(leaf)
+	}
+}
+
+// finalizeTree finalizes the deposit tree up to the given deposit count and
+// persists the resulting snapshot.
+func (kv *KVStore[DepositT]) finalizeTree(count uint64) error {
+	tree, err := kv.getTree()
+	if err != nil {
+		return err
+	}
+
+	_, finalizedCount := tree.Snapshot()
+	count = min(count, tree.Count())
+	if count <= finalizedCount {
+		return nil
+	}
+
+	if err = tree.Finalize(count); err != nil {
+		return err
+	}
+
+	roots, count := tree.Snapshot()
+	bz := make([]byte, 0, len(roots)*constants.RootLength)
+	for _, root := range roots {
+		bz = append(bz, root[:]...)
+	}
+	if err = kv.finalizedRoots.Set(context.TODO(), bz); err != nil {
+		return err
+	}
+	return kv.finalizedCount.Set(context.TODO(), count)
+}
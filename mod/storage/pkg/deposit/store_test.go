@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a deposit that is never stored.
+type testDeposit struct {
+	ssz.Marshallable
+}
+
+func (*testDeposit) GetIndex() uint64 { return 0 }
+
+func (*testDeposit) SetProof([33]common.Root) {}
+
+func (*testDeposit) GetDepositDataRoot() (common.Root, error) {
+	return common.Root{}, nil
+}
+
+// testStoreService opens the same store regardless of the context.
+type testStoreService struct {
+	ctx context.Context
+	*colltest.StoreService
+}
+
+func (s testStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.StoreService.OpenKVStore(s.ctx)
+}
+
+func TestKVStore_Eth1BlockHistory(t *testing.T) {
+	svc, ctx := colltest.MockStore()
+	kv := deposit.NewStore[*testDeposit](
+		testStoreService{ctx: ctx, StoreService: svc},
+	)
+
+	require.NoError(t, kv.SetEth1Block(10, common.ExecutionHash{0x0a}, 1))
+	require.NoError(t, kv.SetEth1Block(20, common.ExecutionHash{0x14}, 3))
+	// An older block is kept in the history but is not the latest one.
+	require.NoError(t, kv.SetEth1Block(15, common.ExecutionHash{0x0f}, 2))
+
+	hash, count, err := kv.GetEth1Block()
+	require.NoError(t, err)
+	require.Equal(t, common.ExecutionHash{0x14}, hash)
+	require.Equal(t, uint64(3), count)
+
+	for hash, expected := range map[common.ExecutionHash]uint64{
+		{0x0a}: 1, {0x0f}: 2, {0x14}: 3,
+	} {
+		count, ok, err := kv.GetEth1BlockDepositCount(hash)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, expected, count)
+	}
+	_, ok, err := kv.GetEth1BlockDepositCount(common.ExecutionHash{0xff})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Blocks that fall out of the history are forgotten.
+	require.NoError(t, kv.SetEth1Block(1040, common.ExecutionHash{0x01}, 4))
+	_, ok, err = kv.GetEth1BlockDepositCount(common.ExecutionHash{0x0a})
+	require.NoError(t, err)
+	require.False(t, ok)
+	count, ok, err = kv.GetEth1BlockDepositCount(common.ExecutionHash{0x14})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), count)
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+	sha256 "github.com/minio/sha256-simd"
+)
+
+// Tree is an incremental Merkle tree over the deposit data roots of the
+// deposit contract, modeled after the deposit snapshot tree of EIP-4881.
+// Finalized deposits are collapsed into the roots of the largest complete
+// subtrees covering them, which is all that is needed to compute the root
+// of the tree and the proofs of any later deposit.
+// https://eips.ethereum.org/EIPS/eip-4881
+type Tree struct {
+	// finalized holds the roots of the finalized subtrees, ordered from
+	// left to right.
+	finalized []common.Root
+	// finalizedCount is the number of deposits covered by finalized.
+	finalizedCount uint64
+	// leaves holds the deposit data roots of the non-finalized deposits,
+	// starting at finalizedCount.
+	leaves []common.Root
+}
+
+// NewTree creates a new deposit tree from the given snapshot of finalized
+// subtree roots.
+func NewTree(
+	finalized []common.Root,
+	finalizedCount uint64,
+) (*Tree, error) {
+	//#nosec:G701 // popcount is at most 64.
+	if len(finalized) != bits.OnesCount64(finalizedCount) {
+		return nil, errors.Wrapf(
+			ErrInvalidSnapshot,
+			"expected %d finalized roots, got %d",
+			bits.OnesCount64(finalizedCount), len(finalized),
+		)
+	}
+	return &Tree{
+		finalized:      finalized,
+		finalizedCount: finalizedCount,
+	}, nil
+}
+
+// Count returns the number of deposits in the tree.
+func (t *Tree) Count() uint64 {
+	return t.finalizedCount + uint64(len(t.leaves))
+}
+
+// Snapshot returns the finalized subtree roots and the number of deposits
+// they cover.
+func (t *Tree) Snapshot() ([]common.Root, uint64) {
+	return t.finalized, t.finalizedCount
+}
+
+// Push appends the deposit data root of the next deposit to the tree.
+func (t *Tree) Push(leaf common.Root) {
+	t.leaves = append(t.leaves, leaf)
+}
+
+// Root returns the root of the tree made of the first count deposits, with
+// the deposit count mixed in.
+func (t *Tree) Root(count uint64) (common.Root, error) {
+	if count < t.finalizedCount || count > t.Count() {
+		return common.Root{}, errors.Wrapf(
+			ErrDepositCountOutOfRange,
+			"count %d, finalized %d, total %d",
+			count, t.finalizedCount, t.Count(),
+		)
+	}
+	return merkle.MixinLength(
+		t.node(constants.DepositContractTreeDepth, 0, count), count,
+	), nil
+}
+
+// Proof returns the Merkle proof of the deposit at the given index against
+// the root of the tree made of the first count deposits. The last element
+// of the proof is the mixed in deposit count.
+func (t *Tree) Proof(index, count uint64) ([33]common.Root, error) {
+	var proof [33]common.Root
+	if count < t.finalizedCount || count > t.Count() {
+		return proof, errors.Wrapf(
+			ErrDepositCountOutOfRange,
+			"count %d, finalized %d, total %d",
+			count, t.finalizedCount, t.Count(),
+		)
+	}
+	if index < t.finalizedCount || index >= count {
+		return proof, errors.Wrapf(
+			ErrDepositIndexOutOfRange,
+			"index %d, finalized %d, count %d",
+			index, t.finalizedCount, count,
+		)
+	}
+
+	for level := range constants.DepositContractTreeDepth {
+		proof[level] = t.node(level, (index>>level)^1, count)
+	}
+	binary.LittleEndian.PutUint64(
+		proof[constants.DepositContractTreeDepth][:8], count,
+	)
+	return proof, nil
+}
+
+// Finalize collapses the first count deposits of the tree into the roots
+// of their complete subtrees. Finalized deposits can no longer be proven.
+func (t *Tree) Finalize(count uint64) error {
+	if count < t.finalizedCount || count > t.Count() {
+		return errors.Wrapf(
+			ErrDepositCountOutOfRange,
+			"count %d, finalized %d, total %d",
+			count, t.finalizedCount, t.Count(),
+		)
+	}
+
+	finalized := make([]common.Root, 0, bits.OnesCount64(count))
+	var offset uint64
+	for level := 63; level >= 0; level-- {
+		size := uint64(1) << level
+		if count&size == 0 {
+			continue
+		}
+		//#nosec:G701 // level is at most 63.
+		finalized = append(
+			finalized, t.node(uint8(level), offset>>level, count),
+		)
+		offset += size
+	}
+
+	t.leaves = t.leaves[count-t.finalizedCount:]
+	t.finalized = finalized
+	t.finalizedCount = count
+	return nil
+}
+
+// node returns the root of the subtree at the given level and index, where
+// only the first count deposits of the tree are taken into account.
+func (t *Tree) node(level uint8, index, count uint64) common.Root {
+	start := index << level
+	if start >= count {
+		return zero.Hashes[level]
+	}
+	if root, ok := t.finalizedNode(level, start); ok {
+		return root
+	}
+	if level == 0 {
+		return t.leaves[start-t.finalizedCount]
+	}
+
+	var input [64]byte
+	left := t.node(level-1, index<<1, count)
+	right := t.node(level-1, index<<1|1, count)
+	copy(input[:32], left[:])
+	copy(input[32:], right[:])
+	return sha256.Sum256(input[:])
+}
+
+// finalizedNode returns the finalized subtree root at the given level that
+// starts at the given leaf, if any.
+func (t *Tree) finalizedNode(level uint8, start uint64) (common.Root, bool) {
+	if start >= t.finalizedCount {
+		return common.Root{}, false
+	}
+
+	var offset uint64
+	for i, l := 0, 63; l >= 0; l-- {
+		size := uint64(1) << l
+		if t.finalizedCount&size == 0 {
+			continue
+		}
+		if offset == start && l == int(level) {
+			return t.finalized[i], true
+		}
+		offset += size
+		i++
+	}
+	return common.Root{}, false
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// generateLeaves generates n distinct deposit data roots.
+func generateLeaves(n int) []common.Root {
+	leaves := make([]common.Root, n)
+	for i := range leaves {
+		leaves[i] = common.Root{byte(i + 1), byte((i + 1) >> 8)}
+	}
+	return leaves
+}
+
+// requireMatchesReference asserts that the root and the proofs of the tree
+// match a full Merkle tree built over the first count leaves.
+func requireMatchesReference(
+	t *testing.T,
+	tree *deposit.Tree,
+	leaves []common.Root,
+	from, count uint64,
+) {
+	t.Helper()
+	// The reference tree may append to the slice it is given, so it must
+	// be built from a copy of the leaves.
+	reference, err := merkle.NewTreeFromLeavesWithDepth[
+		common.Root, common.Root,
+	](
+		append([]common.Root{}, leaves[:count]...),
+		constants.DepositContractTreeDepth,
+	)
+	require.NoError(t, err)
+	expectedRoot, err := reference.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := tree.Root(count)
+	require.NoError(t, err)
+	require.Equal(t, common.Root(expectedRoot), root)
+
+	for index := from; index < count; index++ {
+		proof, errProof := tree.Proof(index, count)
+		require.NoError(t, errProof)
+		require.True(t, merkle.IsValidMerkleBranch(
+			leaves[index], proof[:],
+			constants.DepositContractTreeDepth+1, index, root,
+		))
+	}
+}
+
+func TestTree_RootAndProofs(t *testing.T) {
+	leaves := generateLeaves(17)
+	tree, err := deposit.NewTree(nil, 0)
+	require.NoError(t, err)
+
+	root, err := tree.Root(0)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		merkle.MixinLength(
+			common.Root(zero.Hashes[constants.DepositContractTreeDepth]), 0,
+		),
+		root,
+	)
+
+	for i, leaf := range leaves {
+		tree.Push(leaf)
+		require.Equal(t, uint64(i+1), tree.Count())
+	}
+
+	for count := uint64(1); count <= uint64(len(leaves)); count++ {
+		requireMatchesReference(t, tree, leaves, 0, count)
+	}
+}
+
+func TestTree_Finalize(t *testing.T) {
+	leaves := generateLeaves(20)
+	for _, finalizedCount := range []uint64{0, 1, 3, 4, 7, 8, 13, 16, 20} {
+		tree, err := deposit.NewTree(nil, 0)
+		require.NoError(t, err)
+		for _, leaf := range leaves {
+			tree.Push(leaf)
+		}
+
+		require.NoError(t, tree.Finalize(finalizedCount))
+		require.Equal(t, uint64(len(leaves)), tree.Count())
+
+		// The finalized tree must still produce the same roots and proofs
+		// for all non-finalized deposits.
+		numLeaves := uint64(len(leaves))
+		for count := max(finalizedCount, 1); count <= numLeaves; count++ {
+			requireMatchesReference(t, tree, leaves, finalizedCount, count)
+		}
+
+		// Rebuilding the tree from its snapshot must yield the same tree.
+		roots, snapshotCount := tree.Snapshot()
+		require.Equal(t, finalizedCount, snapshotCount)
+		rebuilt, err := deposit.NewTree(roots, snapshotCount)
+		require.NoError(t, err)
+		for _, leaf := range leaves[snapshotCount:] {
+			rebuilt.Push(leaf)
+		}
+		requireMatchesReference(
+			t, rebuilt, leaves, finalizedCount, numLeaves,
+		)
+	}
+}
+
+func TestTree_OutOfRange(t *testing.T) {
+	tree, err := deposit.NewTree(nil, 0)
+	require.NoError(t, err)
+	for _, leaf := range generateLeaves(8) {
+		tree.Push(leaf)
+	}
+	require.NoError(t, tree.Finalize(4))
+
+	_, err = tree.Root(3)
+	require.ErrorIs(t, err, deposit.ErrDepositCountOutOfRange)
+	_, err = tree.Root(9)
+	require.ErrorIs(t, err, deposit.ErrDepositCountOutOfRange)
+	_, err = tree.Proof(3, 8)
+	require.ErrorIs(t, err, deposit.ErrDepositIndexOutOfRange)
+	_, err = tree.Proof(6, 6)
+	require.ErrorIs(t, err, deposit.ErrDepositIndexOutOfRange)
+	require.ErrorIs(t, tree.Finalize(2), deposit.ErrDepositCountOutOfRange)
+
+	_, err = deposit.NewTree([]common.Root{{}}, 3)
+	require.ErrorIs(t, err, deposit.ErrInvalidSnapshot)
+}
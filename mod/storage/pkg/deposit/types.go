@@ -21,13 +21,20 @@
 package deposit
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
 // Deposit is a struct that represents a deposit.
 type Deposit interface {
 	ssz.Marshallable
+	// GetIndex returns the index of the deposit in the deposit contract.
 	GetIndex() uint64
+	// GetDepositDataRoot returns the leaf of the deposit in the deposit
+	// tree.
+	GetDepositDataRoot() (common.Root, error)
+	// SetProof sets the Merkle proof of the deposit.
+	SetProof(proof [33]common.Root)
 }
 
 // RawBatch represents a group of writes. They may or may not be written